@@ -162,6 +162,23 @@ func TestBindWithTags_Basic(t *testing.T) {
 	}
 }
 
+func TestBindWithTags_StableNames(t *testing.T) {
+	build := func() []string {
+		container := New()
+		_ = container.BindWithTags((*Logger)(nil), &ConsoleLogger{}, []string{"logger"})
+		_ = container.BindWithTags((*Logger)(nil), &ConsoleLogger{}, []string{"logger"})
+		return container.registry.GetAllNamedFor(typeOfToken((*Logger)(nil)))
+	}
+
+	names := build()
+	if len(names) != 2 || names[0] == names[1] {
+		t.Fatalf("expected two distinct tagged bindings, got %v", names)
+	}
+	if again := build(); again[0] != names[0] || again[1] != names[1] {
+		t.Errorf("tagged binding names changed between builds: %v, then %v", names, again)
+	}
+}
+
 func TestMakeWithTag_MultipleMatches(t *testing.T) {
 	container := New()
 
@@ -204,6 +221,41 @@ func TestMakeWithTag_EmptyTag(t *testing.T) {
 	container.MakeWithTag("")
 }
 
+func TestMakeWithTagExpr(t *testing.T) {
+	container := New()
+
+	_ = container.BindWithTags((*Logger)(nil), &ConsoleLogger{}, []string{"logger", "enabled"})
+	_ = container.BindWithTags((*Logger)(nil), &FileLogger{}, []string{"logger", "enabled", "deprecated"})
+	_ = container.BindWithTags((*Database)(nil), &MockDB{}, []string{"storage", "enabled"})
+
+	if got := container.MakeWithTagExpr("logger && enabled && !deprecated"); len(got) != 1 {
+		t.Errorf("Expected 1 active logger, got %d", len(got))
+	} else if _, ok := got[0].(*ConsoleLogger); !ok {
+		t.Errorf("Expected *ConsoleLogger, got %T", got[0])
+	}
+	if got := container.MakeWithTagExpr("(logger || storage) && enabled"); len(got) != 3 {
+		t.Errorf("Expected 3 enabled services, got %d", len(got))
+	}
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+	if got := scope.MakeWithTagExpr("storage || deprecated"); len(got) != 2 {
+		t.Errorf("Expected 2 services in scope, got %d", len(got))
+	}
+}
+
+func TestMakeWithTagExpr_Invalid(t *testing.T) {
+	container := New()
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for invalid expression")
+		}
+	}()
+
+	container.MakeWithTagExpr("logger &&")
+}
+
 // Auto-wire Named Dependencies Test
 
 func TestAutoWire_NamedDependency(t *testing.T) {
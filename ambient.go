@@ -0,0 +1,97 @@
+package nasc
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// scopeContextKey is the context key under which WithScope stores a scope.
+type scopeContextKey struct{}
+
+// WithScope returns a copy of ctx carrying scope, so code further down the
+// call chain can resolve request-scoped services with MakeFromContext
+// without the scope being passed explicitly.
+//
+// Example:
+//
+//	func (m *Middleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+//	    scope := m.container.CreateScopeWithContext(r.Context())
+//	    defer scope.Dispose()
+//	    m.next.ServeHTTP(w, r.WithContext(nasc.WithScope(r.Context(), scope)))
+//	}
+func WithScope(ctx context.Context, scope *Scope) context.Context {
+	return context.WithValue(ctx, scopeContextKey{}, scope)
+}
+
+// ScopeFromContext returns the scope stored in ctx by WithScope.
+func ScopeFromContext(ctx context.Context) (*Scope, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	scope, ok := ctx.Value(scopeContextKey{}).(*Scope)
+	return scope, ok && scope != nil
+}
+
+// MakeFromContext resolves abstractType from the scope carried in ctx (see
+// WithScope), or from the container itself if ctx carries no scope or a
+// scope of another container. It panics on failure like Make.
+//
+// Example:
+//
+//	func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+//	    uow := h.container.MakeFromContext(r.Context(), (*UnitOfWork)(nil)).(UnitOfWork)
+//	    // ...
+//	}
+func (n *Nasc) MakeFromContext(ctx context.Context, abstractType interface{}) interface{} {
+	if scope, ok := ScopeFromContext(ctx); ok && scope.parent.containerState == n.containerState {
+		return scope.Make(abstractType)
+	}
+	return n.Make(abstractType)
+}
+
+// Ambient types are satisfied from the current resolution when no binding
+// exists for them: constructors and auto-wired fields may declare them to
+// reach the container, the resolving scope, or the scope's context.
+var (
+	nascType    = reflect.TypeOf((*Nasc)(nil))
+	scopeType   = reflect.TypeOf((*Scope)(nil))
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+)
+
+// isAmbientType reports whether t is *Nasc, *Scope or context.Context.
+func isAmbientType(t reflect.Type) bool {
+	return t == nascType || t == scopeType || t == contextType
+}
+
+// ambient resolves an ambient type for a resolution outside any scope:
+// the container itself and context.Background(). A *Scope cannot be
+// supplied there, since no scope is resolving.
+func (n *Nasc) ambient(t reflect.Type) (interface{}, bool, error) {
+	switch t {
+	case nascType:
+		return n, true, nil
+	case contextType:
+		return context.Background(), true, nil
+	case scopeType:
+		return nil, true, fmt.Errorf("*nasc.Scope is only available to instances created by a scope")
+	}
+	return nil, false, nil
+}
+
+// ambient resolves an ambient type for a resolution by this scope: its
+// container, the scope itself and the scope's context.
+func (s *Scope) ambient(t reflect.Type) (interface{}, bool) {
+	switch t {
+	case nascType:
+		return s.parent, true
+	case scopeType:
+		return s, true
+	case contextType:
+		if s.ctx == nil {
+			return context.Background(), true
+		}
+		return s.ctx, true
+	}
+	return nil, false
+}
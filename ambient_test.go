@@ -0,0 +1,143 @@
+package nasc
+
+import (
+	"context"
+	"testing"
+)
+
+func TestScopeFromContext(t *testing.T) {
+	if _, ok := ScopeFromContext(context.Background()); ok {
+		t.Error("plain context should carry no scope")
+	}
+
+	scope := New().CreateScope()
+	defer scope.Dispose()
+
+	got, ok := ScopeFromContext(WithScope(context.Background(), scope))
+	if !ok || got != scope {
+		t.Error("WithScope should store the scope")
+	}
+	if _, ok := ScopeFromContext(WithScope(context.Background(), nil)); ok {
+		t.Error("nil scope should not be reported")
+	}
+}
+
+func TestMakeFromContext(t *testing.T) {
+	container := New()
+	_ = container.Scoped((*Logger)(nil), &ConsoleLogger{})
+	_ = container.Singleton((*Database)(nil), &MockDB{})
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+	ctx := WithScope(context.Background(), scope)
+
+	if container.MakeFromContext(ctx, (*Logger)(nil)) != scope.Make((*Logger)(nil)) {
+		t.Error("scoped service should come from the context's scope")
+	}
+	if container.MakeFromContext(context.Background(), (*Database)(nil)) != container.Make((*Database)(nil)) {
+		t.Error("without a scope the container should be used")
+	}
+}
+
+func TestMakeFromContext_ForeignScope(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	other := New()
+	_ = other.Bind((*Logger)(nil), &FileLogger{})
+	scope := other.CreateScope()
+	defer scope.Dispose()
+
+	got := container.MakeFromContext(WithScope(context.Background(), scope), (*Logger)(nil))
+	if _, ok := got.(*ConsoleLogger); !ok {
+		t.Errorf("scope of another container should be ignored, got %T", got)
+	}
+}
+
+type ambientService struct {
+	container *Nasc
+	scope     *Scope
+	ctx       context.Context
+}
+
+type ambientFields struct {
+	Container *Nasc           `inject:""`
+	Scope     *Scope          `inject:"optional"`
+	Ctx       context.Context `inject:""`
+}
+
+type ambientKey struct{ name string }
+
+func TestAmbientConstructorParams(t *testing.T) {
+	c := New()
+	_ = c.ScopedConstructor((*ambientService)(nil), func(n *Nasc, s *Scope, ctx context.Context) *ambientService {
+		return &ambientService{container: n, scope: s, ctx: ctx}
+	})
+
+	ctx := context.WithValue(context.Background(), ambientKey{"k"}, "request")
+	scope := c.CreateScopeWithContext(ctx)
+	defer scope.Dispose()
+
+	service := scope.Make((*ambientService)(nil)).(*ambientService)
+	if service.container != c || service.scope != scope {
+		t.Error("container or scope was not injected")
+	}
+	if service.ctx.Value(ambientKey{"k"}) != "request" {
+		t.Error("scope context was not injected")
+	}
+
+	if err := c.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
+
+func TestAmbientConstructorParams_OutsideScope(t *testing.T) {
+	c := New()
+	_ = c.BindConstructor((*ambientService)(nil), func(n *Nasc, ctx context.Context) *ambientService {
+		return &ambientService{container: n, ctx: ctx}
+	})
+	_ = c.BindConstructor((*Consumer)(nil), func(s *Scope) *recordingConsumer {
+		return &recordingConsumer{}
+	})
+
+	service := c.Make((*ambientService)(nil)).(*ambientService)
+	if service.container != c || service.ctx == nil {
+		t.Error("container or background context was not injected")
+	}
+
+	if _, err := c.MakeSafe((*Consumer)(nil)); err == nil {
+		t.Error("MakeSafe() should fail to inject *Scope outside a scope")
+	}
+}
+
+func TestAmbientFields(t *testing.T) {
+	c := New()
+	_ = c.BindAutoWire((*ambientFields)(nil), &ambientFields{}, WithLifetime(LifetimeScoped))
+
+	scope := c.CreateScope()
+	defer scope.Dispose()
+
+	fields := scope.Make((*ambientFields)(nil)).(*ambientFields)
+	if fields.Container != c || fields.Scope != scope || fields.Ctx == nil {
+		t.Errorf("ambient fields were not injected: %+v", fields)
+	}
+
+	outside := &ambientFields{}
+	if err := c.AutoWire(outside); err != nil {
+		t.Fatalf("AutoWire() error = %v", err)
+	}
+	if outside.Container != c || outside.Scope != nil {
+		t.Errorf("AutoWire() outside a scope = %+v", outside)
+	}
+}
+
+func TestAmbientTypes_ExplicitBindingWins(t *testing.T) {
+	c := New()
+	ctx := context.WithValue(context.Background(), ambientKey{"k"}, "bound")
+	_ = c.BindInstanceAs(ctx, (*context.Context)(nil))
+
+	got := c.Make((*context.Context)(nil)).(context.Context)
+	if got.Value(ambientKey{"k"}) != "bound" {
+		t.Error("explicit context.Context binding was not used")
+	}
+}
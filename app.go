@@ -0,0 +1,184 @@
+package nasc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultStopTimeout bounds the shutdown of an App or of RunUntilSignal
+// unless WithShutdownTimeout or App.StopTimeout sets another limit.
+const DefaultStopTimeout = 15 * time.Second
+
+// LifecycleHook is a pair of functions an App runs when it starts and
+// stops. Either may be nil. OnStop runs only if OnStart succeeded.
+type LifecycleHook struct {
+	OnStart func(ctx context.Context) error
+	OnStop  func(ctx context.Context) error
+}
+
+// App runs a container for the lifetime of a program: it registers
+// modules and providers, validates the graph, boots providers, starts
+// hosted services (see AddHostedService), runs start hooks, waits for a
+// signal or a hosted service failure and shuts everything down in reverse
+// order.
+//
+// Example:
+//
+//	func main() {
+//	    app := nasc.NewApp().
+//	        Modules(Database, Cache).
+//	        Providers(&HTTPProvider{}).
+//	        Hook(nasc.LifecycleHook{OnStart: server.Start, OnStop: server.Stop})
+//
+//	    if err := app.Run(context.Background()); err != nil {
+//	        log.Fatal(err)
+//	    }
+//	}
+type App struct {
+	container   *Nasc
+	modules     []*ModuleDef
+	providers   []ServiceProvider
+	hooks       []LifecycleHook
+	stopTimeout time.Duration
+
+	mu      sync.Mutex
+	started []LifecycleHook
+	running bool
+}
+
+// NewApp returns an App around a new container created with options. Its
+// stop timeout is the container's shutdown timeout (see
+// WithShutdownTimeout).
+func NewApp(options ...Option) *App {
+	container := New(options...)
+	return &App{
+		container:   container,
+		stopTimeout: container.stopTimeout(),
+	}
+}
+
+// Container returns the App's container.
+func (a *App) Container() *Nasc {
+	return a.container
+}
+
+// Modules adds modules registered when the App starts.
+func (a *App) Modules(modules ...*ModuleDef) *App {
+	a.modules = append(a.modules, modules...)
+	return a
+}
+
+// Providers adds providers registered when the App starts, after its
+// modules.
+func (a *App) Providers(providers ...ServiceProvider) *App {
+	a.providers = append(a.providers, providers...)
+	return a
+}
+
+// Hook adds a lifecycle hook. Start hooks run in the order added, once
+// providers have booted; stop hooks run in reverse order.
+func (a *App) Hook(hook LifecycleHook) *App {
+	a.hooks = append(a.hooks, hook)
+	return a
+}
+
+// StopTimeout bounds how long Run waits for the App to stop.
+func (a *App) StopTimeout(timeout time.Duration) *App {
+	a.stopTimeout = timeout
+	return a
+}
+
+// Start registers the App's modules and providers, validates the
+// container, boots providers, starts hosted services and runs the start
+// hooks. Hosted services keep running when ctx is canceled; they stop with
+// the App. If a start hook fails, the hooks that already started are
+// stopped and the container is shut down before the error is returned.
+func (a *App) Start(ctx context.Context) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.running {
+		return fmt.Errorf("app is already started")
+	}
+
+	if err := a.container.RegisterModule(a.modules...); err != nil {
+		return err
+	}
+	for _, provider := range a.providers {
+		if err := a.container.RegisterProvider(provider); err != nil {
+			return err
+		}
+	}
+	if err := a.container.Validate(); err != nil {
+		return err
+	}
+	if err := a.container.BootProviders(); err != nil {
+		return err
+	}
+	if err := a.container.StartHostedServices(context.WithoutCancel(ctx)); err != nil {
+		return err
+	}
+
+	a.running = true
+	for _, hook := range a.hooks {
+		if hook.OnStart != nil {
+			if err := hook.OnStart(ctx); err != nil {
+				stopCtx, cancel := context.WithTimeout(context.Background(), a.stopTimeout)
+				defer cancel()
+				return errors.Join(fmt.Errorf("start hook failed: %w", err), a.stopLocked(stopCtx))
+			}
+		}
+		a.started = append(a.started, hook)
+	}
+	return nil
+}
+
+// Stop runs the stop hooks of the started hooks in reverse order and then
+// shuts the container down (see Shutdown), which stops hosted services
+// first, bounded by ctx. Every step runs even if an earlier one fails;
+// errors are combined with errors.Join.
+func (a *App) Stop(ctx context.Context) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if !a.running {
+		return nil
+	}
+	return a.stopLocked(ctx)
+}
+
+// stopLocked stops a running App. The caller must hold a.mu.
+func (a *App) stopLocked(ctx context.Context) error {
+	var errs []error
+	for i := len(a.started) - 1; i >= 0; i-- {
+		if stop := a.started[i].OnStop; stop != nil {
+			if err := stop(ctx); err != nil {
+				errs = append(errs, fmt.Errorf("stop hook failed: %w", err))
+			}
+		}
+	}
+	a.started = nil
+	a.running = false
+
+	if err := a.container.Shutdown(ctx); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Run starts the App, blocks until ctx is done, the process receives
+// SIGINT or SIGTERM or a hosted service fails, and then stops the App
+// within the stop timeout. A hosted service failure is returned along with
+// any shutdown errors.
+func (a *App) Run(ctx context.Context) error {
+	if err := a.Start(ctx); err != nil {
+		return err
+	}
+
+	failure := a.container.waitForStop(ctx, nil)
+
+	stopCtx, cancel := context.WithTimeout(context.Background(), a.stopTimeout)
+	defer cancel()
+	return errors.Join(failure, a.Stop(stopCtx))
+}
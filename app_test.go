@@ -0,0 +1,101 @@
+package nasc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestApp_Lifecycle(t *testing.T) {
+	var steps []string
+	record := func(step string) func(context.Context) error {
+		return func(context.Context) error {
+			steps = append(steps, step)
+			return nil
+		}
+	}
+
+	app := NewApp().
+		Modules(loggingModule()).
+		Providers(NewProvider("boot", func(c *Nasc) error { return nil }).OnBoot(func(c *Nasc) error {
+			steps = append(steps, "boot")
+			return nil
+		})).
+		Hook(LifecycleHook{OnStart: record("start server"), OnStop: record("stop server")}).
+		Hook(LifecycleHook{OnStart: record("start worker"), OnStop: record("stop worker")})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- app.Run(ctx) }()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run should return once its context is done")
+	}
+
+	want := "boot,start server,start worker,stop worker,stop server"
+	if got := strings.Join(steps, ","); got != want {
+		t.Errorf("steps = %s, want %s", got, want)
+	}
+	if _, ok := app.Container().Make((*Logger)(nil)).(*prefixLogger); !ok {
+		t.Error("app modules should be registered in the container")
+	}
+}
+
+func TestApp_StartFailure(t *testing.T) {
+	var stopped []string
+	app := NewApp().
+		Hook(LifecycleHook{
+			OnStart: func(context.Context) error { return nil },
+			OnStop:  func(context.Context) error { stopped = append(stopped, "first"); return nil },
+		}).
+		Hook(LifecycleHook{
+			OnStart: func(context.Context) error { return errors.New("port in use") },
+			OnStop:  func(context.Context) error { stopped = append(stopped, "second"); return nil },
+		})
+
+	err := app.Start(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "port in use") {
+		t.Fatalf("Start() error = %v, want the start hook error", err)
+	}
+	if strings.Join(stopped, ",") != "first" {
+		t.Errorf("stopped = %v, want only the hooks that started", stopped)
+	}
+}
+
+func TestApp_ValidationFailure(t *testing.T) {
+	app := NewApp().Modules(Module("storage",
+		Constructor((*Consumer)(nil), func(db Database) *recordingConsumer {
+			return &recordingConsumer{}
+		}, LifetimeTransient),
+	))
+
+	var validation *ValidationError
+	if err := app.Start(context.Background()); !errors.As(err, &validation) {
+		t.Errorf("Start() error = %v, want a ValidationError", err)
+	}
+}
+
+func TestApp_StartTwice(t *testing.T) {
+	app := NewApp()
+	if err := app.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if err := app.Start(context.Background()); err == nil {
+		t.Error("starting a running app should fail")
+	}
+	if err := app.Stop(context.Background()); err != nil {
+		t.Errorf("Stop() error = %v", err)
+	}
+	if err := app.Stop(context.Background()); err != nil {
+		t.Errorf("stopping a stopped app error = %v", err)
+	}
+}
@@ -0,0 +1,198 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// BindOption configures a binding registered with As, BindType or
+// BindAutoWire.
+type BindOption func(*bindOptions)
+
+// bindOptions collects the settings applied by BindOption values.
+type bindOptions struct {
+	lifetime Lifetime
+	name     string
+	tags     []string
+	autoWire bool
+	priority int
+	meta     Metadata
+}
+
+// WithLifetime sets the binding's lifetime. The default is transient;
+// factory lifetimes are not supported. Cached lifetimes (see
+// LifetimeCached) are.
+func WithLifetime(lifetime Lifetime) BindOption {
+	return func(o *bindOptions) {
+		o.lifetime = lifetime
+	}
+}
+
+// WithName registers the binding under a name (see BindNamed).
+func WithName(name string) BindOption {
+	return func(o *bindOptions) {
+		o.name = name
+	}
+}
+
+// WithTags registers the binding with tags (see BindWithTags).
+func WithTags(tags ...string) BindOption {
+	return func(o *bindOptions) {
+		o.tags = append(o.tags, tags...)
+	}
+}
+
+// WithAutoWire injects the binding's tagged fields when it is resolved
+// (see BindAutoWire).
+func WithAutoWire() BindOption {
+	return func(o *bindOptions) {
+		o.autoWire = true
+	}
+}
+
+// WithPriority orders the binding within MakeAll and MakeWithTag results:
+// higher priorities come first, and bindings of equal priority keep their
+// registration order. The default priority is 0.
+func WithPriority(priority int) BindOption {
+	return func(o *bindOptions) {
+		o.priority = priority
+	}
+}
+
+// WithMeta attaches key/value metadata to the binding, for selecting it
+// with MakeWhere or registry.Query. Repeat it to set several keys.
+//
+// Example:
+//
+//	nasc.As[Handler, OrdersV2](container, nasc.WithTags("handler"), nasc.WithMeta("version", "2"))
+func WithMeta(key, value string) BindOption {
+	return func(o *bindOptions) {
+		if o.meta == nil {
+			o.meta = make(Metadata)
+		}
+		o.meta[key] = value
+	}
+}
+
+// As binds interface I to the struct type T, so that Make((*I)(nil))
+// returns a *T. Type arguments replace the (*I)(nil) and &T{} tokens.
+//
+// "*T implements I" cannot be a constraint here: Go does not allow a type
+// parameter in a constraint's type set (interface{ *T; I } fails with
+// "term cannot be a type parameter"), and a generic var _ I = (*T)(nil)
+// does not compile for arbitrary I either. The check therefore happens when
+// As is called: a *T that does not implement I is reported as an
+// InvalidBindingError at startup instead of failing on first resolution.
+// Use AsChecked to have the compiler enforce it.
+//
+// Example:
+//
+//	nasc.As[Logger, ConsoleLogger](container)
+//	nasc.As[Database, PostgresDB](container, nasc.WithLifetime(nasc.LifetimeSingleton))
+//	nasc.As[Plugin, AuthPlugin](container, nasc.WithTags("plugin"))
+func As[I any, T any](c *Nasc, opts ...BindOption) error {
+	options := bindOptions{lifetime: LifetimeTransient}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	abstractT := reflect.TypeOf((*I)(nil)).Elem()
+	concreteT := reflect.TypeOf((*T)(nil))
+	if abstractT.Kind() != reflect.Interface {
+		return &InvalidBindingError{Reason: fmt.Sprintf("As requires an interface type, got %v", abstractT)}
+	}
+	if concreteT.Elem().Kind() != reflect.Struct {
+		return &InvalidBindingError{Reason: fmt.Sprintf("As requires a struct implementation, got %v", concreteT.Elem())}
+	}
+	if !concreteT.Implements(abstractT) {
+		return &InvalidBindingError{Reason: fmt.Sprintf("%v does not implement %v", concreteT, abstractT)}
+	}
+
+	return c.bindType(abstractT, concreteT, options)
+}
+
+// AsChecked is As with the "*T implements I" check done by the compiler:
+// implements converts a *T to I, so the function literal only compiles if
+// *T is assignable to I, and both type arguments are inferred from it.
+// The function is not called.
+//
+// Example:
+//
+//	nasc.AsChecked(container, func(l *ConsoleLogger) Logger { return l })
+//	nasc.AsChecked(container, func(db *PostgresDB) Database { return db },
+//	    nasc.WithLifetime(nasc.LifetimeSingleton))
+func AsChecked[I any, T any](c *Nasc, implements func(*T) I, opts ...BindOption) error {
+	if implements == nil {
+		return &InvalidBindingError{Reason: "AsChecked requires a conversion function"}
+	}
+	return As[I, T](c, opts...)
+}
+
+// bindTokens checks an (*I)(nil) token and a &T{} concrete value, then
+// registers them with bindType.
+func (n *Nasc) bindTokens(abstractType, concreteType interface{}, options bindOptions) error {
+	if abstractType == nil {
+		return &InvalidBindingError{Reason: "abstract type cannot be nil"}
+	}
+	if concreteType == nil {
+		return &InvalidBindingError{Reason: "concrete type cannot be nil"}
+	}
+
+	abstractT := typeOfToken(abstractType)
+	concreteT := reflect.TypeOf(concreteType)
+	if concreteT.Kind() != reflect.Ptr || concreteT.Elem().Kind() != reflect.Struct {
+		return &InvalidBindingError{Reason: fmt.Sprintf("concrete type must be pointer to struct, got %v", concreteT)}
+	}
+	if abstractT.Kind() == reflect.Interface && !concreteT.Implements(abstractT) {
+		return &InvalidBindingError{Reason: fmt.Sprintf("%v does not implement %v", concreteT, abstractT)}
+	}
+
+	return n.bindType(abstractT, concreteT, options)
+}
+
+// bindType registers concreteT, a pointer to struct, for abstractT with
+// the lifetime, name and tags collected from BindOption values.
+func (n *Nasc) bindType(abstractT, concreteT reflect.Type, options bindOptions) error {
+	binding, err := typeBinding(abstractT, concreteT, options)
+	if err != nil {
+		return err
+	}
+	if binding.Name != "" {
+		return n.registerNamed(binding)
+	}
+	return n.register(binding)
+}
+
+// typeBinding builds the binding registered by bindType.
+func typeBinding(abstractT, concreteT reflect.Type, options bindOptions) (*registry.Binding, error) {
+	switch options.lifetime {
+	case LifetimeTransient, LifetimeSingleton, LifetimeScoped:
+	default:
+		if _, ok := cachedTTL(options.lifetime); ok {
+			break
+		}
+		return nil, &InvalidBindingError{Reason: fmt.Sprintf("the %s lifetime is not supported for type bindings", options.lifetime)}
+	}
+
+	binding := &registry.Binding{
+		AbstractType:    abstractT,
+		ConcreteType:    concreteT,
+		Lifetime:        string(options.lifetime),
+		Tags:            options.tags,
+		AutoWireEnabled: options.autoWire,
+		Priority:        options.priority,
+		Meta:            options.meta,
+	}
+	switch {
+	case len(options.tags) > 0:
+		binding.Name = fmt.Sprintf("%s%s_%v", tagBindingPrefix, options.tags[0], concreteT)
+		if options.name != "" {
+			return nil, &InvalidBindingError{Reason: "a binding cannot have both a name and tags"}
+		}
+	case options.name != "":
+		binding.Name = options.name
+	}
+	return binding, nil
+}
@@ -0,0 +1,111 @@
+package nasc
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestAs_Binds(t *testing.T) {
+	container := New()
+	if err := As[Logger, ConsoleLogger](container); err != nil {
+		t.Fatalf("As failed: %v", err)
+	}
+	if _, ok := container.Make((*Logger)(nil)).(*ConsoleLogger); !ok {
+		t.Error("As should bind the interface to *T")
+	}
+
+	_ = As[Database, MockDB](container, WithLifetime(LifetimeSingleton))
+	if container.Make((*Database)(nil)) != container.Make((*Database)(nil)) {
+		t.Error("WithLifetime should apply the singleton lifetime")
+	}
+}
+
+func TestAs_NamedAndTagged(t *testing.T) {
+	container := New()
+	_ = As[NotificationService, SMSNotifier](container, WithName("sms"))
+	_ = As[NotificationService, EmailNotifier](container, WithTags("notify"))
+
+	if _, ok := container.MakeNamed((*NotificationService)(nil), "sms").(*SMSNotifier); !ok {
+		t.Error("WithName should register a named binding")
+	}
+	if got := container.MakeWithTag("notify"); len(got) != 1 {
+		t.Errorf("WithTags should register a tagged binding, got %d", len(got))
+	}
+}
+
+func TestAs_Priority(t *testing.T) {
+	container := New()
+	_ = As[NotificationService, EmailNotifier](container, WithTags("notify"))
+	_ = As[NotificationService, SMSNotifier](container, WithTags("notify"), WithPriority(-5))
+	_ = As[NotificationService, PushNotifier](container, WithTags("notify"), WithPriority(5))
+
+	for i := 0; i < 3; i++ {
+		got := container.MakeWithTag("notify")
+		_, push := got[0].(*PushNotifier)
+		_, email := got[1].(*EmailNotifier)
+		_, sms := got[2].(*SMSNotifier)
+		if !push || !email || !sms {
+			t.Fatalf("MakeWithTag() = %T, %T, %T; want push, email, sms", got[0], got[1], got[2])
+		}
+	}
+}
+
+func TestAs_Meta(t *testing.T) {
+	container := New()
+	_ = As[NotificationService, EmailNotifier](container, WithTags("handler"), WithMeta("version", "1"))
+	_ = As[NotificationService, SMSNotifier](container, WithTags("handler"), WithMeta("version", "2"), WithMeta("channel", "sms"))
+	_ = As[NotificationService, PushNotifier](container, WithTags("handler"), WithMeta("version", "2"))
+
+	v2 := container.MakeWhere(func(meta Metadata) bool { return meta["version"] == "2" })
+	if len(v2) != 2 {
+		t.Fatalf("MakeWhere(version=2) returned %d instances, want 2", len(v2))
+	}
+	if _, ok := v2[0].(*SMSNotifier); !ok {
+		t.Errorf("MakeWhere should keep registration order, got %T first", v2[0])
+	}
+
+	var meta Metadata
+	for _, info := range container.Bindings() {
+		if info.Concrete == reflect.TypeOf(&SMSNotifier{}) {
+			meta = info.Meta
+		}
+	}
+	if meta["channel"] != "sms" || meta["version"] != "2" {
+		t.Errorf("BindingInfo.Meta = %v, want the binding's metadata", meta)
+	}
+}
+
+func TestAs_Invalid(t *testing.T) {
+	container := New()
+	var invalid *InvalidBindingError
+
+	if err := As[Logger, MockDB](container); !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidBindingError for non-implementing type, got %v", err)
+	}
+	if err := As[ConsoleLogger, ConsoleLogger](container); !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidBindingError for non-interface, got %v", err)
+	}
+	if err := As[Logger, ConsoleLogger](container, WithLifetime(LifetimeFactory)); !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidBindingError for factory lifetime, got %v", err)
+	}
+	if err := As[Logger, ConsoleLogger](container, WithName("a"), WithTags("b")); !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidBindingError for name and tags, got %v", err)
+	}
+}
+
+func TestAsChecked(t *testing.T) {
+	container := New()
+	err := AsChecked(container, func(db *MockDB) Database { return db }, WithLifetime(LifetimeSingleton))
+	if err != nil {
+		t.Fatalf("AsChecked failed: %v", err)
+	}
+	if _, ok := container.Make((*Database)(nil)).(*MockDB); !ok {
+		t.Error("AsChecked should bind the interface to *T")
+	}
+
+	var invalid *InvalidBindingError
+	if err := AsChecked[Logger, ConsoleLogger](container, nil); !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidBindingError without a conversion function, got %v", err)
+	}
+}
@@ -11,6 +11,11 @@ type tagOptions struct {
 	skip     bool   // Don't inject this field
 	optional bool   // Don't panic if binding not found
 	name     string // Named binding to use
+	asMap    bool   // Inject named bindings as map[string]T
+	scopeKey string // Scope value to inject (see WithScopeValue)
+	group    string // Tag to register a result field under (see Out)
+	wire     bool   // Auto-wire the nested struct instead of resolving it
+	tag      string // Tag whose bindings fill a slice field (see MakeWithTag)
 }
 
 // parseInjectTag parses an inject struct tag and returns options.
@@ -19,6 +24,11 @@ type tagOptions struct {
 //   - `inject:"optional"` - optional injection
 //   - `inject:"name=foo"` - named binding
 //   - `inject:"optional,name=foo"` - combined options
+//   - `inject:"map"` - named bindings as map[string]T
+//   - `inject:"scope=traceID"` - scope value
+//   - `inject:"group=handlers"` - result field group (see Out)
+//   - `inject:"wire"` - auto-wire a nested struct (see AutoWire)
+//   - `inject:"tag=plugin"` - tagged bindings as a slice
 func parseInjectTag(tag string) tagOptions {
 	opts := tagOptions{}
 
@@ -38,6 +48,16 @@ func parseInjectTag(tag string) tagOptions {
 
 		if part == "optional" {
 			opts.optional = true
+		} else if part == "map" {
+			opts.asMap = true
+		} else if part == "wire" {
+			opts.wire = true
+		} else if strings.HasPrefix(part, "scope=") {
+			opts.scopeKey = strings.TrimPrefix(part, "scope=")
+		} else if strings.HasPrefix(part, "tag=") {
+			opts.tag = strings.TrimPrefix(part, "tag=")
+		} else if strings.HasPrefix(part, "group=") {
+			opts.group = strings.TrimPrefix(part, "group=")
 		} else if strings.HasPrefix(part, "name=") {
 			opts.name = strings.TrimPrefix(part, "name=")
 		}
@@ -48,11 +68,10 @@ func parseInjectTag(tag string) tagOptions {
 
 // autoWireFieldInfo holds metadata about a field to inject.
 type autoWireFieldInfo struct {
-	field       reflect.StructField
-	fieldValue  reflect.Value
-	options     tagOptions
-	fieldType   reflect.Type
-	isInterface bool
+	field      reflect.StructField
+	fieldValue reflect.Value
+	options    tagOptions
+	fieldType  reflect.Type
 }
 
 // getInjectableFields scans a struct and returns fields that need injection.
@@ -74,25 +93,33 @@ func (n *Nasc) getInjectableFields(structValue reflect.Value) []autoWireFieldInf
 	cachedFields := n.reflectionCache.getFieldInfo(structType)
 
 	for _, cached := range cachedFields {
-		if !cached.isInjectable {
+		tag, tagged := cached.tag.Lookup("inject")
+		if !tagged {
 			continue
 		}
-
-		fieldValue := structValue.Field(cached.index)
-		tag := cached.tag.Get("inject")
 		opts := parseInjectTag(tag)
-
 		if opts.skip {
 			continue
 		}
 
+		fieldValue := structValue.Field(cached.index)
+		if !cached.isInjectable {
+			switch {
+			case n.unexportedInjection:
+				fieldValue = exposeField(fieldValue)
+			case cached.embedded && opts.wire:
+				// Embedded structs of unexported types can be wired in place
+			default:
+				continue
+			}
+		}
+
 		// Store field info
 		info := autoWireFieldInfo{
-			field:       structType.Field(cached.index),
-			fieldValue:  fieldValue,
-			options:     opts,
-			fieldType:   cached.typ,
-			isInterface: cached.typ.Kind() == reflect.Interface,
+			field:      structType.Field(cached.index),
+			fieldValue: fieldValue,
+			options:    opts,
+			fieldType:  cached.typ,
 		}
 
 		fields = append(fields, info)
@@ -108,6 +135,20 @@ func (n *Nasc) getInjectableFields(structValue reflect.Value) []autoWireFieldInf
 //   - `inject:""` - basic injection (panics if not found)
 //   - `inject:"optional"` - optional (skips if not found)
 //   - `inject:"name=foo"` - uses named binding
+//   - `inject:"map"` - named bindings of a map[string]T field, keyed by name
+//   - `inject:"tag=plugin"` - every binding tagged plugin, in a []T field
+//   - `inject:"scope=traceID"` - a scope value; only set for instances
+//     created by a scope (see WithScopeValue)
+//   - `inject:"wire"` - a nested struct, struct pointer or embedded struct
+//     whose own tagged fields are wired in turn; nil pointers are allocated
+//
+// Fields of type *Lazy[T], Optional[T], func() T, []T and map[string]T
+// are filled as described for constructor parameters (see ConstructorFunc).
+// Concrete fields such as *Config are injected when their type is bound.
+// Unbound *Nasc, *Scope and context.Context fields receive the container,
+// the scope creating the instance and its context.
+// Unexported fields are ignored unless the container was created with
+// WithUnexportedInjection.
 //
 // Example:
 //
@@ -119,7 +160,27 @@ func (n *Nasc) getInjectableFields(structValue reflect.Value) []autoWireFieldInf
 //
 //	service := &Service{}
 //	container.AutoWire(service)
+//
+// Nested structs let a large aggregate be assembled in one call:
+//
+//	type Handlers struct {
+//	    Users  *UserHandler  `inject:"wire"`
+//	    Orders *OrderHandler `inject:"wire"`
+//	}
 func (n *Nasc) AutoWire(instance interface{}) error {
+	return n.autoWire(instance, nil)
+}
+
+// autoWire injects tagged fields of instance. The consumer is the abstract
+// type the instance was resolved for (if any); together with the instance's
+// own type it selects contextual bindings.
+func (n *Nasc) autoWire(instance interface{}, consumer reflect.Type) error {
+	return n.autoWireWith(instance, consumer, n)
+}
+
+// autoWireWith injects tagged fields, resolving dependencies with r.
+// Scopes pass themselves so scoped fields are honored.
+func (n *Nasc) autoWireWith(instance interface{}, consumer reflect.Type, r resolver) error {
 	if instance == nil {
 		return fmt.Errorf("cannot auto-wire nil instance")
 	}
@@ -134,12 +195,53 @@ func (n *Nasc) AutoWire(instance interface{}) error {
 		return fmt.Errorf("AutoWire requires a pointer to struct, got pointer to %v", elem.Kind())
 	}
 
+	state := &wireState{visited: make(map[wiredStruct]bool), path: make(map[reflect.Type]bool)}
+	return n.wireStruct(value, consumer, r, state)
+}
+
+// wiredStruct identifies a struct wired by one AutoWire call. The type is
+// part of the key because an embedded struct shares its parent's address.
+type wiredStruct struct {
+	ptr uintptr
+	t   reflect.Type
+}
+
+// wireState tracks one AutoWire call through nested structs. Visited holds
+// the structs already wired so shared and cyclic pointers are wired once;
+// path holds the struct types being wired, which are never allocated again
+// below themselves.
+type wireState struct {
+	visited map[wiredStruct]bool
+	path    map[reflect.Type]bool
+}
+
+// wireStruct injects the tagged fields of the struct value points to,
+// descending into `inject:"wire"` fields.
+func (n *Nasc) wireStruct(value reflect.Value, consumer reflect.Type, r resolver, state *wireState) error {
+	key := wiredStruct{ptr: value.Pointer(), t: value.Type()}
+	if state.visited[key] {
+		return nil
+	}
+	state.visited[key] = true
+
+	if !state.path[value.Type()] {
+		state.path[value.Type()] = true
+		defer delete(state.path, value.Type())
+	}
+
 	// Get fields that need injection
 	fields := n.getInjectableFields(value)
+	consumers := consumerTypes(consumer, value.Type())
 
 	// Inject each field
 	for i := range fields {
-		if err := n.injectField(&fields[i]); err != nil {
+		if fields[i].options.wire {
+			if err := n.wireNested(&fields[i], r, state); err != nil {
+				return fmt.Errorf("failed to wire field %s: %w", fields[i].field.Name, err)
+			}
+			continue
+		}
+		if err := n.injectField(&fields[i], consumers, r); err != nil {
 			return fmt.Errorf("failed to inject field %s: %w", fields[i].field.Name, err)
 		}
 	}
@@ -148,36 +250,71 @@ func (n *Nasc) AutoWire(instance interface{}) error {
 }
 
 // injectField injects a single field.
-func (n *Nasc) injectField(field *autoWireFieldInfo) error {
+func (n *Nasc) injectField(field *autoWireFieldInfo, consumers []reflect.Type, r resolver) error {
 	if !field.fieldValue.CanSet() {
 		return fmt.Errorf("field %s is not settable (not exported?)", field.field.Name)
 	}
 
-	// Create type token for resolution
-	var typeToken interface{}
-	if field.isInterface {
-		// For interface fields, we need to create a nil pointer to the interface type
-		typeToken = reflect.Zero(reflect.PointerTo(field.fieldType)).Interface()
-	} else {
-		return fmt.Errorf("only interface fields are supported for injection, got %v", field.fieldType)
+	// Scope values are injected by the scope that creates the instance
+	if field.options.scopeKey != "" {
+		return nil
+	}
+
+	if field.options.tag != "" {
+		return injectTagged(field, r)
 	}
 
+	if field.options.asMap && !isNamedMap(field.fieldType) {
+		return fmt.Errorf("inject:\"map\" requires a map[string]T field with interface T, got %v", field.fieldType)
+	}
+
+	// Contextual bindings apply to unnamed dependencies
+	if field.options.name == "" {
+		if contextual, ok := n.contextual.lookup(field.fieldType, consumers...); ok {
+			value, err := n.contextualValue(contextual, field.fieldType, r, nil)
+			if err != nil {
+				if field.options.optional {
+					return nil
+				}
+				return err
+			}
+			field.fieldValue.Set(value)
+			return nil
+		}
+	}
+
+	// Wrapper types such as *Lazy[T] are built rather than resolved
+	if value, ok, err := n.resolveInjectable(field.fieldType, r); ok {
+		if err != nil {
+			if field.options.optional {
+				return nil
+			}
+			return err
+		}
+		field.fieldValue.Set(value)
+		return nil
+	}
+
+	// Concrete types such as *Config are resolved like interfaces, so r
+	// reports them if they are not bound
+	typeToken := reflect.Zero(reflect.PointerTo(field.fieldType)).Interface()
+
 	// Try to resolve
 	var resolved interface{}
 	var resolveErr error
 
 	func() {
 		defer func() {
-			if r := recover(); r != nil {
-				resolveErr = fmt.Errorf("resolution panicked: %v", r)
+			if p := recover(); p != nil {
+				resolveErr = fmt.Errorf("resolution panicked: %v", p)
 			}
 		}()
 
 		// Check if this is a named dependency
 		if field.options.name != "" {
-			resolved = n.MakeNamed(typeToken, field.options.name)
+			resolved = r.MakeNamed(typeToken, field.options.name)
 		} else {
-			resolved = n.Make(typeToken)
+			resolved = r.Make(typeToken)
 		}
 	}()
 
@@ -191,12 +328,73 @@ func (n *Nasc) injectField(field *autoWireFieldInfo) error {
 	}
 
 	// Set the field value
-	resolvedValue := reflect.ValueOf(resolved)
-	if !resolvedValue.Type().AssignableTo(field.fieldType) {
-		return fmt.Errorf("resolved type %v is not assignable to field type %v",
-			resolvedValue.Type(), field.fieldType)
+	resolvedValue, err := paramValue(resolved, field.fieldType)
+	if err != nil {
+		return err
 	}
 
 	field.fieldValue.Set(resolvedValue)
 	return nil
 }
+
+// injectTagged fills a slice field with the instances r resolves for the
+// field's tag, in registration order (see MakeWithTag).
+func injectTagged(field *autoWireFieldInfo, r resolver) error {
+	if field.fieldType.Kind() != reflect.Slice {
+		return fmt.Errorf("inject:\"tag=%s\" requires a slice field, got %v", field.options.tag, field.fieldType)
+	}
+	elemType := field.fieldType.Elem()
+
+	var instances []interface{}
+	var resolveErr error
+	func() {
+		defer func() {
+			if p := recover(); p != nil {
+				resolveErr = fmt.Errorf("resolution panicked: %v", p)
+			}
+		}()
+		instances = r.MakeWithTag(field.options.tag)
+	}()
+	if resolveErr != nil {
+		if field.options.optional {
+			return nil
+		}
+		return resolveErr
+	}
+
+	slice := reflect.MakeSlice(field.fieldType, 0, len(instances))
+	for _, instance := range instances {
+		value, err := paramValue(instance, elemType)
+		if err != nil {
+			return fmt.Errorf("tag %q: %w", field.options.tag, err)
+		}
+		slice = reflect.Append(slice, value)
+	}
+	field.fieldValue.Set(slice)
+	return nil
+}
+
+// wireNested auto-wires the struct held by an `inject:"wire"` field,
+// allocating it first if the field is a nil pointer. A nil pointer to a
+// struct already being wired is left nil rather than recursing forever.
+func (n *Nasc) wireNested(field *autoWireFieldInfo, r resolver, state *wireState) error {
+	switch {
+	case field.fieldType.Kind() == reflect.Struct:
+		return n.wireStruct(field.fieldValue.Addr(), nil, r, state)
+
+	case field.fieldType.Kind() == reflect.Ptr && field.fieldType.Elem().Kind() == reflect.Struct:
+		if field.fieldValue.IsNil() {
+			if state.path[field.fieldType] {
+				return nil
+			}
+			if !field.fieldValue.CanSet() {
+				return fmt.Errorf("nil embedded pointer of unexported type %v cannot be allocated", field.fieldType)
+			}
+			field.fieldValue.Set(reflect.New(field.fieldType.Elem()))
+		}
+		return n.wireStruct(field.fieldValue, nil, r, state)
+
+	default:
+		return fmt.Errorf("inject:\"wire\" requires a struct or pointer to struct field, got %v", field.fieldType)
+	}
+}
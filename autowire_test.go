@@ -1,6 +1,9 @@
 package nasc
 
 import (
+	"bytes"
+	"errors"
+	"strings"
 	"testing"
 )
 
@@ -257,3 +260,300 @@ func TestBindAutoWire_Errors(t *testing.T) {
 		t.Error("BindAutoWire with non-struct should return error")
 	}
 }
+
+func TestBindAutoWire_SingletonLifetime(t *testing.T) {
+	c := New()
+	c.Bind((*Logger)(nil), &ConsoleLogger{})
+	c.Bind((*Database)(nil), &MockDB{})
+
+	err := c.BindAutoWire((*ServiceWithDeps)(nil), &ServiceWithDeps{}, WithLifetime(LifetimeSingleton))
+	if err != nil {
+		t.Fatalf("BindAutoWire() error = %v", err)
+	}
+
+	first := c.Make((*ServiceWithDeps)(nil)).(*ServiceWithDeps)
+	if first.Logger == nil || first.Database == nil {
+		t.Fatal("tagged fields were not injected")
+	}
+	if second := c.Make((*ServiceWithDeps)(nil)).(*ServiceWithDeps); second != first {
+		t.Error("singleton auto-wired binding returned different instances")
+	}
+}
+
+func TestBindAutoWire_MakeSafe(t *testing.T) {
+	c := New()
+	c.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	if err := c.BindAutoWire((*ServiceWithOptional)(nil), &ServiceWithOptional{}, WithLifetime(LifetimeSingleton)); err != nil {
+		t.Fatalf("BindAutoWire() error = %v", err)
+	}
+
+	instance, err := c.MakeSafe((*ServiceWithOptional)(nil))
+	if err != nil {
+		t.Fatalf("MakeSafe() error = %v", err)
+	}
+	if instance.(*ServiceWithOptional).Logger == nil {
+		t.Error("Logger was not injected")
+	}
+}
+
+func TestBindAutoWire_MakeSafeMissingDependency(t *testing.T) {
+	c := New()
+	c.BindAutoWire((*ServiceWithDeps)(nil), &ServiceWithDeps{})
+
+	_, err := c.MakeSafe((*ServiceWithDeps)(nil))
+	var resErr *ResolutionError
+	if !errors.As(err, &resErr) {
+		t.Fatalf("MakeSafe() error = %v, want *ResolutionError", err)
+	}
+}
+
+func TestBindAutoWire_ScopedLifetime(t *testing.T) {
+	c := New()
+	c.Scoped((*Logger)(nil), &ConsoleLogger{})
+	c.Bind((*Database)(nil), &MockDB{})
+	c.BindAutoWire((*ServiceWithDeps)(nil), &ServiceWithDeps{}, WithLifetime(LifetimeScoped))
+
+	scope := c.CreateScope()
+	defer scope.Dispose()
+
+	service := scope.Make((*ServiceWithDeps)(nil)).(*ServiceWithDeps)
+	if service != scope.Make((*ServiceWithDeps)(nil)) {
+		t.Error("scoped auto-wired binding returned different instances in one scope")
+	}
+	if service.Logger != scope.Make((*Logger)(nil)) {
+		t.Error("scoped field was not resolved from the scope")
+	}
+}
+
+type serviceWithNamedLogger struct {
+	Logger Logger `inject:"name=request"`
+}
+
+func TestBindAutoWire_ScopedNamedField(t *testing.T) {
+	c := New()
+	_ = As[Logger, ConsoleLogger](c, WithName("request"), WithLifetime(LifetimeScoped))
+	_ = c.BindAutoWire((*serviceWithNamedLogger)(nil), &serviceWithNamedLogger{}, WithLifetime(LifetimeScoped))
+
+	scope := c.CreateScope()
+	defer scope.Dispose()
+
+	service := scope.Make((*serviceWithNamedLogger)(nil)).(*serviceWithNamedLogger)
+	if service.Logger != scope.MakeNamed((*Logger)(nil), "request") {
+		t.Error("named scoped field was not resolved from the scope")
+	}
+}
+
+type wiredRepository struct {
+	Database Database `inject:""`
+}
+
+type wiredHandler struct {
+	Logger Logger           `inject:""`
+	Repo   *wiredRepository `inject:"wire"`
+}
+
+type wiredBase struct {
+	Logger Logger `inject:""`
+}
+
+type wiredApp struct {
+	wiredBase `inject:"wire"`
+	Handler   *wiredHandler `inject:"wire"`
+	Settings  struct {
+		Database Database `inject:""`
+	} `inject:"wire"`
+	Self *wiredApp `inject:"wire"`
+}
+
+func TestAutoWire_Nested(t *testing.T) {
+	c := New()
+	c.Bind((*Logger)(nil), &ConsoleLogger{})
+	c.Bind((*Database)(nil), &MockDB{})
+
+	app := &wiredApp{}
+	app.Self = app
+	if err := c.AutoWire(app); err != nil {
+		t.Fatalf("AutoWire() error = %v", err)
+	}
+
+	if app.Logger == nil {
+		t.Error("embedded struct was not wired")
+	}
+	if app.Handler == nil || app.Handler.Logger == nil {
+		t.Fatal("nil struct pointer was not allocated and wired")
+	}
+	if app.Handler.Repo == nil || app.Handler.Repo.Database == nil {
+		t.Error("second level was not wired")
+	}
+	if app.Settings.Database == nil {
+		t.Error("struct value field was not wired")
+	}
+	if app.Self != app {
+		t.Error("self reference was replaced")
+	}
+}
+
+func TestAutoWire_NestedKeepsExistingPointer(t *testing.T) {
+	c := New()
+	c.Bind((*Logger)(nil), &ConsoleLogger{})
+	c.Bind((*Database)(nil), &MockDB{})
+
+	handler := &wiredHandler{}
+	app := &wiredApp{Handler: handler}
+	if err := c.AutoWire(app); err != nil {
+		t.Fatalf("AutoWire() error = %v", err)
+	}
+	if app.Handler != handler || handler.Logger == nil {
+		t.Error("existing nested struct was not wired in place")
+	}
+}
+
+func TestAutoWire_NestedErrors(t *testing.T) {
+	c := New()
+	c.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	err := c.AutoWire(&wiredHandler{})
+	if err == nil || !strings.Contains(err.Error(), "Repo") {
+		t.Errorf("AutoWire() error = %v, want failure naming the nested field", err)
+	}
+
+	type badWire struct {
+		Logger Logger `inject:"wire"`
+	}
+	if err := c.AutoWire(&badWire{}); err == nil {
+		t.Error("AutoWire() should reject wire on a non-struct field")
+	}
+}
+
+func TestBindAutoWire_NestedDependencies(t *testing.T) {
+	c := New()
+	c.Bind((*Logger)(nil), &ConsoleLogger{})
+	c.BindAutoWire((*wiredHandler)(nil), &wiredHandler{})
+
+	var buf bytes.Buffer
+	if err := c.PrintTree(&buf, (*wiredHandler)(nil)); err != nil {
+		t.Fatalf("PrintTree() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), "Database") {
+		t.Errorf("tree is missing the nested Database dependency:\n%s", buf.String())
+	}
+}
+
+type serviceWithConfig struct {
+	Config  *appConfig `inject:""`
+	Replica *appConfig `inject:"name=replica"`
+	Port    Port       `inject:"optional"`
+}
+
+func TestAutoWire_ConcreteFields(t *testing.T) {
+	c := New()
+	cfg := &appConfig{DSN: "postgres://"}
+	_ = c.BindInstanceAs(cfg, (**appConfig)(nil))
+	_ = c.BindNamed((**appConfig)(nil), &appConfig{}, "replica")
+
+	service := &serviceWithConfig{}
+	if err := c.AutoWire(service); err != nil {
+		t.Fatalf("AutoWire() error = %v", err)
+	}
+	if service.Config != cfg {
+		t.Errorf("Config = %v, want the bound instance", service.Config)
+	}
+	if service.Replica == nil || service.Replica == cfg {
+		t.Errorf("Replica = %v, want the named binding", service.Replica)
+	}
+	if service.Port != 0 {
+		t.Errorf("Port = %v, want it left unset", service.Port)
+	}
+}
+
+type serviceWithScopeConfig struct {
+	Config *appConfig `inject:""`
+}
+
+func TestBindAutoWire_ScopeBoundConcreteField(t *testing.T) {
+	c := New()
+	_ = c.BindAutoWire((*serviceWithScopeConfig)(nil), &serviceWithScopeConfig{}, WithLifetime(LifetimeScoped))
+
+	scope := c.CreateScope()
+	defer scope.Dispose()
+	cfg := &appConfig{DSN: "tenant://"}
+	_ = scope.BindInstance((**appConfig)(nil), cfg)
+
+	if service := scope.Make((*serviceWithScopeConfig)(nil)).(*serviceWithScopeConfig); service.Config != cfg {
+		t.Errorf("Config = %v, want the scope's instance", service.Config)
+	}
+}
+
+func TestAutoWire_UnboundConcreteField(t *testing.T) {
+	c := New()
+
+	err := c.AutoWire(&serviceWithConfig{})
+	if err == nil || !strings.Contains(err.Error(), "Config") {
+		t.Errorf("AutoWire() error = %v, want failure naming Config", err)
+	}
+}
+
+type serviceWithTaggedLoggers struct {
+	Loggers []Logger   `inject:"tag=logger"`
+	Dbs     []Database `inject:"tag=db"`
+}
+
+func TestAutoWire_TaggedSlice(t *testing.T) {
+	c := New()
+	_ = c.BindWithTags((*Logger)(nil), &ConsoleLogger{}, []string{"logger"})
+	_ = c.BindWithTags((*Logger)(nil), &FileLogger{}, []string{"logger"})
+
+	service := &serviceWithTaggedLoggers{}
+	if err := c.AutoWire(service); err != nil {
+		t.Fatalf("AutoWire() error = %v", err)
+	}
+	if len(service.Loggers) != 2 {
+		t.Fatalf("len(Loggers) = %d, want 2", len(service.Loggers))
+	}
+	if _, ok := service.Loggers[0].(*ConsoleLogger); !ok {
+		t.Errorf("Loggers[0] = %T, want registration order", service.Loggers[0])
+	}
+	if service.Dbs == nil || len(service.Dbs) != 0 {
+		t.Errorf("Dbs = %v, want an empty slice for an unused tag", service.Dbs)
+	}
+
+	var buf bytes.Buffer
+	c.BindAutoWire((*serviceWithTaggedLoggers)(nil), &serviceWithTaggedLoggers{})
+	if err := c.PrintTree(&buf, (*serviceWithTaggedLoggers)(nil)); err != nil {
+		t.Fatalf("PrintTree() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), "<tag=logger> (2 bindings)") {
+		t.Errorf("tree is missing the tag edge:\n%s", buf.String())
+	}
+}
+
+func TestBindAutoWire_ScopedTaggedSlice(t *testing.T) {
+	c := New()
+	_ = As[Logger, ConsoleLogger](c, WithTags("logger"), WithLifetime(LifetimeScoped))
+	_ = c.BindAutoWire((*serviceWithTaggedLoggers)(nil), &serviceWithTaggedLoggers{}, WithLifetime(LifetimeScoped))
+
+	scope := c.CreateScope()
+	defer scope.Dispose()
+
+	service := scope.Make((*serviceWithTaggedLoggers)(nil)).(*serviceWithTaggedLoggers)
+	if len(service.Loggers) != 1 || service.Loggers[0] != scope.MakeWithTag("logger")[0] {
+		t.Errorf("Loggers = %v, want the scope's tagged instances", service.Loggers)
+	}
+}
+
+func TestAutoWire_TaggedSliceErrors(t *testing.T) {
+	c := New()
+	_ = c.BindWithTags((*Logger)(nil), &ConsoleLogger{}, []string{"db"})
+
+	if err := c.AutoWire(&serviceWithTaggedLoggers{}); err == nil || !strings.Contains(err.Error(), "Dbs") {
+		t.Errorf("AutoWire() error = %v, want a mismatch on Dbs", err)
+	}
+
+	type notSlice struct {
+		Logger Logger `inject:"tag=logger"`
+	}
+	if err := c.AutoWire(&notSlice{}); err == nil {
+		t.Error("AutoWire() should reject tag= on a non-slice field")
+	}
+}
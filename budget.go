@@ -0,0 +1,145 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+	"sync/atomic"
+	"time"
+)
+
+// BudgetAction selects what a scope does when its budget is exceeded.
+type BudgetAction int
+
+const (
+	// BudgetFail makes the offending Scope.Make panic with a
+	// BudgetExceededError. An instance that took too long to create is
+	// disposed and discarded.
+	BudgetFail BudgetAction = iota
+
+	// BudgetReport delivers a BudgetExceededError through Errors and
+	// WithOnError, once per limit and scope, and lets resolution continue.
+	BudgetReport
+)
+
+// ScopeBudget limits the work one scope may do, catching accidental
+// per-request construction of heavyweight object graphs that should have
+// been singletons. Zero limits are unlimited.
+type ScopeBudget struct {
+	// MaxResolutions is the maximum number of Scope.Make calls, including
+	// those made to build constructor dependencies
+	MaxResolutions int
+
+	// MaxConstructorTime is the maximum time the scope may spend creating
+	// one instance, including its dependencies
+	MaxConstructorTime time.Duration
+
+	// Action is what happens when a limit is exceeded
+	Action BudgetAction
+}
+
+// ScopeUsage reports the work a scope has done (see Scope.Usage).
+type ScopeUsage struct {
+	// Resolutions is the number of Scope.Make calls
+	Resolutions int
+
+	// SlowestConstructor is the longest time spent creating one instance
+	SlowestConstructor time.Duration
+}
+
+// WithScopeBudget applies budget to every scope the container creates.
+//
+// Example:
+//
+//	container := nasc.New(nasc.WithScopeBudget(nasc.ScopeBudget{
+//	    MaxResolutions:     200,
+//	    MaxConstructorTime: 50 * time.Millisecond,
+//	    Action:             nasc.BudgetReport,
+//	}))
+func WithScopeBudget(budget ScopeBudget) Option {
+	return func(n *Nasc) error {
+		if budget.MaxResolutions < 0 || budget.MaxConstructorTime < 0 {
+			return fmt.Errorf("scope budget limits cannot be negative")
+		}
+		n.scopeBudget = budget
+		return nil
+	}
+}
+
+// SetBudget replaces the budget of this scope. Child scopes created
+// afterwards inherit it. Work already done counts against the new budget.
+//
+// Example:
+//
+//	scope := container.CreateScope()
+//	scope.SetBudget(nasc.ScopeBudget{MaxResolutions: 50})
+func (s *Scope) SetBudget(budget ScopeBudget) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.budget = budget
+}
+
+// Usage returns the work this scope has done so far.
+func (s *Scope) Usage() ScopeUsage {
+	return ScopeUsage{
+		Resolutions:        int(s.resolutions.Load()),
+		SlowestConstructor: time.Duration(s.slowest.Load()),
+	}
+}
+
+// spendResolution counts a resolution of t against the budget.
+func (s *Scope) spendResolution(t reflect.Type) {
+	count := int(s.resolutions.Add(1))
+
+	s.mu.RLock()
+	budget := s.budget
+	s.mu.RUnlock()
+
+	if budget.MaxResolutions == 0 || count <= budget.MaxResolutions {
+		return
+	}
+	err := s.exceedBudget(budget, &BudgetExceededError{
+		Type:           t,
+		Resolutions:    count,
+		MaxResolutions: budget.MaxResolutions,
+	}, &s.reportedResolutions)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// spendConstructorTime records the time taken to create an instance of t.
+// It returns an error if the instance must be discarded.
+func (s *Scope) spendConstructorTime(t reflect.Type, elapsed time.Duration) error {
+	for {
+		slowest := s.slowest.Load()
+		if int64(elapsed) <= slowest || s.slowest.CompareAndSwap(slowest, int64(elapsed)) {
+			break
+		}
+	}
+
+	s.mu.RLock()
+	budget := s.budget
+	s.mu.RUnlock()
+
+	if budget.MaxConstructorTime == 0 || elapsed <= budget.MaxConstructorTime {
+		return nil
+	}
+	return s.exceedBudget(budget, &BudgetExceededError{
+		Type:               t,
+		ConstructorTime:    elapsed,
+		MaxConstructorTime: budget.MaxConstructorTime,
+	}, &s.reportedConstructorTime)
+}
+
+// exceedBudget handles a budget violation: it returns the error when the
+// budget fails resolutions, and reports it otherwise.
+func (s *Scope) exceedBudget(budget ScopeBudget, err *BudgetExceededError, reported *atomic.Bool) error {
+	if budget.Action == BudgetFail {
+		return err
+	}
+	if !reported.Swap(true) {
+		s.parent.reportError("scope budget", err.Type, err)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package nasc
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+type slowDB struct {
+	MockDB
+	disposed bool
+}
+
+func (d *slowDB) Dispose() error {
+	d.disposed = true
+	return nil
+}
+
+func TestScopeBudget_FailsOnResolutions(t *testing.T) {
+	container := New(WithScopeBudget(ScopeBudget{MaxResolutions: 2}))
+	_ = container.Scoped((*Database)(nil), &MockDB{})
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+	scope.Make((*Database)(nil))
+	scope.Make((*Database)(nil))
+
+	defer func() {
+		err, _ := recover().(error)
+		var budgetErr *BudgetExceededError
+		if !errors.As(err, &budgetErr) || budgetErr.Resolutions != 3 {
+			t.Errorf("expected BudgetExceededError after 3 resolutions, got %v", err)
+		}
+	}()
+	scope.Make((*Database)(nil))
+}
+
+func TestScopeBudget_FailsOnConstructorTime(t *testing.T) {
+	var created *slowDB
+	container := New()
+	_ = container.ScopedConstructor((*Database)(nil), func() *slowDB {
+		time.Sleep(5 * time.Millisecond)
+		created = &slowDB{}
+		return created
+	})
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+	scope.SetBudget(ScopeBudget{MaxConstructorTime: time.Millisecond})
+
+	func() {
+		defer func() {
+			if _, ok := recover().(*BudgetExceededError); !ok {
+				t.Error("expected BudgetExceededError")
+			}
+		}()
+		scope.Make((*Database)(nil))
+	}()
+
+	if !created.disposed {
+		t.Error("discarded instance should be disposed")
+	}
+	if usage := scope.Usage(); usage.Resolutions != 1 || usage.SlowestConstructor < 5*time.Millisecond {
+		t.Errorf("unexpected usage %+v", usage)
+	}
+}
+
+func TestScopeBudget_Reports(t *testing.T) {
+	var mu sync.Mutex
+	var reported []error
+	container := New(
+		WithScopeBudget(ScopeBudget{MaxResolutions: 1, Action: BudgetReport}),
+		WithOnError(func(err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			reported = append(reported, err)
+		}),
+	)
+	_ = container.Scoped((*Database)(nil), &MockDB{})
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+	for i := 0; i < 5; i++ {
+		scope.Make((*Database)(nil))
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	var budgetErr *BudgetExceededError
+	if len(reported) != 1 || !errors.As(reported[0], &budgetErr) {
+		t.Errorf("expected one reported budget error, got %v", reported)
+	}
+}
+
+func TestScopeBudget_ChildInherits(t *testing.T) {
+	container := New()
+	_ = container.Scoped((*Database)(nil), &MockDB{})
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+	scope.SetBudget(ScopeBudget{MaxResolutions: 1})
+
+	child := scope.CreateChildScope()
+	child.Make((*Database)(nil))
+	defer func() {
+		if recover() == nil {
+			t.Error("child scope should inherit the budget")
+		}
+	}()
+	child.Make((*Database)(nil))
+}
+
+func TestWithScopeBudget_Negative(t *testing.T) {
+	n := &Nasc{}
+	if err := WithScopeBudget(ScopeBudget{MaxResolutions: -1})(n); err == nil {
+		t.Error("expected error for negative limit")
+	}
+}
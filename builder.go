@@ -0,0 +1,77 @@
+package nasc
+
+import (
+	"fmt"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// ErrContainerFrozen is returned when registering on a frozen container.
+// It matches registry.ErrFrozen with errors.Is.
+var ErrContainerFrozen = registry.ErrFrozen
+
+// Builder collects registrations for a container that is frozen by Build.
+// All registration methods of Nasc are available on the builder.
+//
+// Example:
+//
+//	builder := nasc.NewBuilder()
+//	builder.Singleton((*Logger)(nil), &ConsoleLogger{})
+//	builder.RegisterProvider(&DatabaseProvider{})
+//
+//	container, err := builder.Build()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+type Builder struct {
+	*Nasc
+}
+
+// NewBuilder creates a builder for an immutable container.
+func NewBuilder(options ...Option) *Builder {
+	return &Builder{Nasc: New(options...)}
+}
+
+// Build activates pending conditional bindings, freezes the container,
+// creates singletons marked with Eager and returns it. The returned
+// container resolves without locking its registry and rejects further
+// registrations with ErrContainerFrozen.
+func (b *Builder) Build() (*Nasc, error) {
+	if b.IsFrozen() {
+		return nil, fmt.Errorf("container has already been built: %w", ErrContainerFrozen)
+	}
+
+	if err := b.applyConditionalBindings(); err != nil {
+		return nil, fmt.Errorf("conditional binding failed: %w", err)
+	}
+
+	b.Freeze()
+
+	if err := b.warmUpEager(); err != nil {
+		return nil, err
+	}
+	return b.Nasc, nil
+}
+
+// Freeze makes the container read-only. Bindings, contextual bindings,
+// conditional registrations, providers, and overrides can no longer be
+// added, and resolution skips registry locking.
+//
+// Freezing is permanent; use Clone to obtain a mutable copy. The first
+// call registers the lazy providers still waiting (see LazyProvider) and
+// delivers the report requested with WithSealingReport.
+func (n *Nasc) Freeze() {
+	if n.registry.IsFrozen() {
+		return
+	}
+	n.loadLazyProviders()
+	n.registry.Freeze()
+	if n.sealingReport != nil {
+		n.sealingReport(n.RegistrationStats())
+	}
+}
+
+// IsFrozen reports whether the container has been frozen.
+func (n *Nasc) IsFrozen() bool {
+	return n.registry.IsFrozen()
+}
@@ -0,0 +1,70 @@
+package nasc
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestBuilder_BuildFreezesContainer(t *testing.T) {
+	builder := NewBuilder()
+	_ = builder.Singleton((*Logger)(nil), &ConsoleLogger{})
+	_ = builder.BindIf(IfNotBound((*Database)(nil)), (*Database)(nil), &MockDB{})
+
+	container, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	if !container.IsFrozen() {
+		t.Error("built container should be frozen")
+	}
+
+	if _, err := container.MakeSafe((*Database)(nil)); err != nil {
+		t.Errorf("conditional binding should be active after Build: %v", err)
+	}
+
+	if err := container.Bind((*NotificationService)(nil), &EmailNotifier{}); !errors.Is(err, ErrContainerFrozen) {
+		t.Errorf("expected ErrContainerFrozen from Bind, got %v", err)
+	}
+	if err := container.RegisterProvider(&LoggingProvider{}); !errors.Is(err, ErrContainerFrozen) {
+		t.Errorf("expected ErrContainerFrozen from RegisterProvider, got %v", err)
+	}
+	if err := container.When((*ReportService)(nil)).Needs((*Logger)(nil)).Give((*FileLogger)(nil)); !errors.Is(err, ErrContainerFrozen) {
+		t.Errorf("expected ErrContainerFrozen from Give, got %v", err)
+	}
+
+	if _, err := builder.Build(); err == nil {
+		t.Error("building twice should fail")
+	}
+}
+
+func TestBuilder_ConcurrentResolution(t *testing.T) {
+	builder := NewBuilder()
+	_ = builder.Singleton((*Logger)(nil), &ConsoleLogger{})
+	_ = builder.BindNamed((*NotificationService)(nil), &EmailNotifier{}, "email")
+	container, _ := builder.Build()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			container.Make((*Logger)(nil))
+			container.MakeNamed((*NotificationService)(nil), "email")
+			container.MakeAll((*NotificationService)(nil))
+		}()
+	}
+	wg.Wait()
+}
+
+func TestFreeze_OverridePanics(t *testing.T) {
+	container := New()
+	container.Freeze()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic when overriding a frozen container")
+		}
+	}()
+	container.Override((*Logger)(nil), &ConsoleLogger{})
+}
@@ -0,0 +1,204 @@
+package nasc
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// cachedLifetimePrefix starts the lifetimes created by LifetimeCached.
+const cachedLifetimePrefix = "cached:"
+
+// LifetimeCached returns a lifetime under which an instance is reused until
+// ttl has passed since it was created, then rebuilt on the next resolution.
+// The expired instance is disposed if it implements Disposable. Use it for
+// clients holding credentials that rotate periodically.
+//
+// Example:
+//
+//	container.CachedConstructor((*StorageClient)(nil), NewStorageClient, 15*time.Minute)
+//	nasc.As[TokenSource, VaultTokens](container, nasc.WithLifetime(nasc.LifetimeCached(time.Hour)))
+func LifetimeCached(ttl time.Duration) Lifetime {
+	return Lifetime(cachedLifetimePrefix + ttl.String())
+}
+
+// cachedTTL reports whether l was created by LifetimeCached, and its TTL.
+func cachedTTL(l Lifetime) (time.Duration, bool) {
+	s, ok := strings.CutPrefix(string(l), cachedLifetimePrefix)
+	if !ok {
+		return 0, false
+	}
+	ttl, err := time.ParseDuration(s)
+	if err != nil || ttl <= 0 {
+		return 0, false
+	}
+	return ttl, true
+}
+
+// Cached registers a binding whose instance is reused for ttl (see
+// LifetimeCached). Returns an InvalidBindingError if ttl is not positive.
+//
+// Example:
+//
+//	container.Cached((*Credentials)(nil), &VaultCredentials{}, 10*time.Minute)
+func (n *Nasc) Cached(abstractType, concreteType interface{}, ttl time.Duration) error {
+	if abstractType == nil {
+		return &InvalidBindingError{Reason: "abstract type cannot be nil"}
+	}
+	if concreteType == nil {
+		return &InvalidBindingError{Reason: "concrete type cannot be nil"}
+	}
+	if ttl <= 0 {
+		return &InvalidBindingError{Reason: fmt.Sprintf("cache TTL must be positive, got %v", ttl)}
+	}
+
+	concreteT := reflect.TypeOf(concreteType)
+	if concreteT.Kind() != reflect.Ptr || concreteT.Elem().Kind() != reflect.Struct {
+		return &InvalidBindingError{
+			Reason: fmt.Sprintf("concrete type must be pointer to struct, got %v", concreteT),
+		}
+	}
+
+	binding := &registry.Binding{
+		AbstractType: typeOfToken(abstractType),
+		ConcreteType: concreteT,
+		Lifetime:     string(LifetimeCached(ttl)),
+	}
+	return n.register(binding)
+}
+
+// CachedConstructor registers a constructor binding whose instance is
+// reused for ttl (see LifetimeCached).
+//
+// Example:
+//
+//	container.CachedConstructor((*S3Client)(nil), NewS3Client, 15*time.Minute)
+func (n *Nasc) CachedConstructor(abstractType interface{}, constructor ConstructorFunc, ttl time.Duration, opts ...ConstructorOption) error {
+	if ttl <= 0 {
+		return &InvalidBindingError{Reason: fmt.Sprintf("cache TTL must be positive, got %v", ttl)}
+	}
+	return n.bindConstructorWithLifetime(abstractType, constructor, LifetimeCached(ttl), opts...)
+}
+
+// makeCached resolves a binding with a cached lifetime, panicking on
+// failure as Make does. The instance is built as MakeSafe builds it, with a
+// resolution path starting at the binding, so a dependency cycle leading
+// back to it is reported instead of waiting on its own cache entry.
+func (n *Nasc) makeCached(binding *registry.Binding, abstractT reflect.Type) interface{} {
+	ctx := newResolutionContext()
+	_ = ctx.push(resolutionKey(abstractT, binding.Name))
+
+	instance, err := n.createInstanceSafe(binding, abstractT, ctx)
+	if err != nil {
+		panic(fmt.Sprintf("failed to create cached instance for type %v: %v", abstractT, err))
+	}
+	return instance
+}
+
+// cachedStore holds the instances of bindings with a cached lifetime,
+// keyed by binding.
+type cachedStore struct {
+	mu      sync.Mutex
+	entries map[*registry.Binding]*cachedEntry
+}
+
+// cachedEntry is one cached instance and its expiry.
+type cachedEntry struct {
+	mu      sync.Mutex
+	value   interface{}
+	expires time.Time
+	valid   bool
+}
+
+// newCachedStore creates an empty cached instance store.
+func newCachedStore() *cachedStore {
+	return &cachedStore{entries: make(map[*registry.Binding]*cachedEntry)}
+}
+
+// entry returns the entry for a binding, creating it if needed.
+func (cs *cachedStore) entry(binding *registry.Binding) *cachedEntry {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	entry, ok := cs.entries[binding]
+	if !ok {
+		entry = &cachedEntry{}
+		cs.entries[binding] = entry
+	}
+	return entry
+}
+
+// lookup returns the cached instance for a binding if one exists and has
+// not expired, without creating it.
+func (cs *cachedStore) lookup(binding *registry.Binding) (interface{}, bool) {
+	cs.mu.Lock()
+	entry, ok := cs.entries[binding]
+	cs.mu.Unlock()
+	if !ok {
+		return nil, false
+	}
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	if !entry.valid || !time.Now().Before(entry.expires) {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// resolveCached returns the cached instance for a binding, calling create
+// when there is none or it has expired. Concurrent callers wait for one
+// creation, so create must report a cycle back to the binding itself
+// rather than resolve it (see makeCached). Failed creations are not cached.
+func (n *Nasc) resolveCached(binding *registry.Binding, create func() (interface{}, error)) (interface{}, error) {
+	entry := n.cached.entry(binding)
+
+	entry.mu.Lock()
+	if entry.valid && time.Now().Before(entry.expires) {
+		value := entry.value
+		entry.mu.Unlock()
+		return value, nil
+	}
+
+	value, err := create()
+	if err != nil {
+		entry.mu.Unlock()
+		return nil, err
+	}
+	expired, hadExpired := entry.value, entry.valid
+	entry.value, entry.expires, entry.valid = value, time.Now().Add(binding.TTL), true
+	entry.mu.Unlock()
+
+	// Dispose the replaced instance outside the lock; failures are reported
+	// since the caller is not responsible for them
+	if hadExpired {
+		if err := disposeInstance(context.Background(), expired, newTeardownResolver(n, nil)); err != nil {
+			n.reportError("dispose", binding.AbstractType, err)
+		}
+	}
+	return value, nil
+}
+
+// drain empties the store and returns the instances it held, including
+// expired ones that were never replaced and so never disposed.
+func (cs *cachedStore) drain() []interface{} {
+	cs.mu.Lock()
+	entries := cs.entries
+	cs.entries = make(map[*registry.Binding]*cachedEntry)
+	cs.mu.Unlock()
+
+	var values []interface{}
+	for _, entry := range entries {
+		entry.mu.Lock()
+		if entry.valid {
+			values = append(values, entry.value)
+		}
+		entry.mu.Unlock()
+	}
+	return values
+}
@@ -0,0 +1,202 @@
+package nasc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type rotatingClient struct {
+	id       int64
+	disposed atomic.Bool
+}
+
+func (c *rotatingClient) Dispose() error {
+	c.disposed.Store(true)
+	return nil
+}
+
+func newRotatingClientFactory(counter *atomic.Int64) func() *rotatingClient {
+	return func() *rotatingClient {
+		return &rotatingClient{id: counter.Add(1)}
+	}
+}
+
+func TestLifetimeCached_Roundtrip(t *testing.T) {
+	ttl, ok := cachedTTL(LifetimeCached(90 * time.Second))
+	if !ok || ttl != 90*time.Second {
+		t.Errorf("cachedTTL = %v, %v; want 1m30s, true", ttl, ok)
+	}
+	if _, ok := cachedTTL(LifetimeSingleton); ok {
+		t.Error("singleton lifetime should not parse as cached")
+	}
+	if _, ok := cachedTTL(LifetimeCached(0)); ok {
+		t.Error("zero TTL should not parse as cached")
+	}
+}
+
+func TestCachedConstructor_ReusesUntilExpiry(t *testing.T) {
+	var created atomic.Int64
+	container := New()
+	if err := container.CachedConstructor((*rotatingClient)(nil), newRotatingClientFactory(&created), 50*time.Millisecond); err != nil {
+		t.Fatalf("CachedConstructor failed: %v", err)
+	}
+
+	first := container.Make((*rotatingClient)(nil)).(*rotatingClient)
+	if again := container.Make((*rotatingClient)(nil)).(*rotatingClient); again != first {
+		t.Error("instance should be reused before the TTL expires")
+	}
+
+	time.Sleep(80 * time.Millisecond)
+
+	rebuilt := container.Make((*rotatingClient)(nil)).(*rotatingClient)
+	if rebuilt == first {
+		t.Error("instance should be rebuilt after the TTL expires")
+	}
+	if !first.disposed.Load() {
+		t.Error("expired instance should be disposed")
+	}
+	if rebuilt.disposed.Load() {
+		t.Error("current instance should not be disposed")
+	}
+	if created.Load() != 2 {
+		t.Errorf("constructor called %d times, want 2", created.Load())
+	}
+}
+
+func TestCached_SharedAcrossPaths(t *testing.T) {
+	container := New()
+	if err := container.Cached((*Logger)(nil), &ConsoleLogger{}, time.Hour); err != nil {
+		t.Fatalf("Cached failed: %v", err)
+	}
+
+	viaMake := container.Make((*Logger)(nil))
+	viaSafe, err := container.MakeSafe((*Logger)(nil))
+	if err != nil {
+		t.Fatalf("MakeSafe failed: %v", err)
+	}
+	scope := container.CreateScope()
+	defer scope.Dispose()
+	viaScope := scope.Make((*Logger)(nil))
+
+	if viaMake != viaSafe || viaMake != viaScope {
+		t.Error("Make, MakeSafe and Scope.Make should share the cached instance")
+	}
+}
+
+func TestCached_ConcurrentResolutionBuildsOnce(t *testing.T) {
+	var created atomic.Int64
+	container := New()
+	_ = container.CachedConstructor((*rotatingClient)(nil), newRotatingClientFactory(&created), time.Hour)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			container.Make((*rotatingClient)(nil))
+		}()
+	}
+	wg.Wait()
+
+	if created.Load() != 1 {
+		t.Errorf("constructor called %d times, want 1", created.Load())
+	}
+}
+
+func TestCached_FailuresAreNotCached(t *testing.T) {
+	calls := 0
+	container := New()
+	_ = container.CachedConstructor((*rotatingClient)(nil), func() (*rotatingClient, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("credentials unavailable")
+		}
+		return &rotatingClient{}, nil
+	}, time.Hour)
+
+	if _, err := container.MakeSafe((*rotatingClient)(nil)); err == nil {
+		t.Fatal("expected first resolution to fail")
+	}
+	if _, err := container.MakeSafe((*rotatingClient)(nil)); err != nil {
+		t.Errorf("second resolution should retry, got %v", err)
+	}
+}
+
+func TestCached_InvalidTTL(t *testing.T) {
+	container := New()
+	err := container.Cached((*Logger)(nil), &ConsoleLogger{}, 0)
+	if _, ok := err.(*InvalidBindingError); !ok {
+		t.Errorf("expected InvalidBindingError, got %v", err)
+	}
+	err = container.CachedConstructor((*rotatingClient)(nil), func() *rotatingClient { return nil }, -time.Second)
+	if _, ok := err.(*InvalidBindingError); !ok {
+		t.Errorf("expected InvalidBindingError, got %v", err)
+	}
+}
+
+func TestCached_As(t *testing.T) {
+	container := New()
+	if err := As[Logger, ConsoleLogger](container, WithLifetime(LifetimeCached(time.Minute))); err != nil {
+		t.Fatalf("As failed: %v", err)
+	}
+	if container.Make((*Logger)(nil)) != container.Make((*Logger)(nil)) {
+		t.Error("As with a cached lifetime should reuse the instance")
+	}
+}
+
+func TestCached_ExportGo(t *testing.T) {
+	container := New()
+	_ = container.Cached((*Logger)(nil), &ConsoleLogger{}, time.Minute)
+
+	src, err := container.ExportGo("wiring")
+	if err != nil {
+		t.Fatalf("ExportGo failed: %v", err)
+	}
+	if !strings.Contains(string(src), "c.Cached((*nasc.Logger)(nil), &nasc.ConsoleLogger{}, time.Duration(60000000000))") {
+		t.Errorf("expected Cached call in export, got:\n%s", src)
+	}
+}
+
+type cachedCycleA interface{ A() }
+
+type cachedCycleB interface{ B() }
+
+type cachedCycle struct{}
+
+func (*cachedCycle) A() {}
+func (*cachedCycle) B() {}
+
+func TestCached_CycleIsReported(t *testing.T) {
+	container := New()
+	_ = container.CachedConstructor((*cachedCycleA)(nil), func(b cachedCycleB) cachedCycleA { return &cachedCycle{} }, time.Hour)
+	_ = container.BindConstructor((*cachedCycleB)(nil), func(a cachedCycleA) cachedCycleB { return &cachedCycle{} })
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		_, err := container.MakeSafe((*cachedCycleA)(nil))
+		var circular *CircularDependencyError
+		if !errors.As(err, &circular) {
+			t.Errorf("MakeSafe error = %v, want a CircularDependencyError", err)
+		}
+
+		defer func() {
+			if p := recover(); p == nil || !strings.Contains(fmt.Sprint(p), "circular dependency") {
+				t.Errorf("Make panicked with %v, want a circular dependency", p)
+			}
+		}()
+		container.Make((*cachedCycleB)(nil))
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("resolving a cached binding that depends on itself did not return")
+	}
+}
@@ -0,0 +1,86 @@
+package nasc
+
+import "reflect"
+
+// Clone copies the container's registrations into a new independent
+// container. Singleton instances and registered providers are not copied,
+// and the clone is never frozen, so it can be modified freely. Lazy
+// providers still waiting to register are copied, and register in the
+// clone when their types are first resolved there.
+//
+// Use it to share a baseline registration between tests without
+// cross-test interference.
+//
+// Example:
+//
+//	base := nasc.New()
+//	base.Singleton((*Logger)(nil), &ConsoleLogger{})
+//
+//	func TestSomething(t *testing.T) {
+//	    container := base.Clone()
+//	    container.Bind((*Database)(nil), &MockDB{})
+//	}
+func (n *Nasc) Clone() *Nasc {
+	c := &Nasc{containerState: &containerState{
+		registry:          n.registry.Clone(),
+		singletonCache:    newSingletonCache(),
+		cached:            newCachedStore(),
+		reflectionCache:   n.reflectionCache,
+		providers:         make([]*providerEntry, 0),
+		contextual:        n.contextual.clone(),
+		overrides:         newOverrideStack(),
+		errors:            newErrorSink(),
+		validationWorkers: n.validationWorkers,
+		warmUpWorkers:     n.warmUpWorkers,
+		bootWorkers:       n.bootWorkers,
+		shutdownTimeout:   n.shutdownTimeout,
+		profile:           n.profile,
+		scopeValues:       n.scopeValues,
+		fallbacks:         n.fallbacks,
+		scopeBudget:       n.scopeBudget,
+		scopeTagCache:     n.scopeTagCache,
+		sealingReport:     n.sealingReport,
+
+		unexportedInjection: n.unexportedInjection,
+		implicitBinding:     n.implicitBinding,
+	}}
+	c.singletonCache.onHit = c.singletonHit
+	if n.stubs != nil {
+		c.stubs = newStubRegistry(n.stubs.provided)
+	}
+	if n.instances != nil {
+		c.instances = newInstanceTable()
+	}
+	if n.immutability != nil {
+		c.enableImmutabilityChecks(n.immutability.exempt)
+	}
+
+	c.decorators.byType = n.decorators.snapshot()
+	c.decorators.instances = n.decorators.instanceBindings()
+	c.rebinds.byType = n.rebinds.snapshot()
+	c.modules.byName = n.modules.snapshot()
+	c.lazy.byType = n.lazy.snapshot()
+	c.lazy.waiting.Store(int32(len(c.lazy.byType)))
+	n.hosted.mu.Lock()
+	c.hosted.types = append([]reflect.Type(nil), n.hosted.types...)
+	n.hosted.mu.Unlock()
+	n.health.mu.Lock()
+	c.health.entries = append([]healthEntry(nil), n.health.entries...)
+	n.health.mu.Unlock()
+	if chain := n.middleware.snapshot(); chain != nil {
+		c.middleware.chain.Store(&chain)
+	}
+	if listeners := n.events.snapshot(); listeners != nil {
+		c.events.listeners.Store(&listeners)
+	}
+
+	n.scopeHookMu.Lock()
+	c.scopeHooks = append(c.scopeHooks, n.scopeHooks...)
+	n.scopeHookMu.Unlock()
+
+	n.conditionalMu.Lock()
+	c.conditionals = append(c.conditionals, n.conditionals...)
+	n.conditionalMu.Unlock()
+
+	return c
+}
@@ -0,0 +1,71 @@
+package nasc
+
+import "testing"
+
+func TestClone_IndependentBindings(t *testing.T) {
+	base := New()
+	_ = base.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	clone := base.Clone()
+	_ = clone.Bind((*Database)(nil), &MockDB{})
+
+	if !clone.registry.Has(typeOfToken((*Logger)(nil))) {
+		t.Error("clone should contain the baseline bindings")
+	}
+	if base.registry.Has(typeOfToken((*Database)(nil))) {
+		t.Error("bindings added to the clone must not leak into the original")
+	}
+}
+
+func TestClone_DoesNotCopySingletons(t *testing.T) {
+	base := New()
+	_ = base.Singleton((*Logger)(nil), &ConsoleLogger{})
+	original := base.Make((*Logger)(nil))
+
+	clone := base.Clone()
+	if clone.Make((*Logger)(nil)) == original {
+		t.Error("clone should create its own singleton instances")
+	}
+	if base.Make((*Logger)(nil)) != original {
+		t.Error("original singleton should be unchanged")
+	}
+}
+
+func TestClone_OfFrozenContainerIsMutable(t *testing.T) {
+	base := New()
+	base.Freeze()
+
+	clone := base.Clone()
+	if clone.IsFrozen() {
+		t.Error("clone should not be frozen")
+	}
+	if err := clone.Bind((*Logger)(nil), &ConsoleLogger{}); err != nil {
+		t.Errorf("Bind on clone failed: %v", err)
+	}
+}
+
+func TestClone_CopiesPendingLazyProviders(t *testing.T) {
+	base := New()
+	provider := &lazyLoggingProvider{}
+	if err := base.RegisterProvider(provider); err != nil {
+		t.Fatalf("RegisterProvider failed: %v", err)
+	}
+
+	clone := base.Clone()
+	if _, err := clone.MakeSafe((*Logger)(nil)); err != nil {
+		t.Fatalf("clone should register the pending lazy provider: %v", err)
+	}
+	if provider.registered != 1 {
+		t.Errorf("provider registered %d times, want 1", provider.registered)
+	}
+	if base.registry.Has(typeOfToken((*Logger)(nil))) {
+		t.Error("registering in the clone must not register in the original")
+	}
+
+	if _, err := base.MakeSafe((*Logger)(nil)); err != nil {
+		t.Fatalf("original should still register its lazy provider: %v", err)
+	}
+	if provider.registered != 2 {
+		t.Errorf("provider registered %d times, want 2", provider.registered)
+	}
+}
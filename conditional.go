@@ -0,0 +1,119 @@
+package nasc
+
+import (
+	"errors"
+	"os"
+	"reflect"
+)
+
+// Condition is a predicate deciding whether a conditional registration activates.
+// It receives the container so conditions can inspect existing bindings.
+type Condition func(*Nasc) bool
+
+// conditionalRegistration is a registration waiting for its condition to be evaluated.
+type conditionalRegistration struct {
+	condition Condition
+	register  func(*Nasc) error
+}
+
+// BindIf registers a transient binding that only activates when cond holds.
+// Conditions are evaluated in registration order the next time Validate or
+// BootProviders runs; registrations whose condition is false are discarded.
+//
+// Example:
+//
+//	container.BindIf(nasc.IfEnv("CACHE", "redis"), (*Cache)(nil), &RedisCache{})
+//	container.BindIf(nasc.IfNotBound((*Cache)(nil)), (*Cache)(nil), &MemoryCache{})
+//	container.BootProviders()
+func (n *Nasc) BindIf(cond Condition, abstractType, concreteType interface{}) error {
+	if abstractType == nil {
+		return &InvalidBindingError{Reason: "abstract type cannot be nil"}
+	}
+	if concreteType == nil {
+		return &InvalidBindingError{Reason: "concrete type cannot be nil"}
+	}
+
+	return n.RegisterIf(cond, func(c *Nasc) error {
+		return c.Bind(abstractType, concreteType)
+	})
+}
+
+// RegisterIf defers an arbitrary registration until cond holds.
+// Use it for conditional singletons, constructors, or named bindings.
+//
+// Example:
+//
+//	container.RegisterIf(nasc.IfEnv("APP_ENV", "production"), func(c *nasc.Nasc) error {
+//	    return c.SingletonConstructor((*Mailer)(nil), NewSMTPMailer)
+//	})
+func (n *Nasc) RegisterIf(cond Condition, register func(*Nasc) error) error {
+	if cond == nil {
+		return &InvalidBindingError{Reason: "condition cannot be nil"}
+	}
+	if register == nil {
+		return &InvalidBindingError{Reason: "registration function cannot be nil"}
+	}
+	if n.IsFrozen() {
+		return ErrContainerFrozen
+	}
+
+	n.conditionalMu.Lock()
+	defer n.conditionalMu.Unlock()
+
+	n.conditionals = append(n.conditionals, &conditionalRegistration{
+		condition: cond,
+		register:  register,
+	})
+	return nil
+}
+
+// applyConditionalBindings evaluates pending conditions and performs the
+// registrations that hold. Each pending registration is evaluated once.
+func (n *Nasc) applyConditionalBindings() error {
+	n.conditionalMu.Lock()
+	pending := n.conditionals
+	n.conditionals = nil
+	n.conditionalMu.Unlock()
+
+	var errs []error
+	for _, entry := range pending {
+		if !entry.condition(n) {
+			continue
+		}
+		if err := entry.register(n); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// IfEnv returns a condition that holds when the environment variable equals value.
+func IfEnv(name, value string) Condition {
+	return func(*Nasc) bool {
+		return os.Getenv(name) == value
+	}
+}
+
+// IfBound returns a condition that holds when abstractType has a default binding.
+func IfBound(abstractType interface{}) Condition {
+	return func(n *Nasc) bool {
+		return n.registry.Has(typeOfToken(abstractType))
+	}
+}
+
+// IfNotBound returns a condition that holds when abstractType has no default binding.
+func IfNotBound(abstractType interface{}) Condition {
+	return func(n *Nasc) bool {
+		return !n.registry.Has(typeOfToken(abstractType))
+	}
+}
+
+// typeOfToken extracts the abstract type from a type token like (*Logger)(nil).
+func typeOfToken(token interface{}) reflect.Type {
+	t := reflect.TypeOf(token)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
@@ -0,0 +1,82 @@
+package nasc
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBindIf_ActivatesOnBoot(t *testing.T) {
+	t.Setenv("NASC_TEST_LOGGER", "file")
+
+	container := New()
+	_ = container.BindIf(IfEnv("NASC_TEST_LOGGER", "file"), (*Logger)(nil), &FileLogger{})
+	_ = container.BindIf(IfNotBound((*Logger)(nil)), (*Logger)(nil), &ConsoleLogger{})
+
+	if _, err := container.MakeSafe((*Logger)(nil)); err == nil {
+		t.Fatal("conditional binding should not be active before boot")
+	}
+
+	if err := container.BootProviders(); err != nil {
+		t.Fatalf("BootProviders failed: %v", err)
+	}
+
+	if _, ok := container.Make((*Logger)(nil)).(*FileLogger); !ok {
+		t.Error("expected the env-selected FileLogger")
+	}
+}
+
+func TestBindIf_FallbackOnValidate(t *testing.T) {
+	container := New()
+	_ = container.BindIf(IfEnv("NASC_TEST_UNSET_VARIABLE", "yes"), (*Logger)(nil), &FileLogger{})
+	_ = container.BindIf(IfNotBound((*Logger)(nil)), (*Logger)(nil), &ConsoleLogger{})
+
+	if err := container.Validate(); err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+
+	if _, ok := container.Make((*Logger)(nil)).(*ConsoleLogger); !ok {
+		t.Error("expected the fallback ConsoleLogger")
+	}
+}
+
+func TestRegisterIf_IfBound(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Database)(nil), &MockDB{})
+	_ = container.RegisterIf(IfBound((*Database)(nil)), func(c *Nasc) error {
+		return c.BindConstructor((*ConstructorService)(nil), NewServiceWithDeps)
+	})
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	if err := container.Validate(); err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	if _, err := container.MakeSafe((*ConstructorService)(nil)); err != nil {
+		t.Errorf("conditional constructor not registered: %v", err)
+	}
+}
+
+func TestBindIf_RegistrationErrors(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+	_ = container.BindIf(func(*Nasc) bool { return true }, (*Logger)(nil), &FileLogger{})
+
+	err := container.Validate()
+	var valErr *ValidationError
+	if !errors.As(err, &valErr) {
+		t.Fatalf("expected ValidationError for duplicate conditional binding, got %v", err)
+	}
+}
+
+func TestBindIf_InvalidArguments(t *testing.T) {
+	container := New()
+
+	if err := container.BindIf(nil, (*Logger)(nil), &ConsoleLogger{}); err == nil {
+		t.Error("expected error for nil condition")
+	}
+	if err := container.BindIf(IfBound((*Logger)(nil)), nil, &ConsoleLogger{}); err == nil {
+		t.Error("expected error for nil abstract type")
+	}
+	if err := container.RegisterIf(IfBound((*Logger)(nil)), nil); err == nil {
+		t.Error("expected error for nil registration")
+	}
+}
@@ -15,6 +15,23 @@ import (
 //   - func(Dep1) (*T, error)
 //   - func(Dep1, Dep2, ...) *T
 //   - func(Dep1, Dep2, ...) (*T, error)
+//
+// The result may also be an interface, as in func(Dep1) Logger, or a value
+// type such as Config.
+//
+// Parameters of concrete types, such as *Config, struct values or
+// primitives, are resolved when the type has a binding (see BindInstanceAs
+// and Factory). Parameters may also be *Lazy[T] (resolved on first use),
+// Optional[T] (absent when T is not bound),
+// func() T / func() (T, error) (resolved on every call), []T (every
+// implementation of T, see MakeAll), or map[string]T (named bindings of T,
+// keyed by name). A struct parameter embedding In is a parameter object
+// whose fields are resolved individually.
+//
+// Unless bound explicitly, *Nasc, *Scope and context.Context parameters
+// receive the resolving container, the scope resolving the instance and
+// that scope's context (context.Background() outside scopes). Requesting
+// *Scope outside a scope is an error.
 type ConstructorFunc interface{}
 
 // constructorInfo holds metadata about a constructor function.
@@ -25,6 +42,8 @@ type constructorInfo struct {
 	returnsError bool
 	returnType   reflect.Type
 	numParams    int
+	paramTokens  []interface{} // resolution tokens per parameter, see paramToken
+	paramNames   []string      // named bindings per parameter (see Param)
 }
 
 // parseConstructor analyzes a constructor function and extracts metadata.
@@ -43,30 +62,33 @@ func parseConstructor(constructor ConstructorFunc) (*constructorInfo, error) {
 	// Validate return values
 	numOut := fnType.NumOut()
 	if numOut == 0 || numOut > 2 {
-		return nil, fmt.Errorf("constructor must return (*T) or (*T, error), got %d return values", numOut)
+		return nil, fmt.Errorf("constructor must return (T) or (T, error), got %d return values", numOut)
 	}
 
-	// First return value must be a pointer
+	// The first return value is the instance: a pointer, an interface such
+	// as Logger, or a value type. A lone error is not an instance.
+	errorInterface := reflect.TypeOf((*error)(nil)).Elem()
 	returnType := fnType.Out(0)
-	if returnType.Kind() != reflect.Ptr {
-		return nil, fmt.Errorf("constructor must return a pointer, got %v", returnType.Kind())
+	if returnType == errorInterface {
+		return nil, fmt.Errorf("constructor must return an instance before the error, got %v", fnType)
 	}
 
 	// Check if second return is error
 	returnsError := false
 	if numOut == 2 {
-		errorInterface := reflect.TypeOf((*error)(nil)).Elem()
 		if !fnType.Out(1).Implements(errorInterface) {
 			return nil, fmt.Errorf("constructor's second return value must be error, got %v", fnType.Out(1))
 		}
 		returnsError = true
 	}
 
-	// Extract parameter types
+	// Extract parameter types and the tokens used to resolve them
 	numParams := fnType.NumIn()
 	paramTypes := make([]reflect.Type, numParams)
+	paramTokens := make([]interface{}, numParams)
 	for i := 0; i < numParams; i++ {
 		paramTypes[i] = fnType.In(i)
+		paramTokens[i] = reflect.Zero(reflect.PointerTo(paramTypes[i])).Interface()
 	}
 
 	return &constructorInfo{
@@ -76,23 +98,100 @@ func parseConstructor(constructor ConstructorFunc) (*constructorInfo, error) {
 		returnsError: returnsError,
 		returnType:   returnType,
 		numParams:    numParams,
+		paramTokens:  paramTokens,
 	}, nil
 }
 
+// paramToken returns the token that resolves parameter i, such as
+// (*Logger)(nil) for a Logger parameter.
+func (info *constructorInfo) paramToken(i int) interface{} {
+	if info.paramTokens != nil {
+		return info.paramTokens[i]
+	}
+	return reflect.Zero(reflect.PointerTo(info.paramTypes[i])).Interface()
+}
+
+// resolver resolves the dependencies of an instance being built: the
+// container, or the scope creating the instance, so that scoped bindings,
+// scope bindings and overrides are honored.
+type resolver interface {
+	Make(abstractType interface{}) interface{}
+	MakeNamed(abstractType interface{}, name string) interface{}
+	MakeAll(abstractType interface{}) []interface{}
+	MakeWithTag(tag string) []interface{}
+}
+
 // invokeConstructor calls a constructor with resolved dependencies.
-func (n *Nasc) invokeConstructor(info *constructorInfo) (interface{}, error) {
+// The consumer is the abstract type being constructed; it selects contextual bindings.
+func (n *Nasc) invokeConstructor(info *constructorInfo, consumer reflect.Type) (interface{}, error) {
+	return n.invokeConstructorWith(info, consumer, n)
+}
+
+// invokeConstructorWith calls a constructor, resolving dependencies with r.
+// Scopes pass themselves so scoped bindings and overrides are honored.
+func (n *Nasc) invokeConstructorWith(info *constructorInfo, consumer reflect.Type, r resolver) (interface{}, error) {
+	params, err := n.resolveConstructorParams(info, consumer, r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invoke constructor
+	results := info.fn.Call(params)
+
+	// Handle return values
+	instance := results[0].Interface()
+
+	if info.returnsError {
+		errValue := results[len(results)-1]
+		if !errValue.IsNil() {
+			err := errValue.Interface().(error)
+			return nil, fmt.Errorf("constructor returned error: %w", err)
+		}
+	}
+
+	return instance, nil
+}
+
+// resolveConstructorParams resolves a constructor's parameters with r.
+func (n *Nasc) resolveConstructorParams(info *constructorInfo, consumer reflect.Type, r resolver) ([]reflect.Value, error) {
+	consumers := consumerTypes(consumer, info.returnType)
+
 	// Resolve parameters
 	params := make([]reflect.Value, info.numParams)
 	for i, paramType := range info.paramTypes {
-		// Create type token for resolution
-		var typeToken interface{}
-		if paramType.Kind() == reflect.Interface {
-			// For interface parameters, create nil pointer to interface
-			typeToken = reflect.Zero(reflect.PointerTo(paramType)).Interface()
-		} else {
-			return nil, fmt.Errorf("constructor parameter %d must be an interface, got %v", i, paramType)
+		// Parameters annotated with Named use the named binding
+		if name := info.paramName(i); name != "" {
+			value, err := n.resolveNamedParam(paramType, name, r)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve parameter %d: %w", i, err)
+			}
+			params[i] = value
+			continue
+		}
+
+		// Contextual bindings take precedence over the container default
+		if contextual, ok := n.contextual.lookup(paramType, consumers...); ok {
+			value, err := n.contextualValue(contextual, paramType, r, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve parameter %d: %w", i, err)
+			}
+			params[i] = value
+			continue
+		}
+
+		// Wrapper types such as *Lazy[T] are built rather than resolved
+		if value, ok, err := n.resolveInjectable(paramType, r); ok {
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve parameter %d: %w", i, err)
+			}
+			params[i] = value
+			continue
 		}
 
+		// Concrete types such as *Config, struct values and primitives are
+		// resolved like interfaces, so r reports them if they are not bound
+		typeToken := info.paramToken(i)
+
 		// Resolve dependency
 		var resolved interface{}
 		var resolveErr error
@@ -103,31 +202,36 @@ func (n *Nasc) invokeConstructor(info *constructorInfo) (interface{}, error) {
 					resolveErr = fmt.Errorf("failed to resolve parameter %d: %v", i, r)
 				}
 			}()
-			resolved = n.Make(typeToken)
+			resolved = r.Make(typeToken)
 		}()
 
 		if resolveErr != nil {
 			return nil, resolveErr
 		}
 
-		params[i] = reflect.ValueOf(resolved)
+		value, err := paramValue(resolved, paramType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve parameter %d: %w", i, err)
+		}
+		params[i] = value
 	}
 
-	// Invoke constructor
-	results := info.fn.Call(params)
-
-	// Handle return values
-	instance := results[0].Interface()
+	return params, nil
+}
 
-	if info.returnsError {
-		errValue := results[1]
-		if !errValue.IsNil() {
-			err := errValue.Interface().(error)
-			return nil, fmt.Errorf("constructor returned error: %w", err)
-		}
+// paramValue converts a resolved dependency to a parameter value. A nil
+// result becomes the zero value, since a factory for *Config may
+// legitimately return nil.
+func paramValue(resolved interface{}, t reflect.Type) (reflect.Value, error) {
+	if resolved == nil {
+		return reflect.Zero(t), nil
 	}
 
-	return instance, nil
+	value := reflect.ValueOf(resolved)
+	if !value.Type().AssignableTo(t) {
+		return reflect.Value{}, fmt.Errorf("binding produced %v, which is not assignable to %v", value.Type(), t)
+	}
+	return value, nil
 }
 
 // BindConstructor registers a binding using a constructor function.
@@ -143,8 +247,12 @@ func (n *Nasc) invokeConstructor(info *constructorInfo) (interface{}, error) {
 //
 //	container.BindConstructor((*UserService)(nil), NewUserService)
 //	// Where: func NewUserService(logger Logger, db Database) (*UserService, error)
-func (n *Nasc) BindConstructor(abstractType interface{}, constructor ConstructorFunc) error {
-	return n.bindConstructorWithLifetime(abstractType, constructor, LifetimeTransient)
+//
+// Options annotate individual parameters, such as using a named binding:
+//
+//	container.BindConstructor((*Replicator)(nil), NewReplicator, nasc.Param(0, nasc.Named("primary")))
+func (n *Nasc) BindConstructor(abstractType interface{}, constructor ConstructorFunc, opts ...ConstructorOption) error {
+	return n.bindConstructorWithLifetime(abstractType, constructor, LifetimeTransient, opts...)
 }
 
 // SingletonConstructor registers a singleton binding using a constructor function.
@@ -152,8 +260,8 @@ func (n *Nasc) BindConstructor(abstractType interface{}, constructor Constructor
 // Example:
 //
 //	container.SingletonConstructor((*Database)(nil), NewDatabase)
-func (n *Nasc) SingletonConstructor(abstractType interface{}, constructor ConstructorFunc) error {
-	return n.bindConstructorWithLifetime(abstractType, constructor, LifetimeSingleton)
+func (n *Nasc) SingletonConstructor(abstractType interface{}, constructor ConstructorFunc, opts ...ConstructorOption) error {
+	return n.bindConstructorWithLifetime(abstractType, constructor, LifetimeSingleton, opts...)
 }
 
 // ScopedConstructor registers a scoped binding using a constructor function.
@@ -161,21 +269,26 @@ func (n *Nasc) SingletonConstructor(abstractType interface{}, constructor Constr
 // Example:
 //
 //	container.ScopedConstructor((*UnitOfWork)(nil), NewUnitOfWork)
-func (n *Nasc) ScopedConstructor(abstractType interface{}, constructor ConstructorFunc) error {
-	return n.bindConstructorWithLifetime(abstractType, constructor, LifetimeScoped)
+func (n *Nasc) ScopedConstructor(abstractType interface{}, constructor ConstructorFunc, opts ...ConstructorOption) error {
+	return n.bindConstructorWithLifetime(abstractType, constructor, LifetimeScoped, opts...)
 }
 
 // bindConstructorWithLifetime is the internal method that handles constructor binding.
-func (n *Nasc) bindConstructorWithLifetime(abstractType interface{}, constructor ConstructorFunc, lifetime Lifetime) error {
+func (n *Nasc) bindConstructorWithLifetime(abstractType interface{}, constructor ConstructorFunc, lifetime Lifetime, opts ...ConstructorOption) error {
 	if abstractType == nil {
 		return &InvalidBindingError{Reason: "abstract type cannot be nil"}
 	}
 
 	// Parse constructor
-	info, err := parseConstructor(constructor)
+	info, err := n.reflectionCache.parseConstructor(constructor)
 	if err != nil {
 		return &InvalidBindingError{Reason: fmt.Sprintf("invalid constructor: %v", err)}
 	}
+	for _, opt := range opts {
+		if err := opt(info); err != nil {
+			return &InvalidBindingError{Reason: fmt.Sprintf("invalid constructor option: %v", err)}
+		}
+	}
 
 	// Extract abstract type
 	abstractT := reflect.TypeOf(abstractType)
@@ -191,5 +304,5 @@ func (n *Nasc) bindConstructorWithLifetime(abstractType interface{}, constructor
 		Constructor:  info, // Store constructor info
 	}
 
-	return n.registry.Register(binding)
+	return n.register(binding)
 }
@@ -2,6 +2,8 @@ package nasc
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -201,6 +203,8 @@ func TestParseConstructor_ValidCases(t *testing.T) {
 		func() (*BasicConstructorService, error) { return nil, nil },
 		func(Logger) *BasicConstructorService { return nil },
 		func(Logger, Database) (*BasicConstructorService, error) { return nil, nil },
+		func(Database) Logger { return nil },
+		func() (appConfig, error) { return appConfig{}, nil },
 	}
 
 	for i, constructor := range validConstructors {
@@ -217,7 +221,7 @@ func TestParseConstructor_InvalidCases(t *testing.T) {
 		"not a function",
 		func() {}, // no return
 		func() (int, int, int) { return 0, 0, 0 },                // too many returns
-		func() int { return 0 },                                  // returns non-pointer
+		func() error { return nil },                              // returns only an error
 		func() (*BasicConstructorService, int) { return nil, 0 }, // second return not error
 	}
 
@@ -299,3 +303,132 @@ func TestSingletonConstructorError(t *testing.T) {
 
 	c.Make((*Service)(nil))
 }
+
+type appConfig struct {
+	DSN string
+}
+
+type Port int
+
+type configuredService struct {
+	cfg    *appConfig
+	limits appConfig
+	port   Port
+	logger Logger
+}
+
+func TestBindConstructor_ConcreteParams(t *testing.T) {
+	container := New()
+	_ = container.BindInstanceAs(&appConfig{DSN: "postgres://"}, (**appConfig)(nil))
+	_ = container.Factory((*appConfig)(nil), func(*Nasc) (interface{}, error) {
+		return appConfig{DSN: "limits"}, nil
+	})
+	_ = container.Factory((*Port)(nil), func(*Nasc) (interface{}, error) {
+		return Port(8080), nil
+	})
+	_ = container.Singleton((*Logger)(nil), &ConsoleLogger{})
+
+	newService := func(cfg *appConfig, limits appConfig, port Port, logger Logger) *configuredService {
+		return &configuredService{cfg: cfg, limits: limits, port: port, logger: logger}
+	}
+	if err := container.BindConstructor((*configuredService)(nil), newService); err != nil {
+		t.Fatalf("BindConstructor failed: %v", err)
+	}
+
+	check := func(svc *configuredService) {
+		t.Helper()
+		if svc.cfg == nil || svc.cfg.DSN != "postgres://" {
+			t.Errorf("pointer parameter = %+v", svc.cfg)
+		}
+		if svc.limits.DSN != "limits" || svc.port != 8080 || svc.logger == nil {
+			t.Errorf("value parameters not injected: %+v", svc)
+		}
+	}
+
+	check(container.Make((*configuredService)(nil)).(*configuredService))
+
+	instance, err := container.MakeSafe((*configuredService)(nil))
+	if err != nil {
+		t.Fatalf("MakeSafe failed: %v", err)
+	}
+	check(instance.(*configuredService))
+}
+
+func TestBindConstructor_UnboundConcreteParam(t *testing.T) {
+	container := New()
+	_ = container.BindConstructor((*configuredService)(nil), func(cfg *appConfig) *configuredService {
+		return &configuredService{cfg: cfg}
+	})
+
+	defer func() {
+		r := recover()
+		if r == nil || !strings.Contains(fmt.Sprint(r), "binding not found for type *nasc.appConfig") {
+			t.Errorf("expected unbound parameter panic, got %v", r)
+		}
+	}()
+	container.Make((*configuredService)(nil))
+}
+
+func TestBindConstructor_ScopeBoundConcreteParam(t *testing.T) {
+	container := New()
+	_ = container.ScopedConstructor((*configuredService)(nil), func(cfg *appConfig) *configuredService {
+		return &configuredService{cfg: cfg}
+	})
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+	cfg := &appConfig{DSN: "tenant://"}
+	if err := scope.BindInstance((**appConfig)(nil), cfg); err != nil {
+		t.Fatalf("BindInstance failed: %v", err)
+	}
+
+	if svc := scope.Make((*configuredService)(nil)).(*configuredService); svc.cfg != cfg {
+		t.Errorf("cfg = %+v, want the scope's instance", svc.cfg)
+	}
+}
+
+func TestBindConstructor_MismatchedConcreteParam(t *testing.T) {
+	container := New()
+	_ = container.Factory((*Port)(nil), func(*Nasc) (interface{}, error) {
+		return "not a port", nil
+	})
+	_ = container.BindConstructor((*configuredService)(nil), func(port Port) *configuredService {
+		return &configuredService{port: port}
+	})
+
+	if _, err := container.MakeSafe((*configuredService)(nil)); err == nil || !strings.Contains(err.Error(), "not assignable") {
+		t.Errorf("expected assignability error, got %v", err)
+	}
+}
+
+func NewConsoleLoggerAsInterface() Logger {
+	return &ConsoleLogger{}
+}
+
+func TestBindConstructor_InterfaceAndValueResults(t *testing.T) {
+	container := New()
+	if err := container.SingletonConstructor((*Logger)(nil), NewConsoleLoggerAsInterface); err != nil {
+		t.Fatalf("SingletonConstructor failed: %v", err)
+	}
+	if err := container.BindConstructor((*appConfig)(nil), func(logger Logger) (appConfig, error) {
+		return appConfig{DSN: "sqlite://"}, nil
+	}); err != nil {
+		t.Fatalf("BindConstructor failed: %v", err)
+	}
+
+	logger := container.Make((*Logger)(nil))
+	if _, ok := logger.(*ConsoleLogger); !ok {
+		t.Errorf("expected *ConsoleLogger, got %T", logger)
+	}
+	if container.Make((*Logger)(nil)) != logger {
+		t.Error("interface-returning singleton constructors should be cached")
+	}
+
+	cfg, err := container.MakeSafe((*appConfig)(nil))
+	if err != nil {
+		t.Fatalf("MakeSafe failed: %v", err)
+	}
+	if cfg.(appConfig).DSN != "sqlite://" {
+		t.Errorf("value result = %+v", cfg)
+	}
+}
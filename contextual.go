@@ -0,0 +1,244 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// contextualBinding is the implementation given to one consumer for one dependency.
+type contextualBinding struct {
+	// concrete is the type to provide; resolved from the container when it
+	// has a binding, otherwise instantiated directly (pointer to struct)
+	concrete reflect.Type
+
+	// factory creates the instance when set (GiveFactory)
+	factory FactoryFunc
+}
+
+// contextualRegistry stores contextual bindings keyed by consumer, then dependency.
+type contextualRegistry struct {
+	mu       sync.RWMutex
+	bindings map[reflect.Type]map[reflect.Type]*contextualBinding
+}
+
+// newContextualRegistry creates an empty contextual registry.
+func newContextualRegistry() *contextualRegistry {
+	return &contextualRegistry{
+		bindings: make(map[reflect.Type]map[reflect.Type]*contextualBinding),
+	}
+}
+
+// set registers a contextual binding, replacing any previous one.
+func (cr *contextualRegistry) set(consumer, needs reflect.Type, binding *contextualBinding) {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+
+	if cr.bindings[consumer] == nil {
+		cr.bindings[consumer] = make(map[reflect.Type]*contextualBinding)
+	}
+	cr.bindings[consumer][needs] = binding
+}
+
+// count returns the number of contextual bindings.
+func (cr *contextualRegistry) count() int {
+	cr.mu.RLock()
+	defer cr.mu.RUnlock()
+
+	total := 0
+	for _, needs := range cr.bindings {
+		total += len(needs)
+	}
+	return total
+}
+
+// lookup returns the contextual binding for the first matching consumer type.
+func (cr *contextualRegistry) lookup(needs reflect.Type, consumers ...reflect.Type) (*contextualBinding, bool) {
+	cr.mu.RLock()
+	defer cr.mu.RUnlock()
+
+	if len(cr.bindings) == 0 {
+		return nil, false
+	}
+
+	for _, consumer := range consumers {
+		if consumer == nil {
+			continue
+		}
+		if binding, ok := cr.bindings[consumer][needs]; ok {
+			return binding, true
+		}
+	}
+	return nil, false
+}
+
+// consumerTypes returns the keys a consumer may have been registered under:
+// its abstract type, its concrete type, and the struct behind a pointer.
+func consumerTypes(abstractT, concreteT reflect.Type) []reflect.Type {
+	types := []reflect.Type{abstractT, concreteT}
+	if concreteT != nil && concreteT.Kind() == reflect.Ptr {
+		types = append(types, concreteT.Elem())
+	}
+	return types
+}
+
+// ContextualBindingBuilder configures a contextual binding.
+// It is created by Nasc.When and completed by Give or GiveFactory.
+type ContextualBindingBuilder struct {
+	container *Nasc
+	consumer  reflect.Type
+	needs     reflect.Type
+}
+
+// When starts a contextual binding for a consumer type.
+// The consumer may be an interface token like (*ReportService)(nil) or a
+// concrete struct token like (*ReportServiceImpl)(nil).
+//
+// Contextual bindings are honored during constructor and auto-wire
+// resolution, letting one consumer receive a different implementation
+// than the container default.
+//
+// Example:
+//
+//	container.When((*ReportService)(nil)).
+//	    Needs((*Logger)(nil)).
+//	    Give((*FileLogger)(nil))
+func (n *Nasc) When(consumer interface{}) *ContextualBindingBuilder {
+	b := &ContextualBindingBuilder{container: n}
+	if consumer != nil {
+		b.consumer = reflect.TypeOf(consumer)
+		if b.consumer.Kind() == reflect.Ptr {
+			b.consumer = b.consumer.Elem()
+		}
+	}
+	return b
+}
+
+// Needs sets the dependency type the consumer should receive a different implementation for.
+func (b *ContextualBindingBuilder) Needs(abstractType interface{}) *ContextualBindingBuilder {
+	if abstractType != nil {
+		b.needs = reflect.TypeOf(abstractType)
+		if b.needs.Kind() == reflect.Ptr {
+			b.needs = b.needs.Elem()
+		}
+	}
+	return b
+}
+
+// Give completes the contextual binding with a concrete type.
+// If the container has a binding for the concrete type it is resolved from
+// the container; otherwise a new instance is created on every resolution.
+//
+// Returns an InvalidBindingError if the consumer, dependency, or concrete
+// type is missing, or if the concrete type cannot satisfy the dependency.
+func (b *ContextualBindingBuilder) Give(concreteType interface{}) error {
+	if err := b.validate(); err != nil {
+		return err
+	}
+	if concreteType == nil {
+		return &InvalidBindingError{Reason: "concrete type cannot be nil"}
+	}
+
+	concreteT := reflect.TypeOf(concreteType)
+	if concreteT.Kind() != reflect.Ptr || concreteT.Elem().Kind() != reflect.Struct {
+		return &InvalidBindingError{
+			Reason: fmt.Sprintf("concrete type must be pointer to struct, got %v", concreteT),
+		}
+	}
+	if !concreteT.AssignableTo(b.needs) {
+		return &InvalidBindingError{
+			Reason: fmt.Sprintf("%v is not assignable to %v", concreteT, b.needs),
+		}
+	}
+
+	b.container.contextual.set(b.consumer, b.needs, &contextualBinding{concrete: concreteT})
+	return nil
+}
+
+// GiveFactory completes the contextual binding with a factory function.
+//
+// Example:
+//
+//	container.When((*ReportService)(nil)).
+//	    Needs((*Logger)(nil)).
+//	    GiveFactory(func(c *nasc.Nasc) (interface{}, error) {
+//	        return NewFileLogger("reports.log"), nil
+//	    })
+func (b *ContextualBindingBuilder) GiveFactory(factory FactoryFunc) error {
+	if err := b.validate(); err != nil {
+		return err
+	}
+	if factory == nil {
+		return &InvalidBindingError{Reason: "factory function cannot be nil"}
+	}
+
+	b.container.contextual.set(b.consumer, b.needs, &contextualBinding{factory: factory})
+	return nil
+}
+
+// validate checks that When and Needs were given usable types.
+func (b *ContextualBindingBuilder) validate() error {
+	if b.container.IsFrozen() {
+		return ErrContainerFrozen
+	}
+	if b.consumer == nil {
+		return &InvalidBindingError{Reason: "contextual consumer type cannot be nil"}
+	}
+	if b.needs == nil {
+		return &InvalidBindingError{Reason: "contextual dependency type cannot be nil"}
+	}
+	return nil
+}
+
+// resolveContextual creates the instance for a contextual binding. A bound
+// concrete type is resolved with ctx when one is given, and through r
+// otherwise, so a scope resolves its own scoped instance.
+func (n *Nasc) resolveContextual(binding *contextualBinding, r resolver, ctx *resolutionContext) (instance interface{}, err error) {
+	if binding.factory != nil {
+		return binding.factory(n)
+	}
+
+	// Prefer a container binding for the concrete type when one exists
+	if n.registry.Has(binding.concrete.Elem()) {
+		if ctx != nil {
+			return n.makeSafeWithContext(binding.concrete.Elem(), "", ctx)
+		}
+		defer func() {
+			if p := recover(); p != nil {
+				err = fmt.Errorf("resolution panicked: %v", p)
+			}
+		}()
+		return r.Make(reflect.Zero(binding.concrete).Interface()), nil
+	}
+
+	instance = reflect.New(binding.concrete.Elem()).Interface()
+	if err := n.initialize(instance); err != nil {
+		return nil, err
+	}
+	return instance, nil
+}
+
+// contextualValue resolves a contextual binding as a value of type t,
+// reporting a nil or mismatched result as an error.
+func (n *Nasc) contextualValue(binding *contextualBinding, t reflect.Type, r resolver, ctx *resolutionContext) (reflect.Value, error) {
+	resolved, err := n.resolveContextual(binding, r, ctx)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	return paramValue(resolved, t)
+}
+
+// clone returns an independent copy of the contextual registry.
+func (cr *contextualRegistry) clone() *contextualRegistry {
+	cr.mu.RLock()
+	defer cr.mu.RUnlock()
+
+	c := newContextualRegistry()
+	for consumer, needs := range cr.bindings {
+		c.bindings[consumer] = make(map[reflect.Type]*contextualBinding, len(needs))
+		for t, binding := range needs {
+			c.bindings[consumer][t] = binding
+		}
+	}
+	return c
+}
@@ -0,0 +1,190 @@
+package nasc
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type ReportService interface {
+	Report() string
+}
+
+type reportServiceImpl struct {
+	Logger Logger
+}
+
+func (r *reportServiceImpl) Report() string { return "report" }
+
+func newReportService(logger Logger) *reportServiceImpl {
+	return &reportServiceImpl{Logger: logger}
+}
+
+type autoWiredReport struct {
+	Logger Logger `inject:""`
+}
+
+func (r *autoWiredReport) Report() string { return "report" }
+
+func TestWhen_ConstructorReceivesContextualBinding(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+	_ = container.BindConstructor((*ReportService)(nil), newReportService)
+	_ = container.BindConstructor((*ConstructorService)(nil), NewServiceWithLogger)
+
+	err := container.When((*ReportService)(nil)).Needs((*Logger)(nil)).Give((*FileLogger)(nil))
+	if err != nil {
+		t.Fatalf("Give failed: %v", err)
+	}
+
+	report := container.Make((*ReportService)(nil)).(*reportServiceImpl)
+	if _, ok := report.Logger.(*FileLogger); !ok {
+		t.Errorf("ReportService got %T, expected *FileLogger", report.Logger)
+	}
+
+	// Other consumers still receive the default binding
+	other := container.Make((*ConstructorService)(nil)).(*ConstructorServiceImpl)
+	if _, ok := other.Logger.(*ConsoleLogger); !ok {
+		t.Errorf("other consumer got %T, expected *ConsoleLogger", other.Logger)
+	}
+
+	// Safe resolution honors the contextual binding too
+	safe, err := container.MakeSafe((*ReportService)(nil))
+	if err != nil {
+		t.Fatalf("MakeSafe failed: %v", err)
+	}
+	if _, ok := safe.(*reportServiceImpl).Logger.(*FileLogger); !ok {
+		t.Error("MakeSafe ignored the contextual binding")
+	}
+}
+
+func TestWhen_ConcreteConsumerAndBoundGiven(t *testing.T) {
+	container := New()
+	_ = container.Singleton((*FileLogger)(nil), &FileLogger{})
+	_ = container.BindConstructor((*ReportService)(nil), newReportService)
+
+	_ = container.When((*reportServiceImpl)(nil)).Needs((*Logger)(nil)).Give((*FileLogger)(nil))
+
+	first := container.Make((*ReportService)(nil)).(*reportServiceImpl)
+	second := container.Make((*ReportService)(nil)).(*reportServiceImpl)
+	if first.Logger != second.Logger {
+		t.Error("given type bound as singleton should be resolved from the container")
+	}
+}
+
+func TestWhen_AutoWire(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	_ = container.When((*autoWiredReport)(nil)).Needs((*Logger)(nil)).GiveFactory(func(c *Nasc) (interface{}, error) {
+		return &FileLogger{filename: "reports.log"}, nil
+	})
+
+	report := &autoWiredReport{}
+	if err := container.AutoWire(report); err != nil {
+		t.Fatalf("AutoWire failed: %v", err)
+	}
+	logger, ok := report.Logger.(*FileLogger)
+	if !ok || logger.filename != "reports.log" {
+		t.Errorf("auto-wired field got %T, expected factory FileLogger", report.Logger)
+	}
+
+	// The abstract type an instance is resolved for takes precedence
+	_ = container.When((*ReportService)(nil)).Needs((*Logger)(nil)).Give((*FileLogger)(nil))
+	named := &autoWiredReport{}
+	if err := container.autoWire(named, reflect.TypeOf((*ReportService)(nil)).Elem()); err != nil {
+		t.Fatalf("autoWire failed: %v", err)
+	}
+	if logger, ok := named.Logger.(*FileLogger); !ok || logger.filename != "" {
+		t.Errorf("expected the abstract consumer's binding, got %#v", named.Logger)
+	}
+}
+
+func TestWhen_InvalidBindings(t *testing.T) {
+	container := New()
+
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{"nil consumer", container.When(nil).Needs((*Logger)(nil)).Give((*FileLogger)(nil))},
+		{"nil needs", container.When((*ReportService)(nil)).Needs(nil).Give((*FileLogger)(nil))},
+		{"nil concrete", container.When((*ReportService)(nil)).Needs((*Logger)(nil)).Give(nil)},
+		{"not assignable", container.When((*ReportService)(nil)).Needs((*Logger)(nil)).Give((*MockDB)(nil))},
+		{"nil factory", container.When((*ReportService)(nil)).Needs((*Logger)(nil)).GiveFactory(nil)},
+	}
+
+	for _, tt := range tests {
+		var invalid *InvalidBindingError
+		if !errors.As(tt.err, &invalid) {
+			t.Errorf("%s: expected InvalidBindingError, got %v", tt.name, tt.err)
+		}
+	}
+}
+
+func TestWhen_FactoryResultIsChecked(t *testing.T) {
+	tests := []struct {
+		name   string
+		result interface{}
+	}{
+		{"nil", nil},
+		{"not assignable", &MockDB{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			container := New()
+			_ = container.BindConstructor((*ReportService)(nil), newReportService)
+			_ = container.When((*autoWiredReport)(nil)).Needs((*Logger)(nil)).GiveFactory(func(c *Nasc) (interface{}, error) {
+				return tt.result, nil
+			})
+			_ = container.When((*ReportService)(nil)).Needs((*Logger)(nil)).GiveFactory(func(c *Nasc) (interface{}, error) {
+				return tt.result, nil
+			})
+
+			report := &autoWiredReport{}
+			err := container.AutoWire(report)
+			if tt.result == nil {
+				if err != nil || report.Logger != nil {
+					t.Errorf("nil result should leave the field zero, got %v, %v", report.Logger, err)
+				}
+			} else if err == nil {
+				t.Error("AutoWire should fail for a non-assignable factory result")
+			}
+
+			_, err = container.MakeSafe((*ReportService)(nil))
+			if (err != nil) != (tt.result != nil) {
+				t.Errorf("MakeSafe returned %v", err)
+			}
+			func() {
+				defer func() {
+					if p := recover(); p != nil && tt.result == nil {
+						t.Errorf("Make panicked for a nil result: %v", p)
+					}
+				}()
+				container.Make((*ReportService)(nil))
+			}()
+		})
+	}
+}
+
+func TestWhen_ScopedGivenResolvesFromScope(t *testing.T) {
+	container := New()
+	_ = container.Scoped((*FileLogger)(nil), &FileLogger{})
+	_ = container.BindConstructor((*ReportService)(nil), newReportService)
+	_ = container.When((*ReportService)(nil)).Needs((*Logger)(nil)).Give((*FileLogger)(nil))
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+	other := container.CreateScope()
+	defer other.Dispose()
+
+	first := scope.Make((*ReportService)(nil)).(*reportServiceImpl)
+	second := scope.Make((*ReportService)(nil)).(*reportServiceImpl)
+	if first.Logger != second.Logger {
+		t.Error("a scope should give the same scoped instance to both resolutions")
+	}
+	if other.Make((*ReportService)(nil)).(*reportServiceImpl).Logger == first.Logger {
+		t.Error("different scopes should get different scoped instances")
+	}
+}
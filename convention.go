@@ -0,0 +1,103 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// RegisterConvention binds each of types to every interface in interfaces
+// that it implements, replacing one Bind call per pair. Go cannot list the
+// interfaces a type implements, so the candidates are given explicitly.
+// Options apply to every binding; the default lifetime is transient.
+//
+// Each type must be a pointer to struct implementing at least one of the
+// interfaces. Two types implementing the same interface are ambiguous
+// unless the bindings are tagged (see WithTags), in which case every
+// implementation is registered under the tags. Problems with the arguments,
+// and bindings that already exist, are reported before anything is
+// registered.
+//
+// Example:
+//
+//	container.RegisterConvention(
+//	    []interface{}{(*UserRepository)(nil), (*OrderRepository)(nil), (*Mailer)(nil)},
+//	    []interface{}{&SQLUserRepository{}, &SQLOrderRepository{}, &SMTPMailer{}},
+//	    nasc.WithLifetime(nasc.LifetimeSingleton),
+//	)
+func (n *Nasc) RegisterConvention(interfaces []interface{}, types []interface{}, opts ...BindOption) error {
+	options := bindOptions{lifetime: LifetimeTransient}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	abstractTypes := make([]reflect.Type, 0, len(interfaces))
+	for _, token := range interfaces {
+		if token == nil {
+			return &InvalidBindingError{Reason: "convention interface cannot be nil"}
+		}
+		abstractT := typeOfToken(token)
+		if abstractT.Kind() != reflect.Interface {
+			return &InvalidBindingError{Reason: fmt.Sprintf("convention interfaces must be interface types, got %v", abstractT)}
+		}
+		abstractTypes = append(abstractTypes, abstractT)
+	}
+
+	type pair struct{ abstract, concrete reflect.Type }
+	var pairs []pair
+	implemented := make(map[reflect.Type]reflect.Type)
+
+	for _, typ := range types {
+		if typ == nil {
+			return &InvalidBindingError{Reason: "convention type cannot be nil"}
+		}
+		concreteT := reflect.TypeOf(typ)
+		if concreteT.Kind() != reflect.Ptr || concreteT.Elem().Kind() != reflect.Struct {
+			return &InvalidBindingError{Reason: fmt.Sprintf("convention types must be pointers to struct, got %v", concreteT)}
+		}
+
+		matched := false
+		for _, abstractT := range abstractTypes {
+			if !concreteT.Implements(abstractT) {
+				continue
+			}
+			if previous, ok := implemented[abstractT]; ok && len(options.tags) == 0 {
+				return &InvalidBindingError{
+					Reason: fmt.Sprintf("both %v and %v implement %v; tag the bindings or register one explicitly", previous, concreteT, abstractT),
+				}
+			}
+			implemented[abstractT] = concreteT
+			pairs = append(pairs, pair{abstract: abstractT, concrete: concreteT})
+			matched = true
+		}
+		if !matched {
+			return &InvalidBindingError{Reason: fmt.Sprintf("%v implements none of the convention's interfaces", concreteT)}
+		}
+	}
+
+	bindings := make([]*registry.Binding, 0, len(pairs))
+	for _, p := range pairs {
+		binding, err := typeBinding(p.abstract, p.concrete, options)
+		if err != nil {
+			return err
+		}
+		if _, exists := n.lookupBinding(binding); exists {
+			return &BindingAlreadyExistsError{Type: p.abstract}
+		}
+		bindings = append(bindings, binding)
+	}
+
+	for _, binding := range bindings {
+		var err error
+		if binding.Name != "" {
+			err = n.registerNamed(binding)
+		} else {
+			err = n.register(binding)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package nasc
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRegisterConvention(t *testing.T) {
+	c := New()
+	err := c.RegisterConvention(
+		[]interface{}{(*Logger)(nil), (*Database)(nil)},
+		[]interface{}{&ConsoleLogger{}, &MockDB{}},
+		WithLifetime(LifetimeSingleton),
+	)
+	if err != nil {
+		t.Fatalf("RegisterConvention() error = %v", err)
+	}
+
+	logger := c.Make((*Logger)(nil))
+	if _, ok := logger.(*ConsoleLogger); !ok {
+		t.Errorf("Make(Logger) = %T, want *ConsoleLogger", logger)
+	}
+	if logger != c.Make((*Logger)(nil)) {
+		t.Error("convention lifetime was not applied")
+	}
+	if _, ok := c.Make((*Database)(nil)).(*MockDB); !ok {
+		t.Error("Database was not bound to *MockDB")
+	}
+}
+
+func TestRegisterConvention_Tagged(t *testing.T) {
+	c := New()
+	err := c.RegisterConvention(
+		[]interface{}{(*Logger)(nil)},
+		[]interface{}{&ConsoleLogger{}, &FileLogger{}},
+		WithTags("logger"),
+	)
+	if err != nil {
+		t.Fatalf("RegisterConvention() error = %v", err)
+	}
+	if got := len(c.MakeWithTag("logger")); got != 2 {
+		t.Errorf("MakeWithTag() returned %d instances, want 2", got)
+	}
+}
+
+func TestRegisterConvention_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		interfaces []interface{}
+		types      []interface{}
+		want       string
+	}{
+		{"ambiguous", []interface{}{(*Logger)(nil)}, []interface{}{&ConsoleLogger{}, &FileLogger{}}, "both"},
+		{"unmatched", []interface{}{(*Logger)(nil)}, []interface{}{&MockDB{}}, "implements none"},
+		{"not an interface", []interface{}{(**MockDB)(nil)}, []interface{}{&MockDB{}}, "interface types"},
+		{"not a struct pointer", []interface{}{(*Logger)(nil)}, []interface{}{"logger"}, "pointers to struct"},
+		{"nil type", []interface{}{(*Logger)(nil)}, []interface{}{nil}, "cannot be nil"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New()
+			err := c.RegisterConvention(tt.interfaces, tt.types)
+			var invalid *InvalidBindingError
+			if !errors.As(err, &invalid) || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("RegisterConvention() error = %v, want InvalidBindingError containing %q", err, tt.want)
+			}
+			if len(c.registry.GetAllTypes()) != 0 {
+				t.Error("bindings were registered despite the error")
+			}
+		})
+	}
+}
+
+func TestRegisterConvention_ExistingBinding(t *testing.T) {
+	c := New()
+	_ = c.Bind((*Database)(nil), &ReplicaDB{})
+
+	err := c.RegisterConvention(
+		[]interface{}{(*Logger)(nil), (*Database)(nil)},
+		[]interface{}{&ConsoleLogger{}, &MockDB{}},
+	)
+	var exists *BindingAlreadyExistsError
+	if !errors.As(err, &exists) {
+		t.Fatalf("RegisterConvention() error = %v, want BindingAlreadyExistsError", err)
+	}
+	if c.registry.Has(typeOfToken((*Logger)(nil))) {
+		t.Error("Logger was registered despite the error")
+	}
+}
@@ -0,0 +1,283 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// decorator is one wrapper registered with Decorate, or an extender
+// registered with Extend.
+type decorator struct {
+	fn             reflect.Value
+	takesContainer bool
+	returnsError   bool
+
+	// extend is set instead of fn for extenders
+	extend func(interface{}, *Nasc) interface{}
+
+	// origin is the module that registered the decorator (see ReplaceModule)
+	origin string
+}
+
+// decoratorSet holds the decorators of each abstract type, in registration
+// order.
+type decoratorSet struct {
+	mu     sync.RWMutex
+	byType map[reflect.Type][]*decorator
+
+	// instances caches the decorated instance of each binding registered
+	// with BindInstanceAs, which must resolve to the same value every time
+	instances map[*registry.Binding]interface{}
+}
+
+// Decorate wraps every instance created for abstractType's bindings with
+// decorator, layering cross-cutting behavior such as metrics, retries or
+// caching onto a binding without replacing it. Decorators apply in
+// registration order, so the last one registered is outermost.
+//
+// The decorator is a function taking the inner instance as its first
+// parameter, optionally followed by *Nasc, and returning the wrapped
+// instance, optionally with an error:
+//
+//	func(inner T) T
+//	func(inner T, c *Nasc) T
+//	func(inner T, c *Nasc) (T, error)
+//
+// Decorators apply to default, named and tagged bindings of abstractType,
+// whatever their lifetime, after the instance is initialized. The wrapper
+// is what gets cached and disposed, so it should forward Dispose if the
+// inner instance needs it. Instances created before Decorate is called
+// are not wrapped. An instance registered with BindInstanceAs is wrapped
+// once, and every later resolution returns the same wrapper.
+//
+// Example:
+//
+//	container.Decorate((*Logger)(nil), func(inner Logger, c *nasc.Nasc) Logger {
+//	    return &TracingLogger{inner: inner}
+//	})
+func (n *Nasc) Decorate(abstractType interface{}, decoratorFunc interface{}) error {
+	if abstractType == nil {
+		return &InvalidBindingError{Reason: "abstract type cannot be nil"}
+	}
+	if decoratorFunc == nil {
+		return &InvalidBindingError{Reason: "decorator cannot be nil"}
+	}
+
+	abstractT := typeOfToken(abstractType)
+	fn := reflect.ValueOf(decoratorFunc)
+	fnType := fn.Type()
+	if fnType.Kind() != reflect.Func {
+		return &InvalidBindingError{Reason: fmt.Sprintf("decorator must be a function, got %v", fnType)}
+	}
+
+	d := &decorator{fn: fn, origin: n.origin}
+	switch {
+	case fnType.NumIn() == 1 && fnType.In(0) == abstractT:
+	case fnType.NumIn() == 2 && fnType.In(0) == abstractT && fnType.In(1) == nascType:
+		d.takesContainer = true
+	default:
+		return &InvalidBindingError{
+			Reason: fmt.Sprintf("decorator must take (%v) or (%v, *nasc.Nasc), got %v", abstractT, abstractT, fnType),
+		}
+	}
+
+	switch {
+	case fnType.NumOut() == 1:
+	case fnType.NumOut() == 2 && fnType.Out(1) == errorType:
+		d.returnsError = true
+	default:
+		return &InvalidBindingError{
+			Reason: fmt.Sprintf("decorator must return %v, optionally with an error, got %v", abstractT, fnType),
+		}
+	}
+	if !fnType.Out(0).AssignableTo(abstractT) {
+		return &InvalidBindingError{
+			Reason: fmt.Sprintf("decorator returns %v, which is not assignable to %v", fnType.Out(0), abstractT),
+		}
+	}
+
+	n.decorators.add(abstractT, d)
+	return nil
+}
+
+// add appends a decorator for abstractT.
+func (ds *decoratorSet) add(abstractT reflect.Type, d *decorator) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	if ds.byType == nil {
+		ds.byType = make(map[reflect.Type][]*decorator)
+	}
+	ds.byType[abstractT] = append(ds.byType[abstractT], d)
+	for binding := range ds.instances {
+		if binding.AbstractType == abstractT {
+			ds.instances[binding] = nil
+		}
+	}
+}
+
+// markInstance records binding as an instance binding, whose decorated
+// instance is cached.
+func (ds *decoratorSet) markInstance(binding *registry.Binding) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	if ds.instances == nil {
+		ds.instances = make(map[*registry.Binding]interface{})
+	}
+	ds.instances[binding] = nil
+}
+
+// decorate applies the decorators of a binding's abstract type to a newly
+// created instance. Instance bindings are decorated once; later calls
+// return the cached wrapper.
+func (n *Nasc) decorate(binding *registry.Binding, instance interface{}) (interface{}, error) {
+	n.decorators.mu.RLock()
+	decorators := n.decorators.byType[binding.AbstractType]
+	cached, isInstance := n.decorators.instances[binding]
+	n.decorators.mu.RUnlock()
+
+	if !isInstance || len(decorators) == 0 {
+		return n.applyDecorators(binding, decorators, instance)
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	// Decorators may resolve from the container, so they run unlocked; when
+	// two resolutions race, the first wrapper stored is kept
+	decorated, err := n.applyDecorators(binding, decorators, instance)
+	if err != nil {
+		return nil, err
+	}
+	n.decorators.mu.Lock()
+	defer n.decorators.mu.Unlock()
+	if cached, ok := n.decorators.instances[binding]; ok {
+		if cached != nil {
+			return cached, nil
+		}
+		n.decorators.instances[binding] = decorated
+	}
+	return decorated, nil
+}
+
+// applyDecorators wraps instance with each of decorators in turn.
+func (n *Nasc) applyDecorators(binding *registry.Binding, decorators []*decorator, instance interface{}) (interface{}, error) {
+	for _, d := range decorators {
+		if d.extend != nil {
+			extended := d.extend(instance, n)
+			if !satisfies(extended, binding.AbstractType) {
+				return nil, fmt.Errorf("extender for %v returned %T, which does not satisfy it", binding.AbstractType, extended)
+			}
+			instance = extended
+			continue
+		}
+
+		inner, err := paramValue(instance, binding.AbstractType)
+		if err != nil {
+			return nil, err
+		}
+		args := []reflect.Value{inner}
+		if d.takesContainer {
+			args = append(args, reflect.ValueOf(n))
+		}
+		results := d.fn.Call(args)
+		if d.returnsError && !results[1].IsNil() {
+			return nil, fmt.Errorf("decorator %v failed: %w", d.fn.Type(), results[1].Interface().(error))
+		}
+		instance = results[0].Interface()
+	}
+	return instance, nil
+}
+
+// satisfies reports whether instance can be returned for abstractT: it is
+// assignable to abstractT or, for a concrete abstract type, a pointer to it.
+func satisfies(instance interface{}, abstractT reflect.Type) bool {
+	if instance == nil {
+		return false
+	}
+	t := reflect.TypeOf(instance)
+	return t.AssignableTo(abstractT) || (abstractT.Kind() != reflect.Interface && t == reflect.PointerTo(abstractT))
+}
+
+// mustDecorate decorates an instance, panicking on failure as Make does.
+func (n *Nasc) mustDecorate(binding *registry.Binding, instance interface{}) interface{} {
+	decorated, err := n.decorate(binding, instance)
+	if err != nil {
+		panic(fmt.Sprintf("failed to decorate instance of type %v: %v", binding.AbstractType, err))
+	}
+	return decorated
+}
+
+// removeOrigins drops the decorators registered by the given origins and
+// returns them.
+func (ds *decoratorSet) removeOrigins(origins map[string]bool) map[reflect.Type][]*decorator {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	removed := make(map[reflect.Type][]*decorator)
+	for t, decorators := range ds.byType {
+		kept := decorators[:0:0]
+		for _, d := range decorators {
+			if origins[d.origin] {
+				removed[t] = append(removed[t], d)
+			} else {
+				kept = append(kept, d)
+			}
+		}
+		if len(kept) == 0 {
+			delete(ds.byType, t)
+		} else {
+			ds.byType[t] = kept
+		}
+	}
+	for binding := range ds.instances {
+		ds.instances[binding] = nil
+	}
+	return removed
+}
+
+// restore adds back decorators returned by removeOrigins.
+func (ds *decoratorSet) restore(removed map[reflect.Type][]*decorator) {
+	if len(removed) == 0 {
+		return
+	}
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	if ds.byType == nil {
+		ds.byType = make(map[reflect.Type][]*decorator)
+	}
+	for t, decorators := range removed {
+		ds.byType[t] = append(ds.byType[t], decorators...)
+	}
+	for binding := range ds.instances {
+		ds.instances[binding] = nil
+	}
+}
+
+// snapshot copies the registered decorators, for Clone.
+func (ds *decoratorSet) snapshot() map[reflect.Type][]*decorator {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	byType := make(map[reflect.Type][]*decorator, len(ds.byType))
+	for t, decorators := range ds.byType {
+		byType[t] = append([]*decorator(nil), decorators...)
+	}
+	return byType
+}
+
+// instanceBindings copies the set of instance bindings with their cached
+// wrappers cleared, for Clone.
+func (ds *decoratorSet) instanceBindings() map[*registry.Binding]interface{} {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	instances := make(map[*registry.Binding]interface{}, len(ds.instances))
+	for binding := range ds.instances {
+		instances[binding] = nil
+	}
+	return instances
+}
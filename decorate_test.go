@@ -0,0 +1,163 @@
+package nasc
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type prefixLogger struct {
+	inner  Logger
+	prefix string
+}
+
+func (l *prefixLogger) Log(msg string) {
+	l.inner.Log(l.prefix + msg)
+}
+
+func TestDecorate(t *testing.T) {
+	c := New()
+	_ = c.Singleton((*Logger)(nil), &ConsoleLogger{})
+
+	if err := c.Decorate((*Logger)(nil), func(inner Logger) Logger {
+		return &prefixLogger{inner: inner, prefix: "a:"}
+	}); err != nil {
+		t.Fatalf("Decorate() error = %v", err)
+	}
+	if err := c.Decorate((*Logger)(nil), func(inner Logger, n *Nasc) Logger {
+		if n != c {
+			t.Error("decorator received the wrong container")
+		}
+		return &prefixLogger{inner: inner, prefix: "b:"}
+	}); err != nil {
+		t.Fatalf("Decorate() error = %v", err)
+	}
+
+	logger := c.Make((*Logger)(nil)).(Logger)
+	if logger != c.Make((*Logger)(nil)) {
+		t.Error("decorated singleton was not cached")
+	}
+	logger.Log("hello")
+
+	outer := logger.(*prefixLogger)
+	inner := outer.inner.(*prefixLogger)
+	console := inner.inner.(*ConsoleLogger)
+	if outer.prefix != "b:" || inner.prefix != "a:" {
+		t.Error("decorators were not applied in registration order")
+	}
+	if got := console.messages; len(got) != 1 || got[0] != "a:b:hello" {
+		t.Errorf("messages = %v, want [a:b:hello]", got)
+	}
+}
+
+func TestDecorate_InstanceBinding(t *testing.T) {
+	c := New()
+	console := &ConsoleLogger{}
+	_ = c.BindInstanceAs(console, (*Logger)(nil))
+
+	calls := 0
+	_ = c.Decorate((*Logger)(nil), func(inner Logger) Logger {
+		calls++
+		return &prefixLogger{inner: inner, prefix: "a:"}
+	})
+
+	logger := c.Make((*Logger)(nil))
+	if c.Make((*Logger)(nil)) != logger {
+		t.Error("instance binding should resolve to the same wrapper every time")
+	}
+	if calls != 1 {
+		t.Errorf("decorator ran %d times, want 1", calls)
+	}
+	if logger.(*prefixLogger).inner != console {
+		t.Error("wrapper should hold the bound instance")
+	}
+
+	_ = c.Decorate((*Logger)(nil), func(inner Logger) Logger {
+		return &prefixLogger{inner: inner, prefix: "b:"}
+	})
+	if outer := c.Make((*Logger)(nil)).(*prefixLogger); outer.prefix != "b:" {
+		t.Error("a new decorator should wrap the instance again")
+	}
+}
+
+func TestDecorate_AllPaths(t *testing.T) {
+	c := New()
+	_ = c.Bind((*Logger)(nil), &ConsoleLogger{})
+	_ = c.BindNamed((*Logger)(nil), &FileLogger{}, "file")
+	_ = c.Scoped((*Database)(nil), &MockDB{})
+
+	decorated := 0
+	_ = c.Decorate((*Logger)(nil), func(inner Logger) Logger {
+		decorated++
+		return &prefixLogger{inner: inner}
+	})
+	_ = c.Decorate((*Database)(nil), func(inner Database) Database {
+		decorated++
+		return inner
+	})
+
+	if _, ok := c.Make((*Logger)(nil)).(*prefixLogger); !ok {
+		t.Error("Make() result was not decorated")
+	}
+	if _, ok := c.MakeNamed((*Logger)(nil), "file").(*prefixLogger); !ok {
+		t.Error("MakeNamed() result was not decorated")
+	}
+	if instance, err := c.MakeSafe((*Logger)(nil)); err != nil {
+		t.Fatalf("MakeSafe() error = %v", err)
+	} else if _, ok := instance.(*prefixLogger); !ok {
+		t.Error("MakeSafe() result was not decorated")
+	}
+
+	scope := c.CreateScope()
+	defer scope.Dispose()
+	scope.Make((*Database)(nil))
+	scope.Make((*Database)(nil))
+
+	if decorated != 4 {
+		t.Errorf("decorators ran %d times, want 4", decorated)
+	}
+
+	if err := c.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if decorated != 4 {
+		t.Error("Validate() ran decorators")
+	}
+}
+
+func TestDecorate_Error(t *testing.T) {
+	c := New()
+	_ = c.Bind((*Logger)(nil), &ConsoleLogger{})
+	failure := errors.New("no tracer")
+	_ = c.Decorate((*Logger)(nil), func(inner Logger, n *Nasc) (Logger, error) {
+		return nil, failure
+	})
+
+	if _, err := c.MakeSafe((*Logger)(nil)); !errors.Is(err, failure) {
+		t.Errorf("MakeSafe() error = %v, want the decorator's error", err)
+	}
+}
+
+func TestDecorate_InvalidSignatures(t *testing.T) {
+	tests := []struct {
+		name      string
+		decorator interface{}
+		want      string
+	}{
+		{"not a function", "wrap", "must be a function"},
+		{"wrong parameter", func(inner Database) Logger { return nil }, "must take"},
+		{"extra parameter", func(inner Logger, s string) Logger { return nil }, "must take"},
+		{"wrong result", func(inner Logger) Database { return nil }, "not assignable"},
+		{"second result not error", func(inner Logger) (Logger, bool) { return nil, false }, "must return"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := New().Decorate((*Logger)(nil), tt.decorator)
+			var invalid *InvalidBindingError
+			if !errors.As(err, &invalid) || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Decorate() error = %v, want InvalidBindingError containing %q", err, tt.want)
+			}
+		})
+	}
+}
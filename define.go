@@ -0,0 +1,81 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// Dependencies is the list of types an inline factory declares with Deps.
+type Dependencies []reflect.Type
+
+// Deps declares the dependencies of an inline factory passed to Define,
+// in parameter order, as interface tokens like (*Database)(nil).
+func Deps(types ...interface{}) Dependencies {
+	deps := make(Dependencies, len(types))
+	for i, t := range types {
+		if t != nil {
+			deps[i] = typeOfToken(t)
+		}
+	}
+	return deps
+}
+
+// Define registers a transient binding backed by an inline factory whose
+// dependencies are declared up front. The declaration is checked against
+// the factory's signature at bind time, so a mismatched closure fails on
+// registration instead of on first resolution.
+//
+// The factory follows the constructor rules (see BindConstructor): it
+// returns an instance, optionally with an error, and its result must be
+// assignable to abstractType.
+//
+// Example:
+//
+//	container.Define((*ReportService)(nil), nasc.Deps((*Database)(nil), (*Clock)(nil)),
+//	    func(db Database, clock Clock) *ReportSvc {
+//	        return &ReportSvc{db: db, clock: clock, started: clock.Now()}
+//	    })
+func (n *Nasc) Define(abstractType interface{}, deps Dependencies, factory interface{}) error {
+	if abstractType == nil {
+		return &InvalidBindingError{Reason: "abstract type cannot be nil"}
+	}
+
+	info, err := n.reflectionCache.parseConstructor(factory)
+	if err != nil {
+		return &InvalidBindingError{Reason: fmt.Sprintf("invalid factory: %v", err)}
+	}
+
+	abstractT := typeOfToken(abstractType)
+	if !info.returnType.AssignableTo(abstractT) {
+		return &InvalidBindingError{
+			Reason: fmt.Sprintf("factory returns %v, which is not assignable to %v", info.returnType, abstractT),
+		}
+	}
+
+	if len(deps) != info.numParams {
+		return &InvalidBindingError{
+			Reason: fmt.Sprintf("Deps declares %d dependencies, but the factory takes %d parameters", len(deps), info.numParams),
+		}
+	}
+	for i, dep := range deps {
+		if dep == nil {
+			return &InvalidBindingError{Reason: fmt.Sprintf("dependency %d cannot be nil", i)}
+		}
+		if dep != info.paramTypes[i] {
+			return &InvalidBindingError{
+				Reason: fmt.Sprintf("factory parameter %d is %v, but Deps declares %v", i, info.paramTypes[i], dep),
+			}
+		}
+	}
+
+	binding := &registry.Binding{
+		AbstractType: abstractT,
+		ConcreteType: info.returnType,
+		Lifetime:     string(LifetimeTransient),
+		Constructor:  info,
+	}
+
+	return n.register(binding)
+}
@@ -0,0 +1,68 @@
+package nasc
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDefine_ResolvesDeclaredDeps(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+	_ = container.Bind((*Database)(nil), &MockDB{})
+
+	err := container.Define((*ConstructorService)(nil), Deps((*Logger)(nil), (*Database)(nil)),
+		func(logger Logger, db Database) *ConstructorServiceImpl {
+			return &ConstructorServiceImpl{Logger: logger, Database: db}
+		})
+	if err != nil {
+		t.Fatalf("Define failed: %v", err)
+	}
+
+	svc := container.Make((*ConstructorService)(nil)).(*ConstructorServiceImpl)
+	if svc.Logger == nil || svc.Database == nil {
+		t.Error("declared dependencies should be injected")
+	}
+}
+
+func TestDefine_ValidatesAtBindTime(t *testing.T) {
+	tests := []struct {
+		name    string
+		deps    Dependencies
+		factory interface{}
+		wantErr string
+	}{
+		{
+			name:    "count mismatch",
+			deps:    Deps((*Logger)(nil)),
+			factory: func() *ConstructorServiceImpl { return nil },
+			wantErr: "declares 1 dependencies",
+		},
+		{
+			name:    "type mismatch",
+			deps:    Deps((*Database)(nil)),
+			factory: func(Logger) *ConstructorServiceImpl { return nil },
+			wantErr: "parameter 0 is nasc.Logger",
+		},
+		{
+			name:    "wrong return type",
+			deps:    Deps(),
+			factory: func() *ConsoleLogger { return nil },
+			wantErr: "not assignable",
+		},
+		{
+			name:    "not a function",
+			deps:    Deps(),
+			factory: "factory",
+			wantErr: "invalid factory",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := New().Define((*ConstructorService)(nil), tt.deps, tt.factory)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// instanceTableSize bounds how many instances are remembered for Describe.
+// The oldest entries are evicted first.
+const instanceTableSize = 4096
+
+// InstanceInfo describes how an instance was created (see Describe).
+type InstanceInfo struct {
+	// Binding is the binding the instance was created from
+	Binding BindingInfo
+
+	// Scope is the scope that created the instance; nil for the container
+	Scope *Scope
+
+	// Created is when the instance was created
+	Created time.Time
+
+	// Path is the resolution path that led to the instance, outermost
+	// first and ending with the instance's own type
+	Path []string
+}
+
+// String formats the info for logs, e.g. "Logger (singleton) created
+// 2006-01-02T15:04:05Z via Service -> Logger".
+func (i InstanceInfo) String() string {
+	label := i.Binding.Type.String()
+	if i.Binding.Name != "" {
+		label = fmt.Sprintf("%s[%s]", label, i.Binding.Name)
+	}
+	s := fmt.Sprintf("%s (%s) created %s", label, i.Binding.Lifetime, i.Created.Format(time.RFC3339Nano))
+	if i.Scope != nil {
+		s += " in scope"
+	}
+	if len(i.Path) > 0 {
+		s += " via " + strings.Join(i.Path, " -> ")
+	}
+	return s
+}
+
+// instanceTable is a side table of creation metadata keyed by instance
+// address. Keys are plain addresses, so entries never keep instances alive;
+// an address reused after garbage collection is overwritten by its new owner.
+type instanceTable struct {
+	mu      sync.Mutex
+	entries map[uintptr]InstanceInfo
+	ring    []uintptr
+	next    int
+}
+
+// newInstanceTable creates an empty instance table.
+func newInstanceTable() *instanceTable {
+	return &instanceTable{
+		entries: make(map[uintptr]InstanceInfo),
+		ring:    make([]uintptr, 0, instanceTableSize),
+	}
+}
+
+// put records info for the instance at addr, evicting the oldest entry
+// when the table is full.
+func (it *instanceTable) put(addr uintptr, info InstanceInfo) {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	if _, exists := it.entries[addr]; !exists {
+		if len(it.ring) < instanceTableSize {
+			it.ring = append(it.ring, addr)
+		} else {
+			delete(it.entries, it.ring[it.next])
+			it.ring[it.next] = addr
+			it.next = (it.next + 1) % instanceTableSize
+		}
+	}
+	it.entries[addr] = info
+}
+
+// get returns the info recorded for the instance at addr.
+func (it *instanceTable) get(addr uintptr) (InstanceInfo, bool) {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	info, ok := it.entries[addr]
+	return info, ok
+}
+
+// instanceAddr returns the address identifying instance. Only pointers to
+// values with a size are identifiable: zero-size values share an address.
+func instanceAddr(instance interface{}) (uintptr, bool) {
+	v := reflect.ValueOf(instance)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Type().Elem().Size() == 0 {
+		return 0, false
+	}
+	return v.Pointer(), true
+}
+
+// WithInstanceTracking stamps every instance the container creates with
+// its binding, scope, creation time and resolution path, so that Describe
+// can trace an object back to where it came from. Only the most recent
+// instances are remembered.
+//
+// Example:
+//
+//	container := nasc.New(nasc.WithInstanceTracking())
+func WithInstanceTracking() Option {
+	return func(n *Nasc) error {
+		n.instances = newInstanceTable()
+		return nil
+	}
+}
+
+// stamp emits InstanceCreated and records creation metadata for instance.
+// Recording is a no-op unless instance tracking is enabled.
+func (n *Nasc) stamp(instance interface{}, binding *registry.Binding, scope *Scope, path []string) {
+	n.emit(Event{Kind: InstanceCreated, Type: binding.AbstractType, Name: binding.Name, Instance: instance, Scope: scope})
+	if n.instances == nil {
+		return
+	}
+	addr, ok := instanceAddr(instance)
+	if !ok {
+		return
+	}
+	if len(path) == 0 {
+		path = []string{binding.AbstractType.String()}
+	}
+
+	n.instances.put(addr, InstanceInfo{
+		Binding: describeBinding(binding),
+		Scope:   scope,
+		Created: time.Now(),
+		Path:    append([]string(nil), path...),
+	})
+}
+
+// Describe reports how instance was created: the binding it came from,
+// the scope that created it, when, and along which resolution path.
+// Returns false if the container was created without WithInstanceTracking,
+// the instance was not created by the container, or it is not a pointer
+// to a non-empty value.
+//
+// Make only knows the type being resolved, so the full path is recorded
+// for instances created through MakeSafe and its variants.
+//
+// Example:
+//
+//	if info, ok := container.Describe(obj); ok {
+//	    log.Printf("mystery object: %s", info)
+//	}
+func (n *Nasc) Describe(instance interface{}) (InstanceInfo, bool) {
+	if n.instances == nil {
+		return InstanceInfo{}, false
+	}
+	addr, ok := instanceAddr(instance)
+	if !ok {
+		return InstanceInfo{}, false
+	}
+	return n.instances.get(addr)
+}
@@ -0,0 +1,83 @@
+package nasc
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDescribe_RecordsBindingAndPath(t *testing.T) {
+	container := New(WithInstanceTracking())
+	_ = container.Singleton((*Logger)(nil), &ConsoleLogger{})
+	_ = container.BindConstructor((*ConstructorService)(nil), NewServiceWithLogger)
+
+	svc, err := container.MakeSafe((*ConstructorService)(nil))
+	if err != nil {
+		t.Fatalf("MakeSafe failed: %v", err)
+	}
+
+	info, ok := container.Describe(svc)
+	if !ok {
+		t.Fatal("Describe should know the service")
+	}
+	if info.Binding.Lifetime != LifetimeTransient || info.Scope != nil || info.Created.IsZero() {
+		t.Errorf("unexpected info %+v", info)
+	}
+
+	logger := svc.(*ConstructorServiceImpl).Logger
+	info, ok = container.Describe(logger)
+	if !ok {
+		t.Fatal("Describe should know the dependency")
+	}
+	if info.Binding.Lifetime != LifetimeSingleton {
+		t.Errorf("Lifetime = %s, want singleton", info.Binding.Lifetime)
+	}
+	want := "nasc.ConstructorService -> nasc.Logger"
+	if got := strings.Join(info.Path, " -> "); got != want {
+		t.Errorf("Path = %q, want %q", got, want)
+	}
+	if !strings.Contains(info.String(), want) {
+		t.Errorf("String() = %q, should contain the path", info.String())
+	}
+}
+
+func TestDescribe_Scope(t *testing.T) {
+	container := New(WithInstanceTracking())
+	_ = container.Scoped((*Database)(nil), &MockDB{})
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+
+	info, ok := container.Describe(scope.Make((*Database)(nil)))
+	if !ok || info.Scope != scope {
+		t.Errorf("scoped instance should be stamped with its scope, got %+v", info)
+	}
+}
+
+func TestDescribe_Unknown(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+	if _, ok := container.Describe(container.Make((*Logger)(nil))); ok {
+		t.Error("Describe should report false without instance tracking")
+	}
+
+	container = New(WithInstanceTracking())
+	if _, ok := container.Describe(&ConsoleLogger{}); ok {
+		t.Error("Describe should report false for foreign instances")
+	}
+	if _, ok := container.Describe(nil); ok {
+		t.Error("Describe should report false for nil")
+	}
+}
+
+func TestInstanceTable_Evicts(t *testing.T) {
+	table := newInstanceTable()
+	for i := 0; i < instanceTableSize+1; i++ {
+		table.put(uintptr(i+1), InstanceInfo{})
+	}
+	if _, ok := table.get(1); ok {
+		t.Error("oldest entry should be evicted")
+	}
+	if _, ok := table.get(instanceTableSize + 1); !ok {
+		t.Error("newest entry should be kept")
+	}
+}
@@ -0,0 +1,213 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Diff describes how the bindings of two containers differ.
+type Diff struct {
+	// Added lists bindings only present in the new container
+	Added []BindingInfo
+
+	// Removed lists bindings only present in the old container
+	Removed []BindingInfo
+
+	// Changed lists bindings present in both whose details differ
+	Changed []BindingChange
+}
+
+// BindingChange describes one binding that differs between containers.
+type BindingChange struct {
+	Old BindingInfo
+	New BindingInfo
+
+	// Fields names what changed: "name", "lifetime", "concrete", "tags", "origin"
+	Fields []string
+}
+
+// DiffContainers compares the bindings of two containers, e.g. the wiring
+// of two releases. Bindings are matched by type and name; a binding whose
+// name changed but whose type and implementation did not is reported as a
+// change rather than a removal plus an addition.
+//
+// Example:
+//
+//	diff := nasc.DiffContainers(previous, current)
+//	if !diff.Empty() {
+//	    fmt.Printf("Wiring changes in this deploy:\n%s", diff)
+//	}
+func DiffContainers(old, new *Nasc) Diff {
+	oldInfos := make(map[string]BindingInfo)
+	var oldKeys []string
+	for _, info := range old.Bindings() {
+		key := diffKey(info)
+		oldInfos[key] = info
+		oldKeys = append(oldKeys, key)
+	}
+
+	var diff Diff
+	seen := make(map[string]bool)
+	for _, info := range new.Bindings() {
+		key := diffKey(info)
+		seen[key] = true
+
+		previous, ok := oldInfos[key]
+		if !ok {
+			diff.Added = append(diff.Added, info)
+			continue
+		}
+		if fields := changedFields(previous, info); len(fields) > 0 {
+			diff.Changed = append(diff.Changed, BindingChange{Old: previous, New: info, Fields: fields})
+		}
+	}
+	for _, key := range oldKeys {
+		if !seen[key] {
+			diff.Removed = append(diff.Removed, oldInfos[key])
+		}
+	}
+
+	diff.pairRenames()
+	return diff
+}
+
+// Empty reports whether the containers have identical bindings.
+func (d Diff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// String renders the diff one binding per line, prefixed with
+// "+" (added), "-" (removed) or "~" (changed).
+func (d Diff) String() string {
+	var b strings.Builder
+	for _, info := range d.Added {
+		fmt.Fprintf(&b, "+ %s\n", describeForDiff(info))
+	}
+	for _, info := range d.Removed {
+		fmt.Fprintf(&b, "- %s\n", describeForDiff(info))
+	}
+	for _, change := range d.Changed {
+		details := make([]string, len(change.Fields))
+		for i, field := range change.Fields {
+			details[i] = fmt.Sprintf("%s %s -> %s", field, diffField(change.Old, field), diffField(change.New, field))
+		}
+		fmt.Fprintf(&b, "~ %s: %s\n", diffLabel(change.New), strings.Join(details, ", "))
+	}
+	return b.String()
+}
+
+// pairRenames turns a removed and an added binding with the same type and
+// implementation but different names into a single change.
+func (d *Diff) pairRenames() {
+	var removed []BindingInfo
+	for _, old := range d.Removed {
+		paired := false
+		for i, added := range d.Added {
+			if added.Type == old.Type && added.Concrete != nil && added.Concrete == old.Concrete {
+				d.Changed = append(d.Changed, BindingChange{Old: old, New: added, Fields: changedFields(old, added)})
+				d.Added = append(d.Added[:i], d.Added[i+1:]...)
+				paired = true
+				break
+			}
+		}
+		if !paired {
+			removed = append(removed, old)
+		}
+	}
+	d.Removed = removed
+}
+
+// diffKey identifies a binding across containers. Tagged bindings get
+// generated names that differ between containers, so they are keyed by
+// implementation and tags instead.
+func diffKey(info BindingInfo) string {
+	if isTagBindingName(info.Name) {
+		return fmt.Sprintf("%v#tags:%v:%s", info.Type, info.Concrete, strings.Join(info.Tags, ","))
+	}
+	return fmt.Sprintf("%v#%s", info.Type, info.Name)
+}
+
+// changedFields lists the fields that differ between two bindings.
+func changedFields(old, new BindingInfo) []string {
+	var fields []string
+	if old.Name != new.Name && diffKey(old) != diffKey(new) {
+		fields = append(fields, "name")
+	}
+	if old.Lifetime != new.Lifetime {
+		fields = append(fields, "lifetime")
+	}
+	if old.Concrete != new.Concrete {
+		fields = append(fields, "concrete")
+	}
+	if !reflect.DeepEqual(old.Tags, new.Tags) {
+		fields = append(fields, "tags")
+	}
+	if !reflect.DeepEqual(old.Meta, new.Meta) {
+		fields = append(fields, "meta")
+	}
+	if old.Origin != new.Origin {
+		fields = append(fields, "origin")
+	}
+	return fields
+}
+
+// diffField renders one field of a binding for Diff.String.
+func diffField(info BindingInfo, field string) string {
+	var value string
+	switch field {
+	case "name":
+		value = info.Name
+	case "lifetime":
+		value = string(info.Lifetime)
+	case "concrete":
+		if info.Concrete != nil {
+			value = info.Concrete.String()
+		}
+	case "tags":
+		value = strings.Join(info.Tags, ",")
+	case "meta":
+		value = formatMeta(info.Meta)
+	case "origin":
+		value = info.Origin
+	}
+	if value == "" {
+		return `""`
+	}
+	return value
+}
+
+// diffLabel renders a binding's type and name.
+func diffLabel(info BindingInfo) string {
+	if info.Name == "" || isTagBindingName(info.Name) {
+		return info.Type.String()
+	}
+	return fmt.Sprintf("%v[%s]", info.Type, info.Name)
+}
+
+// describeForDiff renders a binding for added/removed lines.
+func describeForDiff(info BindingInfo) string {
+	s := fmt.Sprintf("%s (%s)", diffLabel(info), info.Lifetime)
+	if info.Concrete != nil {
+		s += " -> " + info.Concrete.String()
+	}
+	if len(info.Tags) > 0 {
+		s += " tags=" + strings.Join(info.Tags, ",")
+	}
+	if len(info.Meta) > 0 {
+		s += " meta=" + formatMeta(info.Meta)
+	}
+	return s
+}
+
+// formatMeta renders metadata as comma-separated key=value pairs, sorted
+// by key.
+func formatMeta(meta Metadata) string {
+	pairs := make([]string, 0, len(meta))
+	for key, value := range meta {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
@@ -0,0 +1,58 @@
+package nasc
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiffContainers(t *testing.T) {
+	old := New()
+	_ = old.Bind((*Logger)(nil), &ConsoleLogger{})
+	_ = old.Bind((*Database)(nil), &MockDB{})
+	_ = old.BindNamed((*NotificationService)(nil), &EmailNotifier{}, "mail")
+	_ = old.BindWithTags((*Logger)(nil), &FileLogger{}, []string{"audit"})
+
+	updated := New()
+	_ = updated.Singleton((*Logger)(nil), &ConsoleLogger{})
+	_ = updated.BindNamed((*NotificationService)(nil), &EmailNotifier{}, "email")
+	_ = updated.Bind((*ConstructorService)(nil), &BasicConstructorService{})
+	_ = updated.BindWithTags((*Logger)(nil), &FileLogger{}, []string{"audit"})
+
+	diff := DiffContainers(old, updated)
+
+	if len(diff.Added) != 1 || diff.Added[0].Type != typeOfToken((*ConstructorService)(nil)) {
+		t.Errorf("Added = %+v", diff.Added)
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0].Type != typeOfToken((*Database)(nil)) {
+		t.Errorf("Removed = %+v", diff.Removed)
+	}
+	if len(diff.Changed) != 2 {
+		t.Fatalf("expected lifetime change and rename, got %+v", diff.Changed)
+	}
+
+	out := diff.String()
+	for _, want := range []string{
+		"+ nasc.ConstructorService (transient) -> *nasc.BasicConstructorService",
+		"- nasc.Database (transient) -> *nasc.MockDB",
+		"~ nasc.Logger: lifetime transient -> singleton",
+		"~ nasc.NotificationService[email]: name mail -> email",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("diff output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestDiffContainers_Identical(t *testing.T) {
+	build := func() *Nasc {
+		c := New()
+		_ = c.Bind((*Logger)(nil), &ConsoleLogger{})
+		_ = c.BindWithTags((*Logger)(nil), &FileLogger{}, []string{"audit"})
+		return c
+	}
+
+	diff := DiffContainers(build(), build())
+	if !diff.Empty() {
+		t.Errorf("expected empty diff, got:\n%s", diff)
+	}
+}
@@ -0,0 +1,111 @@
+package nasc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// DisposableWithContext represents a service whose cleanup can be slow,
+// such as draining connections, and should respect a deadline. Dispose
+// passes context.Background(); DisposeContext passes its context.
+//
+// Example:
+//
+//	func (p *Pool) Dispose(ctx context.Context) error {
+//	    return p.server.Shutdown(ctx)
+//	}
+type DisposableWithContext interface {
+	Dispose(ctx context.Context) error
+}
+
+// DisposeContext releases the scope's resources like Dispose, but bounded
+// by ctx: child scopes are disposed concurrently, then OnDispose hooks
+// run, then every instance is disposed concurrently. Instances implementing
+// DisposableWithContext receive ctx.
+//
+// If ctx is done before all cleanups finish, DisposeContext returns
+// without waiting for the rest, and the context error is included in the
+// result. The scope is disposed either way. Errors are combined with
+// errors.Join.
+//
+// Because instances are disposed concurrently, their cleanups must not
+// depend on one another; use Dispose when teardown order matters.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	if err := scope.DisposeContext(ctx); err != nil {
+//	    log.Printf("scope cleanup: %v", err)
+//	}
+func (s *Scope) DisposeContext(ctx context.Context) error {
+	s.mu.Lock()
+	children := s.children
+	s.children = nil
+	s.mu.Unlock()
+
+	tasks := make([]func() error, len(children))
+	for i, child := range children {
+		child := child
+		tasks[i] = func() error {
+			if err := child.DisposeContext(ctx); err != nil {
+				return fmt.Errorf("child scope disposal error: %w", err)
+			}
+			return nil
+		}
+	}
+	errs := disposeConcurrently(ctx, tasks)
+
+	s.runDisposeHooks()
+
+	s.mu.Lock()
+	if s.disposed {
+		s.mu.Unlock()
+		return errors.Join(errs...)
+	}
+	resolver := newTeardownResolver(s.parent, s)
+	instances := s.creationOrder
+	s.markDisposed()
+	s.mu.Unlock()
+
+	tasks = make([]func() error, len(instances))
+	for i, instance := range instances {
+		instance := instance
+		tasks[i] = func() error {
+			if err := disposeInstance(ctx, instance, resolver); err != nil {
+				return fmt.Errorf("disposal error for %T: %w", instance, err)
+			}
+			return nil
+		}
+	}
+	errs = append(errs, disposeConcurrently(ctx, tasks)...)
+
+	return errors.Join(errs...)
+}
+
+// disposeConcurrently runs the cleanup tasks in parallel and collects
+// their errors, sorted, until they finish or ctx is done.
+func disposeConcurrently(ctx context.Context, tasks []func() error) []error {
+	results := make(chan error, len(tasks))
+	for _, task := range tasks {
+		go func(task func() error) {
+			results <- task()
+		}(task)
+	}
+
+	var errs []error
+	for pending := len(tasks); pending > 0; pending-- {
+		select {
+		case err := <-results:
+			if err != nil {
+				errs = append(errs, err)
+			}
+		case <-ctx.Done():
+			sortErrors(errs)
+			return append(errs, fmt.Errorf("%d cleanup(s) still running: %w", pending, ctx.Err()))
+		}
+	}
+	sortErrors(errs)
+	return errs
+}
@@ -0,0 +1,109 @@
+package nasc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type DrainingPool interface {
+	Drained() bool
+}
+
+type drainingPool struct {
+	delay   time.Duration
+	drained bool
+}
+
+func (p *drainingPool) Drained() bool { return p.drained }
+
+func (p *drainingPool) Dispose(ctx context.Context) error {
+	select {
+	case <-time.After(p.delay):
+		p.drained = true
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+type failingCloser struct{ id int }
+
+func (f *failingCloser) Log(string) {}
+func (f *failingCloser) Dispose() error {
+	return errors.New("close failed")
+}
+
+func TestScope_DisposeContext(t *testing.T) {
+	container := New()
+	_ = container.ScopedConstructor((*DrainingPool)(nil), func() *drainingPool {
+		return &drainingPool{delay: 10 * time.Millisecond}
+	})
+	_ = container.Scoped((*Logger)(nil), &failingCloser{})
+
+	scope := container.CreateScope()
+	child := scope.CreateChildScope()
+	pool := scope.Make((*DrainingPool)(nil)).(*drainingPool)
+	childPool := child.Make((*DrainingPool)(nil)).(*drainingPool)
+	scope.Make((*Logger)(nil))
+
+	hookRan := false
+	scope.OnDispose(func() { hookRan = true })
+
+	err := scope.DisposeContext(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "close failed") {
+		t.Errorf("expected joined disposal error, got %v", err)
+	}
+	if !pool.drained || !childPool.drained {
+		t.Error("context-aware disposers should run to completion")
+	}
+	if !hookRan {
+		t.Error("OnDispose hooks should run")
+	}
+	if err := scope.DisposeContext(context.Background()); err != nil {
+		t.Errorf("second DisposeContext should be a no-op, got %v", err)
+	}
+}
+
+func TestScope_DisposeContext_Deadline(t *testing.T) {
+	container := New()
+	_ = container.ScopedConstructor((*DrainingPool)(nil), func() *drainingPool {
+		return &drainingPool{delay: time.Hour}
+	})
+
+	scope := container.CreateScope()
+	scope.Make((*DrainingPool)(nil))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := scope.DisposeContext(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline error, got %v", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Error("DisposeContext should return at the deadline")
+	}
+	if container.OpenScopes() != 0 {
+		t.Error("scope should be disposed even when cleanup times out")
+	}
+}
+
+func TestScope_Dispose_ContextAwareInstances(t *testing.T) {
+	container := New()
+	_ = container.ScopedConstructor((*DrainingPool)(nil), func() *drainingPool {
+		return &drainingPool{}
+	})
+
+	scope := container.CreateScope()
+	pool := scope.Make((*DrainingPool)(nil)).(*drainingPool)
+	if err := scope.Dispose(); err != nil {
+		t.Fatalf("Dispose failed: %v", err)
+	}
+	if !pool.drained {
+		t.Error("Dispose should call Dispose(ctx) on context-aware instances")
+	}
+}
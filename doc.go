@@ -42,14 +42,14 @@
 //
 // # Auto-Wiring
 //
-// Automatically resolve constructor dependencies:
+// Automatically resolve tagged struct fields:
 //
 //	type UserService struct {
-//	    DB     Database
-//	    Logger Logger
+//	    DB     Database `inject:""`
+//	    Logger Logger   `inject:""`
 //	}
 //
-//	container.AutoWire((*UserService)(nil), nasc.LifetimeSingleton)
+//	container.BindAutoWire((*UserService)(nil), &UserService{}, nasc.WithLifetime(nasc.LifetimeSingleton))
 //	service := container.Make((*UserService)(nil)).(*UserService)
 //
 // # Named Bindings
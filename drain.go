@@ -0,0 +1,110 @@
+package nasc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// trackScope records an open scope for DisposeAllScopes.
+func (n *Nasc) trackScope(scope *Scope) {
+	n.scopesMu.Lock()
+	defer n.scopesMu.Unlock()
+
+	if n.openScopes == nil {
+		n.openScopes = make(map[*Scope]struct{})
+	}
+	n.scopeSeq++
+	scope.seq = n.scopeSeq
+	n.openScopes[scope] = struct{}{}
+}
+
+// untrackScope forgets a disposed scope.
+func (n *Nasc) untrackScope(scope *Scope) {
+	n.scopesMu.Lock()
+	defer n.scopesMu.Unlock()
+
+	delete(n.openScopes, scope)
+}
+
+// OpenScopes returns the number of scopes created by CreateScope that have
+// not been disposed yet.
+func (n *Nasc) OpenScopes() int {
+	n.scopesMu.Lock()
+	defer n.scopesMu.Unlock()
+
+	return len(n.openScopes)
+}
+
+// DisposeAllScopes disposes every open scope created by CreateScope, for
+// example when a server shuts down with per-connection scopes still open.
+//
+// Each scope's in-flight work (see Scope.Track) is awaited until ctx is
+// done; after that the remaining scopes are disposed immediately and the
+// context error is included in the result. Scopes are disposed
+// concurrently, so one slow scope does not hold up the others.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+//	defer cancel()
+//	if err := container.DisposeAllScopes(ctx); err != nil {
+//	    log.Printf("scope draining: %v", err)
+//	}
+func (n *Nasc) DisposeAllScopes(ctx context.Context) error {
+	n.scopesMu.Lock()
+	scopes := make([]*Scope, 0, len(n.openScopes))
+	for scope := range n.openScopes {
+		scopes = append(scopes, scope)
+	}
+	n.scopesMu.Unlock()
+
+	end := n.trace(TimelineScopeDispose, fmt.Sprintf("%d scope(s)", len(scopes)))
+	err := disposeScopes(ctx, scopes)
+	end(err)
+	return err
+}
+
+// disposeScopes drains scopes concurrently, bounded by ctx.
+func disposeScopes(ctx context.Context, scopes []*Scope) error {
+	errs := make(chan error, len(scopes))
+	for _, scope := range scopes {
+		go func(scope *Scope) {
+			errs <- scope.drain(ctx)
+		}(scope)
+	}
+
+	var all []error
+	forced := 0
+	for range scopes {
+		err := <-errs
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, ctx.Err()) && ctx.Err() != nil {
+			forced++
+		}
+		all = append(all, err)
+	}
+
+	if len(all) == 0 {
+		return nil
+	}
+	sortErrors(all)
+	if forced > 0 {
+		return fmt.Errorf("forced disposal of %d scope(s): %w", forced, errors.Join(all...))
+	}
+	return errors.Join(all...)
+}
+
+// drain waits for the scope's tracked work until ctx is done, then disposes it.
+func (s *Scope) drain(ctx context.Context) error {
+	var waitErr error
+	select {
+	case <-s.idleChan():
+	case <-ctx.Done():
+		waitErr = ctx.Err()
+	}
+
+	return errors.Join(waitErr, s.Dispose())
+}
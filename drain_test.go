@@ -0,0 +1,75 @@
+package nasc
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDisposeAllScopes_DisposesOpenScopes(t *testing.T) {
+	container := New()
+	_ = container.Scoped((*disposableService)(nil), &disposableService{})
+
+	var services []*disposableService
+	for i := 0; i < 3; i++ {
+		scope := container.CreateScope()
+		services = append(services, scope.Make((*disposableService)(nil)).(*disposableService))
+	}
+	closed := container.CreateScope()
+	_ = closed.Dispose()
+
+	if container.OpenScopes() != 3 {
+		t.Fatalf("OpenScopes() = %d, want 3", container.OpenScopes())
+	}
+	if err := container.DisposeAllScopes(context.Background()); err != nil {
+		t.Fatalf("DisposeAllScopes failed: %v", err)
+	}
+	for i, svc := range services {
+		if !svc.disposed {
+			t.Errorf("service %d was not disposed", i)
+		}
+	}
+	if container.OpenScopes() != 0 {
+		t.Errorf("OpenScopes() = %d after draining, want 0", container.OpenScopes())
+	}
+}
+
+func TestDisposeAllScopes_WaitsForInFlightWork(t *testing.T) {
+	container := New()
+	scope := container.CreateScope()
+
+	var finished atomic.Bool
+	done := scope.Track()
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		finished.Store(true)
+		done()
+	}()
+
+	if err := container.DisposeAllScopes(context.Background()); err != nil {
+		t.Fatalf("DisposeAllScopes failed: %v", err)
+	}
+	if !finished.Load() {
+		t.Error("scope was disposed before in-flight work finished")
+	}
+}
+
+func TestDisposeAllScopes_ForcesAfterDeadline(t *testing.T) {
+	container := New()
+	scope := container.CreateScope()
+	done := scope.Track()
+	defer done()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := container.DisposeAllScopes(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline error, got %v", err)
+	}
+	if container.OpenScopes() != 0 {
+		t.Error("scope should be force-disposed after the deadline")
+	}
+}
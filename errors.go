@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 )
 
 // BindingNotFoundError is returned when a requested binding does not exist.
@@ -106,3 +107,109 @@ func (e *ValidationError) Error() string {
 func (e *ValidationError) Unwrap() []error {
 	return e.Errors
 }
+
+// IncompatiblePluginError is returned when a plugin's manifest does not
+// match the host container.
+type IncompatiblePluginError struct {
+	Plugin  string
+	Reasons []string
+}
+
+func (e *IncompatiblePluginError) Error() string {
+	if len(e.Reasons) == 1 {
+		return fmt.Sprintf("plugin %s is incompatible: %s", e.Plugin, e.Reasons[0])
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("plugin %s is incompatible (%d problems):\n", e.Plugin, len(e.Reasons)))
+	for i, reason := range e.Reasons {
+		b.WriteString(fmt.Sprintf("  %d. %s\n", i+1, reason))
+	}
+	return b.String()
+}
+
+// LifecycleError reports a failure in a background lifecycle step, delivered
+// through Errors and WithOnError.
+type LifecycleError struct {
+	// Phase names the step that failed, e.g. "start" or "warmup"
+	Phase string
+
+	// Type is the service involved, if any
+	Type reflect.Type
+
+	Cause error
+}
+
+func (e *LifecycleError) Error() string {
+	if e.Type != nil {
+		return fmt.Sprintf("%s failed for %v: %v", e.Phase, e.Type, e.Cause)
+	}
+	return fmt.Sprintf("%s failed: %v", e.Phase, e.Cause)
+}
+
+// Unwrap returns the underlying cause error.
+func (e *LifecycleError) Unwrap() error {
+	return e.Cause
+}
+
+// BudgetExceededError is raised when a scope exceeds its ScopeBudget.
+type BudgetExceededError struct {
+	// Type is the service being resolved when the budget was exceeded
+	Type reflect.Type
+
+	// Resolutions and MaxResolutions are set when the resolution limit was exceeded
+	Resolutions    int
+	MaxResolutions int
+
+	// ConstructorTime and MaxConstructorTime are set when the constructor time limit was exceeded
+	ConstructorTime    time.Duration
+	MaxConstructorTime time.Duration
+}
+
+func (e *BudgetExceededError) Error() string {
+	if e.MaxResolutions > 0 {
+		return fmt.Sprintf("scope budget exceeded resolving %v: %d resolutions, budget is %d",
+			e.Type, e.Resolutions, e.MaxResolutions)
+	}
+	return fmt.Sprintf("scope budget exceeded creating %v: took %v, budget is %v",
+		e.Type, e.ConstructorTime, e.MaxConstructorTime)
+}
+
+// SingletonMutationError reports a singleton that changed after it was
+// created (see WithImmutabilityChecks).
+type SingletonMutationError struct {
+	Type reflect.Type
+
+	// Name is the binding name of a named singleton
+	Name string
+
+	// Fields names the fields that changed; nested fields use dotted paths
+	Fields []string
+}
+
+func (e *SingletonMutationError) Error() string {
+	singleton := fmt.Sprint(e.Type)
+	if e.Name != "" {
+		singleton += fmt.Sprintf(" (%s)", e.Name)
+	}
+	return fmt.Sprintf("singleton %s was mutated after creation: %s changed", singleton, strings.Join(e.Fields, ", "))
+}
+
+// QuotaViolationError reports a registration that broke a provider's
+// ProviderQuota.
+type QuotaViolationError struct {
+	// Provider is the plugin name or the provider's type
+	Provider string
+
+	// Rule is the quota rule broken: QuotaMaxBindings, QuotaForbiddenType or QuotaOverride
+	Rule string
+
+	// Type is the abstract type being registered
+	Type reflect.Type
+
+	Detail string
+}
+
+func (e *QuotaViolationError) Error() string {
+	return fmt.Sprintf("provider %s violated quota %s registering %v: %s", e.Provider, e.Rule, e.Type, e.Detail)
+}
@@ -0,0 +1,86 @@
+package nasc
+
+import (
+	"reflect"
+	"sync"
+)
+
+// errorBufferSize is the capacity of the channel returned by Errors.
+const errorBufferSize = 64
+
+// errorSink fans background lifecycle errors out to a channel and handlers.
+type errorSink struct {
+	mu       sync.Mutex
+	ch       chan error
+	handlers []func(error)
+}
+
+// newErrorSink creates an error sink with no subscribers.
+func newErrorSink() *errorSink {
+	return &errorSink{}
+}
+
+// WithOnError registers a callback invoked synchronously for every
+// background lifecycle error, such as a failed asynchronous start.
+// Callbacks run on the goroutine that observed the error.
+//
+// Example:
+//
+//	container := nasc.New(nasc.WithOnError(func(err error) {
+//	    log.Printf("background failure: %v", err)
+//	}))
+func WithOnError(handler func(error)) Option {
+	return func(n *Nasc) error {
+		if handler != nil {
+			n.errors.mu.Lock()
+			n.errors.handlers = append(n.errors.handlers, handler)
+			n.errors.mu.Unlock()
+		}
+		return nil
+	}
+}
+
+// Errors returns a channel delivering background lifecycle errors as
+// *LifecycleError values, so applications can alert or trigger shutdown on
+// failures that happen after startup returns.
+//
+// The channel is buffered; errors are dropped rather than blocking the
+// container when it is full. Use WithOnError to observe every error.
+//
+// Example:
+//
+//	go func() {
+//	    for err := range container.Errors() {
+//	        log.Printf("background failure: %v", err)
+//	        cancel()
+//	    }
+//	}()
+func (n *Nasc) Errors() <-chan error {
+	n.errors.mu.Lock()
+	defer n.errors.mu.Unlock()
+
+	if n.errors.ch == nil {
+		n.errors.ch = make(chan error, errorBufferSize)
+	}
+	return n.errors.ch
+}
+
+// reportError delivers a background lifecycle error to Errors and WithOnError.
+func (n *Nasc) reportError(phase string, t reflect.Type, cause error) {
+	err := &LifecycleError{Phase: phase, Type: t, Cause: cause}
+
+	n.errors.mu.Lock()
+	handlers := n.errors.handlers
+	if n.errors.ch != nil {
+		select {
+		case n.errors.ch <- err:
+		default:
+			// Buffer full; drop rather than block the container
+		}
+	}
+	n.errors.mu.Unlock()
+
+	for _, handler := range handlers {
+		handler(err)
+	}
+}
@@ -0,0 +1,50 @@
+package nasc
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestErrors_DeliversLifecycleErrors(t *testing.T) {
+	var handled []error
+	container := New(WithOnError(func(err error) {
+		handled = append(handled, err)
+	}))
+	errs := container.Errors()
+
+	cause := errors.New("connection refused")
+	container.reportError("start", reflect.TypeOf((*Database)(nil)).Elem(), cause)
+
+	select {
+	case err := <-errs:
+		var lifecycle *LifecycleError
+		if !errors.As(err, &lifecycle) || lifecycle.Phase != "start" {
+			t.Errorf("unexpected error %v", err)
+		}
+		if !errors.Is(err, cause) {
+			t.Error("error should wrap its cause")
+		}
+		if err.Error() != "start failed for nasc.Database: connection refused" {
+			t.Errorf("unexpected message %q", err.Error())
+		}
+	default:
+		t.Fatal("expected an error on the channel")
+	}
+
+	if len(handled) != 1 {
+		t.Errorf("OnError handler called %d times, want 1", len(handled))
+	}
+}
+
+func TestErrors_DoesNotBlockWhenFull(t *testing.T) {
+	container := New()
+	errs := container.Errors()
+
+	for i := 0; i < errorBufferSize+10; i++ {
+		container.reportError("start", nil, errors.New("boom"))
+	}
+	if len(errs) != errorBufferSize {
+		t.Errorf("expected a full buffer of %d, got %d", errorBufferSize, len(errs))
+	}
+}
@@ -0,0 +1,155 @@
+package nasc
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// EventKind identifies a kind of container event.
+type EventKind string
+
+const (
+	// BindingRegistered is emitted after a binding is registered or
+	// replaced, including by Merge, Override and its restore function,
+	// OriginView.Replace, ReplaceModule and Eager.
+	BindingRegistered EventKind = "binding.registered"
+
+	// InstanceCreated is emitted after the container creates, initializes
+	// and decorates an instance, whatever its lifetime.
+	InstanceCreated EventKind = "instance.created"
+
+	// SingletonHit is emitted when a resolution is served by an existing
+	// singleton instead of creating one.
+	SingletonHit EventKind = "singleton.hit"
+
+	// ScopeCreated is emitted after a scope or child scope is created.
+	ScopeCreated EventKind = "scope.created"
+
+	// DisposeFailed is emitted when disposing an instance returns an error.
+	DisposeFailed EventKind = "dispose.failed"
+)
+
+// Event describes something that happened in the container.
+type Event struct {
+	// Kind is what happened
+	Kind EventKind
+
+	// Type is the abstract type of the binding or instance involved. For
+	// DisposeFailed it is the concrete type of the instance, and for
+	// ScopeCreated it is nil.
+	Type reflect.Type
+
+	// Name is the binding name for named bindings, or ""
+	Name string
+
+	// Instance is the instance created, served or disposed, if any
+	Instance interface{}
+
+	// Scope is the scope involved, or nil for the container
+	Scope *Scope
+
+	// Err is the disposal error for DisposeFailed
+	Err error
+
+	// Time is when the event was emitted
+	Time time.Time
+}
+
+// eventListener is one handler registered with Subscribe.
+type eventListener struct {
+	kind    EventKind
+	handler func(Event)
+}
+
+// eventBus holds the event listeners. The list is replaced rather than
+// modified, so emitting reads it without locking.
+type eventBus struct {
+	mu        sync.Mutex
+	listeners atomic.Pointer[[]*eventListener]
+}
+
+// Subscribe registers handler for events of kind and returns a function
+// that removes it again, for audit logging or custom tooling built on the
+// container's lifecycle.
+//
+// Handlers run synchronously on the goroutine that triggered the event,
+// possibly while the container or a scope holds a lock, so they should be
+// quick and must not resolve services or dispose scopes themselves.
+//
+// Example:
+//
+//	unsubscribe := container.Subscribe(nasc.InstanceCreated, func(e nasc.Event) {
+//	    log.Printf("created %v", e.Type)
+//	})
+//	defer unsubscribe()
+func (n *Nasc) Subscribe(kind EventKind, handler func(Event)) (unsubscribe func()) {
+	if handler == nil {
+		panic("event handler cannot be nil")
+	}
+
+	listener := &eventListener{kind: kind, handler: handler}
+	n.events.mu.Lock()
+	listeners := n.events.snapshot()
+	listeners = append(listeners, listener)
+	n.events.listeners.Store(&listeners)
+	n.events.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			n.events.mu.Lock()
+			defer n.events.mu.Unlock()
+
+			var remaining []*eventListener
+			for _, l := range n.events.snapshot() {
+				if l != listener {
+					remaining = append(remaining, l)
+				}
+			}
+			n.events.listeners.Store(&remaining)
+		})
+	}
+}
+
+// snapshot returns the registered listeners, for Clone.
+func (eb *eventBus) snapshot() []*eventListener {
+	if current := eb.listeners.Load(); current != nil {
+		return append([]*eventListener(nil), *current...)
+	}
+	return nil
+}
+
+// emitRegistered emits BindingRegistered for binding.
+func (n *Nasc) emitRegistered(binding *registry.Binding) {
+	n.emit(Event{Kind: BindingRegistered, Type: binding.AbstractType, Name: binding.Name})
+}
+
+// emit delivers e to the listeners of its kind. It is cheap when nobody
+// subscribed.
+func (n *Nasc) emit(e Event) {
+	current := n.events.listeners.Load()
+	if current == nil {
+		return
+	}
+
+	stamped := false
+	for _, l := range *current {
+		if l.kind != e.Kind {
+			continue
+		}
+		if !stamped {
+			e.Time = time.Now()
+			stamped = true
+		}
+		l.handler(e)
+	}
+}
+
+// singletonHit emits SingletonHit for an existing singleton.
+func (n *Nasc) singletonHit(key singletonKey, instance interface{}) {
+	n.emit(Event{Kind: SingletonHit, Type: key.t, Name: key.name, Instance: instance})
+}
@@ -0,0 +1,161 @@
+package nasc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSubscribe(t *testing.T) {
+	c := New()
+
+	var events []Event
+	record := func(e Event) { events = append(events, e) }
+	for _, kind := range []EventKind{BindingRegistered, InstanceCreated, SingletonHit, ScopeCreated} {
+		c.Subscribe(kind, record)
+	}
+
+	_ = c.Singleton((*Logger)(nil), &ConsoleLogger{})
+	_ = c.BindNamed((*Logger)(nil), &FileLogger{}, "file")
+	first := c.Make((*Logger)(nil))
+	c.Make((*Logger)(nil))
+	scope := c.CreateScope()
+	defer scope.Dispose()
+
+	loggerType := reflect.TypeOf((*Logger)(nil)).Elem()
+	want := []struct {
+		kind EventKind
+		name string
+	}{
+		{BindingRegistered, ""},
+		{BindingRegistered, "file"},
+		{InstanceCreated, ""},
+		{SingletonHit, ""},
+		{ScopeCreated, ""},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events, want %d: %+v", len(events), len(want), events)
+	}
+	for i, w := range want {
+		e := events[i]
+		if e.Kind != w.kind || e.Name != w.name {
+			t.Errorf("event %d = %s %q, want %s %q", i, e.Kind, e.Name, w.kind, w.name)
+		}
+		if e.Time.IsZero() {
+			t.Errorf("event %d has no time", i)
+		}
+		if w.kind != ScopeCreated && e.Type != loggerType {
+			t.Errorf("event %d type = %v, want %v", i, e.Type, loggerType)
+		}
+	}
+	if events[2].Instance != first || events[3].Instance != first {
+		t.Error("instance events should carry the singleton")
+	}
+	if events[4].Scope != scope {
+		t.Error("ScopeCreated should carry the new scope")
+	}
+}
+
+func TestSubscribe_InstanceCreatedInScope(t *testing.T) {
+	c := New()
+	_ = c.Scoped((*Logger)(nil), &ConsoleLogger{})
+
+	var scopes []*Scope
+	c.Subscribe(InstanceCreated, func(e Event) { scopes = append(scopes, e.Scope) })
+
+	scope := c.CreateScope()
+	defer scope.Dispose()
+	scope.Make((*Logger)(nil))
+	scope.Make((*Logger)(nil))
+
+	if len(scopes) != 1 || scopes[0] != scope {
+		t.Errorf("InstanceCreated scopes = %v, want one event for the scope", scopes)
+	}
+}
+
+func TestSubscribe_DisposeFailed(t *testing.T) {
+	c := New()
+	_ = c.Scoped((*failingDisposable)(nil), &failingDisposable{})
+
+	var failed []Event
+	c.Subscribe(DisposeFailed, func(e Event) { failed = append(failed, e) })
+
+	scope := c.CreateScope()
+	scope.Make((*failingDisposable)(nil))
+	if err := scope.Dispose(); err == nil {
+		t.Fatal("Dispose should fail")
+	}
+
+	if len(failed) != 1 {
+		t.Fatalf("got %d DisposeFailed events, want 1", len(failed))
+	}
+	if failed[0].Err == nil || failed[0].Scope != scope {
+		t.Errorf("DisposeFailed = %+v, want the error and scope", failed[0])
+	}
+	if failed[0].Type != reflect.TypeOf(&failingDisposable{}) {
+		t.Errorf("DisposeFailed type = %v, want *failingDisposable", failed[0].Type)
+	}
+}
+
+func TestSubscribe_Unsubscribe(t *testing.T) {
+	c := New()
+
+	count := 0
+	unsubscribe := c.Subscribe(BindingRegistered, func(Event) { count++ })
+	_ = c.Bind((*Logger)(nil), &ConsoleLogger{})
+	unsubscribe()
+	unsubscribe()
+	_ = c.Bind((*Database)(nil), &MockDB{})
+
+	if count != 1 {
+		t.Errorf("handler ran %d times, want 1", count)
+	}
+}
+
+func TestSubscribe_Clone(t *testing.T) {
+	c := New()
+	count := 0
+	c.Subscribe(BindingRegistered, func(Event) { count++ })
+
+	clone := c.Clone()
+	_ = clone.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	if count != 1 {
+		t.Errorf("clone should keep the listeners, handler ran %d times", count)
+	}
+}
+
+func TestSubscribe_NilHandler(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Subscribe should panic on a nil handler")
+		}
+	}()
+	New().Subscribe(InstanceCreated, nil)
+}
+
+func TestSubscribe_BindingReplaced(t *testing.T) {
+	c := New()
+	_ = c.RegisterModule(
+		Module("logging", Binding((*Logger)(nil), &ConsoleLogger{}, WithLifetime(LifetimeSingleton))),
+		Module("storage", Binding((*Database)(nil), &MockDB{})),
+	)
+
+	var events []string
+	c.Subscribe(BindingRegistered, func(e Event) { events = append(events, e.Type.String()+"#"+e.Name) })
+
+	_ = c.FromOrigin("logging").Replace((*Logger)(nil), &FileLogger{})
+	_ = c.Eager((*Logger)(nil))
+	restore := c.Override((*Logger)(nil), &ConsoleLogger{})
+	restore()
+
+	other := New()
+	_ = other.BindNamed((*Logger)(nil), &FileLogger{}, "file")
+	_ = c.Merge(other, ConflictError)
+
+	_ = c.ReplaceModule(Module("storage", Binding((*Database)(nil), &ReplicaDB{})))
+
+	want := []string{"nasc.Logger#", "nasc.Logger#", "nasc.Logger#", "nasc.Logger#", "nasc.Logger#file", "nasc.Database#"}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("BindingRegistered events = %q, want %q", events, want)
+	}
+}
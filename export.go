@@ -0,0 +1,347 @@
+package nasc
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// nascImportPath is the import path of this package, used by generated code.
+const nascImportPath = "github.com/toutaio/toutago-nasc-dependency-injector"
+
+// ExportGo emits a Go source file that reproduces the container's current
+// registrations as explicit Bind/Singleton/Constructor calls inside a
+// Register(c *nasc.Nasc) error function.
+//
+// This is useful when migrating config-driven or dynamically-built containers
+// to static wiring that can be reviewed. Registrations that cannot be expressed
+// as Go code (e.g. anonymous factory closures) are emitted as TODO comments.
+//
+// Example:
+//
+//	src, err := container.ExportGo("wiring")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	os.WriteFile("wiring/wiring_gen.go", src, 0o644)
+func (n *Nasc) ExportGo(pkgName string) ([]byte, error) {
+	if pkgName == "" {
+		return nil, fmt.Errorf("package name cannot be empty")
+	}
+
+	e := &goExporter{
+		imports: map[string]string{nascImportPath: "nasc"},
+		aliases: map[string]bool{"nasc": true},
+	}
+
+	var body bytes.Buffer
+	for _, binding := range n.sortedBindings() {
+		// Keep only the imports of bindings that export
+		imports, aliases := e.snapshot()
+		call, err := e.bindingCall(binding)
+		if err != nil {
+			e.imports, e.aliases = imports, aliases
+			fmt.Fprintf(&body, "\t// TODO: %v: %v\n", binding.AbstractType, err)
+			continue
+		}
+		fmt.Fprintf(&body, "\tif err := %s; err != nil {\n\t\treturn err\n\t}\n", call)
+	}
+
+	var src bytes.Buffer
+	src.WriteString("// Code generated by nasc ExportGo. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&src, "package %s\n\n", pkgName)
+
+	paths := make([]string, 0, len(e.imports))
+	for p := range e.imports {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	src.WriteString("import (\n")
+	for _, p := range paths {
+		fmt.Fprintf(&src, "\t%s %q\n", e.imports[p], p)
+	}
+	src.WriteString(")\n\n")
+
+	src.WriteString("// Register reproduces the exported container registrations.\n")
+	src.WriteString("func Register(c *nasc.Nasc) error {\n")
+	src.Write(body.Bytes())
+	src.WriteString("\treturn nil\n}\n")
+
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %w", err)
+	}
+	return formatted, nil
+}
+
+// sortedBindings returns every registered binding ordered by type string,
+// then name. For each type the default binding comes first and tagged
+// bindings last, in registration order, since their generated names vary
+// between runs.
+func (n *Nasc) sortedBindings() []*registry.Binding {
+	var bindings []*registry.Binding
+	for _, t := range n.registry.GetAllTypes() {
+		bindings = append(bindings, n.registry.GetAll(t)...)
+	}
+
+	sort.SliceStable(bindings, func(i, j int) bool {
+		bi, bj := bindings[i], bindings[j]
+		if bi.AbstractType != bj.AbstractType {
+			return registry.TypeLess(bi.AbstractType, bj.AbstractType)
+		}
+		if ri, rj := nameRank(bi.Name), nameRank(bj.Name); ri != rj {
+			return ri < rj
+		}
+		return !isTagBindingName(bi.Name) && bi.Name < bj.Name
+	})
+	return bindings
+}
+
+// nameRank orders default, named and tagged bindings of one type.
+func nameRank(name string) int {
+	switch {
+	case name == "":
+		return 0
+	case isTagBindingName(name):
+		return 2
+	default:
+		return 1
+	}
+}
+
+// goExporter tracks imports while rendering Go expressions for bindings.
+type goExporter struct {
+	imports map[string]string // import path -> alias
+	aliases map[string]bool
+}
+
+// bindingCall renders the registration call that reproduces a binding.
+func (e *goExporter) bindingCall(b *registry.Binding) (string, error) {
+	abstract := fmt.Sprintf("(*%s)(nil)", e.typeExpr(b.AbstractType))
+
+	if (b.Factory != nil || b.Constructor != nil) && (b.Name != "" || len(b.Tags) > 0) {
+		return "", fmt.Errorf("named and tagged factory bindings cannot be exported")
+	}
+
+	if b.Factory != nil {
+		fn, err := e.funcExpr(reflect.ValueOf(b.Factory))
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("c.Factory(%s, %s)", abstract, fn), nil
+	}
+
+	if b.Constructor != nil {
+		info := b.Constructor.(*constructorInfo)
+		fn, err := e.funcExpr(info.fn)
+		if err != nil {
+			return "", err
+		}
+		var opts string
+		for i := range info.paramTypes {
+			if name := info.paramName(i); name != "" {
+				opts += fmt.Sprintf(", nasc.Param(%d, nasc.Named(%q))", i, name)
+			}
+		}
+		if ttl, ok := cachedTTL(Lifetime(b.Lifetime)); ok {
+			return fmt.Sprintf("c.CachedConstructor(%s, %s, %s%s)", abstract, fn, e.durationExpr(ttl), opts), nil
+		}
+		switch Lifetime(b.Lifetime) {
+		case LifetimeSingleton:
+			return fmt.Sprintf("c.SingletonConstructor(%s, %s%s)", abstract, fn, opts), nil
+		case LifetimeScoped:
+			return fmt.Sprintf("c.ScopedConstructor(%s, %s%s)", abstract, fn, opts), nil
+		default:
+			return fmt.Sprintf("c.BindConstructor(%s, %s%s)", abstract, fn, opts), nil
+		}
+	}
+
+	if b.ConcreteType == nil || b.ConcreteType.Kind() != reflect.Ptr {
+		return "", fmt.Errorf("binding has no exportable concrete type")
+	}
+	concrete := fmt.Sprintf("&%s{}", e.typeExpr(b.ConcreteType.Elem()))
+	opts := e.bindOptionExprs(b)
+
+	// Auto-wired bindings take every option; the shorthands below cover
+	// plain bindings, and As the remaining interface bindings
+	if b.AutoWireEnabled {
+		return fmt.Sprintf("c.BindAutoWire(%s, %s%s)", abstract, concrete, joinArgs(opts)), nil
+	}
+	plain := b.Priority == 0 && len(b.Meta) == 0
+	transient := Lifetime(b.Lifetime) == LifetimeTransient
+	switch {
+	case plain && len(b.Tags) > 0 && transient:
+		tags := make([]string, len(b.Tags))
+		for i, tag := range b.Tags {
+			tags[i] = strconv.Quote(tag)
+		}
+		return fmt.Sprintf("c.BindWithTags(%s, %s, []string{%s})", abstract, concrete, strings.Join(tags, ", ")), nil
+	case plain && b.Name != "" && transient:
+		return fmt.Sprintf("c.BindNamed(%s, %s, %q)", abstract, concrete, b.Name), nil
+	case !plain || b.Name != "" || len(b.Tags) > 0:
+		if b.AbstractType.Kind() != reflect.Interface || b.ConcreteType.Elem().Kind() != reflect.Struct {
+			return "", fmt.Errorf("binding options are only exported for interface types")
+		}
+		return fmt.Sprintf("nasc.As[%s, %s](c%s)", e.typeExpr(b.AbstractType), e.typeExpr(b.ConcreteType.Elem()), joinArgs(opts)), nil
+	}
+
+	if ttl, ok := cachedTTL(Lifetime(b.Lifetime)); ok {
+		return fmt.Sprintf("c.Cached(%s, %s, %s)", abstract, concrete, e.durationExpr(ttl)), nil
+	}
+
+	switch Lifetime(b.Lifetime) {
+	case LifetimeSingleton:
+		return fmt.Sprintf("c.Singleton(%s, %s)", abstract, concrete), nil
+	case LifetimeScoped:
+		return fmt.Sprintf("c.Scoped(%s, %s)", abstract, concrete), nil
+	default:
+		return fmt.Sprintf("c.Bind(%s, %s)", abstract, concrete), nil
+	}
+}
+
+// bindOptionExprs renders the BindOption values reproducing a type
+// binding's lifetime, name, tags, priority and metadata.
+func (e *goExporter) bindOptionExprs(b *registry.Binding) []string {
+	var opts []string
+	if ttl, ok := cachedTTL(Lifetime(b.Lifetime)); ok {
+		opts = append(opts, fmt.Sprintf("nasc.WithLifetime(nasc.LifetimeCached(%s))", e.durationExpr(ttl)))
+	} else {
+		switch Lifetime(b.Lifetime) {
+		case LifetimeSingleton:
+			opts = append(opts, "nasc.WithLifetime(nasc.LifetimeSingleton)")
+		case LifetimeScoped:
+			opts = append(opts, "nasc.WithLifetime(nasc.LifetimeScoped)")
+		}
+	}
+
+	if len(b.Tags) > 0 {
+		tags := make([]string, len(b.Tags))
+		for i, tag := range b.Tags {
+			tags[i] = strconv.Quote(tag)
+		}
+		opts = append(opts, fmt.Sprintf("nasc.WithTags(%s)", strings.Join(tags, ", ")))
+	} else if b.Name != "" {
+		opts = append(opts, fmt.Sprintf("nasc.WithName(%q)", b.Name))
+	}
+	if b.Priority != 0 {
+		opts = append(opts, fmt.Sprintf("nasc.WithPriority(%d)", b.Priority))
+	}
+
+	keys := make([]string, 0, len(b.Meta))
+	for key := range b.Meta {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		opts = append(opts, fmt.Sprintf("nasc.WithMeta(%q, %q)", key, b.Meta[key]))
+	}
+	return opts
+}
+
+// joinArgs renders trailing call arguments, each preceded by a comma.
+func joinArgs(args []string) string {
+	var s string
+	for _, arg := range args {
+		s += ", " + arg
+	}
+	return s
+}
+
+// funcExpr renders a reference to a top-level function.
+// Closures and method values have no stable name and cannot be exported.
+func (e *goExporter) funcExpr(fn reflect.Value) (string, error) {
+	f := runtime.FuncForPC(fn.Pointer())
+	if f == nil {
+		return "", fmt.Errorf("function name is not available")
+	}
+
+	// Names look like "github.com/acme/pkg.NewService"
+	full := f.Name()
+	slash := strings.LastIndex(full, "/")
+	dot := strings.Index(full[slash+1:], ".")
+	if dot < 0 {
+		return "", fmt.Errorf("cannot parse function name %q", full)
+	}
+	pkgPath := full[:slash+1+dot]
+	name := full[slash+1+dot+1:]
+	if strings.ContainsAny(name, ".-") {
+		return "", fmt.Errorf("function %s is anonymous and cannot be exported", full)
+	}
+
+	return e.qualifier(pkgPath) + "." + name, nil
+}
+
+// typeExpr renders a Go type expression, registering imports as needed.
+func (e *goExporter) typeExpr(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return "*" + e.typeExpr(t.Elem())
+	case reflect.Slice:
+		if t.Name() == "" {
+			return "[]" + e.typeExpr(t.Elem())
+		}
+	case reflect.Map:
+		if t.Name() == "" {
+			return fmt.Sprintf("map[%s]%s", e.typeExpr(t.Key()), e.typeExpr(t.Elem()))
+		}
+	}
+
+	if t.Name() == "" {
+		return t.String()
+	}
+	if t.PkgPath() == "" {
+		return t.Name()
+	}
+	return e.qualifier(t.PkgPath()) + "." + t.Name()
+}
+
+// durationExpr renders a time.Duration literal, registering the import.
+func (e *goExporter) durationExpr(d time.Duration) string {
+	return fmt.Sprintf("%s.Duration(%d)", e.qualifier("time"), int64(d))
+}
+
+// snapshot copies the imports collected so far, so they can be restored
+// when a binding fails to export.
+func (e *goExporter) snapshot() (imports map[string]string, aliases map[string]bool) {
+	imports = make(map[string]string, len(e.imports))
+	for p, alias := range e.imports {
+		imports[p] = alias
+	}
+	aliases = make(map[string]bool, len(e.aliases))
+	for alias := range e.aliases {
+		aliases[alias] = true
+	}
+	return imports, aliases
+}
+
+// qualifier returns the import alias for a package path, adding the import if needed.
+func (e *goExporter) qualifier(pkgPath string) string {
+	if alias, ok := e.imports[pkgPath]; ok {
+		return alias
+	}
+
+	base := strings.Map(func(r rune) rune {
+		if r == '-' || r == '.' {
+			return '_'
+		}
+		return r
+	}, path.Base(pkgPath))
+
+	alias := base
+	for i := 2; e.aliases[alias]; i++ {
+		alias = fmt.Sprintf("%s%d", base, i)
+	}
+
+	e.imports[pkgPath] = alias
+	e.aliases[alias] = true
+	return alias
+}
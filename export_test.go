@@ -0,0 +1,153 @@
+package nasc
+
+import (
+	"bytes"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"hash"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestExportGo_Bindings(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+	_ = container.Singleton((*Database)(nil), &MockDB{})
+	_ = container.BindConstructor((*ConstructorService)(nil), NewServiceWithDeps)
+	_ = container.BindNamed((*NotificationService)(nil), &EmailNotifier{}, "email")
+
+	src, err := container.ExportGo("wiring")
+	if err != nil {
+		t.Fatalf("ExportGo failed: %v", err)
+	}
+	out := string(src)
+
+	expected := []string{
+		"package wiring",
+		`nasc "github.com/toutaio/toutago-nasc-dependency-injector"`,
+		"func Register(c *nasc.Nasc) error {",
+		"c.Bind((*nasc.Logger)(nil), &nasc.ConsoleLogger{})",
+		"c.Singleton((*nasc.Database)(nil), &nasc.MockDB{})",
+		"c.BindConstructor((*nasc.ConstructorService)(nil), nasc.NewServiceWithDeps)",
+		`c.BindNamed((*nasc.NotificationService)(nil), &nasc.EmailNotifier{}, "email")`,
+	}
+	for _, want := range expected {
+		if !strings.Contains(out, want) {
+			t.Errorf("generated code missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestExportGo_AnonymousFactory(t *testing.T) {
+	container := New()
+	_ = container.Factory((*Logger)(nil), func(c *Nasc) (interface{}, error) {
+		return &ConsoleLogger{}, nil
+	})
+
+	src, err := container.ExportGo("wiring")
+	if err != nil {
+		t.Fatalf("ExportGo failed: %v", err)
+	}
+	if !strings.Contains(string(src), "// TODO: nasc.Logger") {
+		t.Errorf("expected TODO for anonymous factory:\n%s", src)
+	}
+}
+
+func TestExportGo_Options(t *testing.T) {
+	container := New()
+	_ = container.BindAutoWire((*Logger)(nil), &ConsoleLogger{}, WithName("audit"), WithLifetime(LifetimeSingleton))
+	_ = As[Database, MockDB](container, WithTags("primary"), WithLifetime(LifetimeScoped), WithPriority(2))
+	_ = container.SingletonConstructor((*ConstructorService)(nil), NewServiceWithDeps)
+
+	src, err := container.ExportGo("wiring")
+	if err != nil {
+		t.Fatalf("ExportGo failed: %v", err)
+	}
+	for _, want := range []string{
+		`c.BindAutoWire((*nasc.Logger)(nil), &nasc.ConsoleLogger{}, nasc.WithLifetime(nasc.LifetimeSingleton), nasc.WithName("audit"))`,
+		`nasc.As[nasc.Database, nasc.MockDB](c, nasc.WithLifetime(nasc.LifetimeScoped), nasc.WithTags("primary"), nasc.WithPriority(2))`,
+		"c.SingletonConstructor((*nasc.ConstructorService)(nil), nasc.NewServiceWithDeps)",
+	} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("generated code missing %q:\n%s", want, src)
+		}
+	}
+}
+
+func TestExportGo_Compiles(t *testing.T) {
+	container := New()
+	_ = container.Bind((*io.Writer)(nil), &bytes.Buffer{})
+	_ = container.Singleton((*io.Reader)(nil), &bytes.Reader{})
+	_ = container.BindAutoWire((*io.Reader)(nil), &strings.Reader{}, WithName("auto"), WithLifetime(LifetimeSingleton))
+	_ = As[io.ReadWriter, bytes.Buffer](container, WithName("rw"), WithLifetime(LifetimeScoped))
+	_ = container.Factory((*hash.Hash)(nil), func(c *Nasc) (interface{}, error) {
+		return nil, nil
+	})
+
+	src, err := container.ExportGo("wiring")
+	if err != nil {
+		t.Fatalf("ExportGo failed: %v", err)
+	}
+	if !strings.Contains(string(src), "// TODO: hash.Hash") {
+		t.Errorf("expected TODO for the anonymous factory:\n%s", src)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "wiring_gen.go", src, 0)
+	if err != nil {
+		t.Fatalf("generated code does not parse: %v\n%s", err, src)
+	}
+	config := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	if _, err := config.Check("wiring", fset, []*ast.File{file}, nil); err != nil {
+		t.Errorf("generated code does not compile: %v\n%s", err, src)
+	}
+}
+
+func TestExportGo_Deterministic(t *testing.T) {
+	container := New()
+	_ = container.BindNamed((*Logger)(nil), &ConsoleLogger{}, "b")
+	_ = container.BindNamed((*Logger)(nil), &FileLogger{}, "a")
+	_ = container.Bind((*Database)(nil), &MockDB{})
+
+	first, _ := container.ExportGo("wiring")
+	for i := 0; i < 10; i++ {
+		again, _ := container.ExportGo("wiring")
+		if string(again) != string(first) {
+			t.Fatal("ExportGo output is not deterministic")
+		}
+	}
+
+	out := string(first)
+	if strings.Index(out, `"a"`) > strings.Index(out, `"b"`) {
+		t.Error("named bindings should be ordered by name")
+	}
+}
+
+func TestExportGo_EmptyPackage(t *testing.T) {
+	if _, err := New().ExportGo(""); err == nil {
+		t.Error("expected error for empty package name")
+	}
+}
+
+func TestBindings_TaggedInRegistrationOrder(t *testing.T) {
+	container := New()
+	_ = container.BindWithTags((*Database)(nil), &MockDB{}, []string{"second"})
+	_ = container.BindWithTags((*Database)(nil), &MockDB{}, []string{"first"})
+	_ = container.BindNamed((*Database)(nil), &MockDB{}, "named")
+	_ = container.Bind((*Database)(nil), &MockDB{})
+
+	infos := container.Bindings()
+	if len(infos) != 4 {
+		t.Fatalf("expected 4 bindings, got %d", len(infos))
+	}
+	if infos[0].Name != "" || infos[1].Name != "named" {
+		t.Errorf("default then named bindings should come first, got %q, %q", infos[0].Name, infos[1].Name)
+	}
+	if infos[2].Tags[0] != "second" || infos[3].Tags[0] != "first" {
+		t.Errorf("tagged bindings should keep registration order, got %v, %v", infos[2].Tags, infos[3].Tags)
+	}
+}
@@ -0,0 +1,108 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// Extend registers extender to run on every instance created for
+// abstractType's bindings, right after it is initialized. The extender
+// may configure the instance in place and return it, or return a
+// replacement, which must still satisfy abstractType.
+//
+// Extenders run in registration order, interleaved with Decorate's
+// decorators, and like them apply to every lifetime, are cached with the
+// instance and do not affect instances created before Extend is called.
+//
+// Example:
+//
+//	container.Extend((*Config)(nil), func(cfg interface{}, c *nasc.Nasc) interface{} {
+//	    cfg.(*AppConfig).Debug = true
+//	    return cfg
+//	})
+func (n *Nasc) Extend(abstractType interface{}, extender func(instance interface{}, c *Nasc) interface{}) error {
+	if abstractType == nil {
+		return &InvalidBindingError{Reason: "abstract type cannot be nil"}
+	}
+	if extender == nil {
+		return &InvalidBindingError{Reason: "extender cannot be nil"}
+	}
+
+	n.decorators.add(typeOfToken(abstractType), &decorator{extend: extender, origin: n.origin})
+	return nil
+}
+
+// rebindFunc is a callback registered with OnRebind.
+type rebindFunc func(instance interface{}, c *Nasc)
+
+// rebindHooks holds the OnRebind callbacks of each abstract type.
+type rebindHooks struct {
+	mu     sync.Mutex
+	byType map[reflect.Type][]rebindFunc
+}
+
+// OnRebind registers fn to run whenever the default binding of
+// abstractType is swapped at runtime by Override (and its restore),
+// Merge with ConflictReplace, OriginView.Replace or ReplaceModule. fn
+// receives an instance resolved from the new binding, so consumers that
+// already hold the old instance can switch to it.
+//
+// Callbacks run synchronously after the swap. If the new binding cannot
+// be resolved, the callbacks are skipped and the failure is reported
+// through Errors and WithOnError.
+//
+// Example:
+//
+//	container.OnRebind((*Mailer)(nil), func(instance interface{}, c *nasc.Nasc) {
+//	    notifier.SetMailer(instance.(Mailer))
+//	})
+func (n *Nasc) OnRebind(abstractType interface{}, fn func(instance interface{}, c *Nasc)) error {
+	if abstractType == nil {
+		return &InvalidBindingError{Reason: "abstract type cannot be nil"}
+	}
+	if fn == nil {
+		return &InvalidBindingError{Reason: "rebind callback cannot be nil"}
+	}
+
+	abstractT := typeOfToken(abstractType)
+	n.rebinds.mu.Lock()
+	defer n.rebinds.mu.Unlock()
+	if n.rebinds.byType == nil {
+		n.rebinds.byType = make(map[reflect.Type][]rebindFunc)
+	}
+	n.rebinds.byType[abstractT] = append(n.rebinds.byType[abstractT], fn)
+	return nil
+}
+
+// rebound runs the OnRebind callbacks of abstractT after its default
+// binding was swapped.
+func (n *Nasc) rebound(abstractT reflect.Type) {
+	n.rebinds.mu.Lock()
+	callbacks := append([]rebindFunc(nil), n.rebinds.byType[abstractT]...)
+	n.rebinds.mu.Unlock()
+	if len(callbacks) == 0 {
+		return
+	}
+
+	instance, err := n.MakeSafe(reflect.Zero(reflect.PointerTo(abstractT)).Interface())
+	if err != nil {
+		n.reportError("rebind", abstractT, fmt.Errorf("resolving the new binding: %w", err))
+		return
+	}
+	for _, fn := range callbacks {
+		fn(instance, n)
+	}
+}
+
+// snapshot copies the registered callbacks, for Clone.
+func (rh *rebindHooks) snapshot() map[reflect.Type][]rebindFunc {
+	rh.mu.Lock()
+	defer rh.mu.Unlock()
+
+	byType := make(map[reflect.Type][]rebindFunc, len(rh.byType))
+	for t, callbacks := range rh.byType {
+		byType[t] = append([]rebindFunc(nil), callbacks...)
+	}
+	return byType
+}
@@ -0,0 +1,136 @@
+package nasc
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestExtend(t *testing.T) {
+	c := New()
+	_ = c.Singleton((*appConfig)(nil), &appConfig{})
+
+	if err := c.Extend((*appConfig)(nil), func(instance interface{}, n *Nasc) interface{} {
+		if n != c {
+			t.Error("extender received the wrong container")
+		}
+		instance.(*appConfig).DSN = "postgres://localhost"
+		return instance
+	}); err != nil {
+		t.Fatalf("Extend() error = %v", err)
+	}
+
+	cfg := c.Make((*appConfig)(nil)).(*appConfig)
+	if cfg.DSN != "postgres://localhost" {
+		t.Errorf("DSN = %q, want the extended value", cfg.DSN)
+	}
+}
+
+func TestExtend_Replace(t *testing.T) {
+	c := New()
+	_ = c.Bind((*Logger)(nil), &ConsoleLogger{})
+	_ = c.Extend((*Logger)(nil), func(instance interface{}, _ *Nasc) interface{} {
+		return &prefixLogger{inner: instance.(Logger), prefix: "x:"}
+	})
+
+	if _, ok := c.Make((*Logger)(nil)).(*prefixLogger); !ok {
+		t.Error("extender should be able to replace the instance")
+	}
+}
+
+func TestExtend_InvalidReplacement(t *testing.T) {
+	c := New()
+	_ = c.Bind((*Logger)(nil), &ConsoleLogger{})
+	_ = c.Extend((*Logger)(nil), func(interface{}, *Nasc) interface{} {
+		return "not a logger"
+	})
+
+	_, err := c.MakeSafe((*Logger)(nil))
+	if err == nil || !strings.Contains(err.Error(), "extender") {
+		t.Errorf("MakeSafe() error = %v, want an extender error", err)
+	}
+}
+
+func TestExtend_Nil(t *testing.T) {
+	c := New()
+	var invalid *InvalidBindingError
+	if err := c.Extend(nil, func(i interface{}, _ *Nasc) interface{} { return i }); !errors.As(err, &invalid) {
+		t.Errorf("Extend(nil type) error = %v, want *InvalidBindingError", err)
+	}
+	if err := c.Extend((*Logger)(nil), nil); !errors.As(err, &invalid) {
+		t.Errorf("Extend(nil extender) error = %v, want *InvalidBindingError", err)
+	}
+}
+
+func TestOnRebind_Override(t *testing.T) {
+	c := New()
+	_ = c.Singleton((*Logger)(nil), &ConsoleLogger{})
+	original := c.Make((*Logger)(nil))
+
+	var seen []interface{}
+	if err := c.OnRebind((*Logger)(nil), func(instance interface{}, _ *Nasc) {
+		seen = append(seen, instance)
+	}); err != nil {
+		t.Fatalf("OnRebind() error = %v", err)
+	}
+
+	fake := &FileLogger{filename: "fake.log"}
+	restore := c.Override((*Logger)(nil), fake)
+	restore()
+
+	if len(seen) != 2 || seen[0] != fake || seen[1] != original {
+		t.Errorf("rebind callbacks saw %v, want the override then the original", seen)
+	}
+}
+
+func TestOnRebind_OriginReplace(t *testing.T) {
+	c := New()
+	_ = c.withOrigin("lib").Bind((*Logger)(nil), &ConsoleLogger{})
+
+	var seen interface{}
+	_ = c.OnRebind((*Logger)(nil), func(instance interface{}, _ *Nasc) { seen = instance })
+
+	if err := c.FromOrigin("lib").Replace((*Logger)(nil), &FileLogger{}); err != nil {
+		t.Fatalf("Replace() error = %v", err)
+	}
+	if _, ok := seen.(*FileLogger); !ok {
+		t.Errorf("rebind callback saw %T, want *FileLogger", seen)
+	}
+}
+
+func TestOnRebind_Merge(t *testing.T) {
+	c := New()
+	_ = c.Bind((*Logger)(nil), &ConsoleLogger{})
+	other := New()
+	_ = other.Bind((*Logger)(nil), &FileLogger{})
+	_ = other.Bind((*Database)(nil), &MockDB{})
+
+	calls := 0
+	_ = c.OnRebind((*Logger)(nil), func(interface{}, *Nasc) { calls++ })
+	_ = c.OnRebind((*Database)(nil), func(interface{}, *Nasc) {
+		t.Error("a newly added binding is not a rebind")
+	})
+
+	if err := c.Merge(other, ConflictReplace); err != nil {
+		t.Fatalf("Merge() error = %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("rebind callback ran %d times, want 1", calls)
+	}
+}
+
+func TestOnRebind_ResolutionFailure(t *testing.T) {
+	var reported []error
+	c := New(WithOnError(func(err error) { reported = append(reported, err) }))
+	restore := c.Override((*Database)(nil), &MockDB{})
+	_ = c.OnRebind((*Database)(nil), func(interface{}, *Nasc) {
+		t.Error("callback should not run when the new binding fails")
+	})
+
+	// Restoring an override of an unbound type leaves nothing to resolve
+	restore()
+
+	if len(reported) != 1 {
+		t.Errorf("got %d reported errors, want 1", len(reported))
+	}
+}
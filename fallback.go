@@ -0,0 +1,59 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// FallbackResolver satisfies types the container has no binding for, such
+// as services still owned by legacy globals, another DI framework, or
+// remote configuration. It lets a codebase adopt the container
+// incrementally.
+//
+// Resolve reports false when it does not provide t; name is empty for
+// default bindings. A returned error fails the resolution.
+type FallbackResolver interface {
+	Resolve(t reflect.Type, name string) (interface{}, bool, error)
+}
+
+// WithFallbackResolver consults r for types that have no binding. Several
+// resolvers may be added; they are consulted in the order given, before
+// the stub fallback of WithStubFallback.
+//
+// Example:
+//
+//	container := nasc.New(nasc.WithFallbackResolver(legacyLocator))
+func WithFallbackResolver(r FallbackResolver) Option {
+	return func(n *Nasc) error {
+		if r == nil {
+			return fmt.Errorf("fallback resolver cannot be nil")
+		}
+		n.fallbacks = append(n.fallbacks, r)
+		return nil
+	}
+}
+
+// resolveUnbound satisfies a type with no binding from the fallback
+// resolvers, then from stubs. It reports false if neither provides it.
+func (n *Nasc) resolveUnbound(t reflect.Type, name string) (interface{}, bool, error) {
+	for _, r := range n.fallbacks {
+		instance, ok, err := r.Resolve(t, name)
+		if err != nil {
+			return nil, true, fmt.Errorf("fallback resolver %T: %w", r, err)
+		}
+		if !ok {
+			continue
+		}
+		if instance != nil && !reflect.TypeOf(instance).AssignableTo(t) {
+			return nil, true, fmt.Errorf("fallback resolver %T returned %T, which is not assignable to %v", r, instance, t)
+		}
+		return instance, true, nil
+	}
+
+	if name == "" {
+		if stub, ok := n.stubFor(t); ok {
+			return stub, true, nil
+		}
+	}
+	return nil, false, nil
+}
@@ -0,0 +1,82 @@
+package nasc
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// legacyLocator serves services from a map, like a legacy service locator.
+type legacyLocator struct {
+	services map[string]interface{}
+	err      error
+}
+
+func (l *legacyLocator) Resolve(t reflect.Type, name string) (interface{}, bool, error) {
+	if l.err != nil {
+		return nil, false, l.err
+	}
+	instance, ok := l.services[t.String()+name]
+	return instance, ok, nil
+}
+
+func TestFallbackResolver_ResolvesUnboundTypes(t *testing.T) {
+	legacyDB := &MockDB{}
+	locator := &legacyLocator{services: map[string]interface{}{
+		"nasc.Database":      legacyDB,
+		"nasc.Loggerconsole": &ConsoleLogger{},
+	}}
+	container := New(WithFallbackResolver(locator))
+	_ = container.BindConstructor((*ConstructorService)(nil), NewServiceWithDeps)
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	if container.Make((*Database)(nil)) != legacyDB {
+		t.Error("Make should use the fallback resolver")
+	}
+	if _, ok := container.MakeNamed((*Logger)(nil), "console").(*ConsoleLogger); !ok {
+		t.Error("MakeNamed should pass the name to the fallback resolver")
+	}
+
+	svc, err := container.MakeSafe((*ConstructorService)(nil))
+	if err != nil {
+		t.Fatalf("MakeSafe failed: %v", err)
+	}
+	if svc.(*ConstructorServiceImpl).Database != legacyDB {
+		t.Error("constructor dependencies should use the fallback resolver")
+	}
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+	if scope.Make((*Database)(nil)) != legacyDB {
+		t.Error("Scope.Make should use the fallback resolver")
+	}
+
+	if _, err := container.MakeSafe((*NotificationService)(nil)); err == nil {
+		t.Error("types unknown to the resolver should still fail")
+	}
+}
+
+func TestFallbackResolver_Errors(t *testing.T) {
+	container := New(WithFallbackResolver(&legacyLocator{err: errors.New("locator offline")}))
+	if _, err := container.MakeSafe((*Database)(nil)); err == nil || !strings.Contains(err.Error(), "locator offline") {
+		t.Errorf("expected resolver error, got %v", err)
+	}
+
+	container = New(WithFallbackResolver(&legacyLocator{services: map[string]interface{}{
+		"nasc.Database": &ConsoleLogger{},
+	}}))
+	if _, err := container.MakeSafe((*Database)(nil)); err == nil || !strings.Contains(err.Error(), "not assignable") {
+		t.Errorf("expected type mismatch error, got %v", err)
+	}
+}
+
+func TestFallbackResolver_Order(t *testing.T) {
+	first := &legacyLocator{services: map[string]interface{}{}}
+	second := &legacyLocator{services: map[string]interface{}{"nasc.Database": &MockDB{connected: true}}}
+	container := New(WithFallbackResolver(first), WithFallbackResolver(second))
+
+	if !container.Make((*Database)(nil)).(*MockDB).connected {
+		t.Error("later resolvers should be consulted when earlier ones decline")
+	}
+}
@@ -0,0 +1,97 @@
+package nasc
+
+import (
+	"context"
+	"fmt"
+)
+
+// FuncProvider is an inline service provider built from closures, for
+// small modules that do not warrant a named provider type. Create one with
+// NewProvider and add optional boot and shutdown steps with OnBoot and
+// OnShutdown.
+//
+// Unlike other providers, which are deduplicated by type, every
+// FuncProvider is a distinct provider; registering the same one twice is
+// still a no-op.
+type FuncProvider struct {
+	name     string
+	register func(c *Nasc) error
+	boot     func(c *Nasc) error
+	shutdown func(ctx context.Context) error
+}
+
+// NewProvider returns an inline provider whose Register method calls
+// register. A non-empty name becomes the origin of the provider's
+// bindings (see FromOrigin).
+//
+// Example:
+//
+//	cache := nasc.NewProvider("cache", func(c *nasc.Nasc) error {
+//	    return c.Singleton((*Cache)(nil), &RedisCache{})
+//	}).OnBoot(func(c *nasc.Nasc) error {
+//	    return c.Make((*Cache)(nil)).(Cache).Ping()
+//	}).OnShutdown(func(ctx context.Context) error {
+//	    return flushMetrics(ctx)
+//	})
+//
+//	container.RegisterProvider(cache)
+func NewProvider(name string, register func(c *Nasc) error) *FuncProvider {
+	return &FuncProvider{name: name, register: register}
+}
+
+// OnBoot sets the function called when providers boot (see BootProviders).
+func (p *FuncProvider) OnBoot(boot func(c *Nasc) error) *FuncProvider {
+	p.boot = boot
+	return p
+}
+
+// OnShutdown sets the function called when the container shuts down (see
+// Shutdown).
+func (p *FuncProvider) OnShutdown(shutdown func(ctx context.Context) error) *FuncProvider {
+	p.shutdown = shutdown
+	return p
+}
+
+// Register implements ServiceProvider.
+func (p *FuncProvider) Register(c *Nasc) error {
+	if p.register == nil {
+		return fmt.Errorf("inline provider %q has no register function", p.name)
+	}
+	return p.register(c)
+}
+
+// Boot implements BootableProvider.
+func (p *FuncProvider) Boot(c *Nasc) error {
+	if p.boot == nil {
+		return nil
+	}
+	return p.boot(c)
+}
+
+// Stop implements Stoppable.
+func (p *FuncProvider) Stop(ctx context.Context) error {
+	if p.shutdown == nil {
+		return nil
+	}
+	return p.shutdown(ctx)
+}
+
+// Origin implements OriginProvider.
+func (p *FuncProvider) Origin() string {
+	return p.name
+}
+
+// RegisterProviderFunc registers an inline provider that calls register,
+// like RegisterProvider(NewProvider("", register)).
+//
+// Example:
+//
+//	container.RegisterProviderFunc(func(c *nasc.Nasc) error {
+//	    return c.Singleton((*Mailer)(nil), &SMTPMailer{})
+//	})
+func (n *Nasc) RegisterProviderFunc(register func(c *Nasc) error) error {
+	if register == nil {
+		return fmt.Errorf("provider function cannot be nil")
+	}
+	return n.RegisterProvider(NewProvider("", register))
+}
@@ -0,0 +1,73 @@
+package nasc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRegisterProviderFunc(t *testing.T) {
+	container := New()
+	for _, db := range []string{"primary", "replica"} {
+		name := db
+		if err := container.RegisterProviderFunc(func(c *Nasc) error {
+			return c.BindNamed((*Database)(nil), &MockDB{}, name)
+		}); err != nil {
+			t.Fatalf("RegisterProviderFunc() error = %v", err)
+		}
+	}
+
+	if len(container.GetProviders()) != 2 {
+		t.Errorf("registered %d providers, want every inline provider", len(container.GetProviders()))
+	}
+	container.MakeNamed((*Database)(nil), "primary")
+	container.MakeNamed((*Database)(nil), "replica")
+
+	if err := container.RegisterProviderFunc(nil); err == nil {
+		t.Error("a nil provider function should fail")
+	}
+}
+
+func TestNewProvider(t *testing.T) {
+	container := New()
+	var steps []string
+	provider := NewProvider("logging", func(c *Nasc) error {
+		steps = append(steps, "register")
+		return c.Singleton((*Logger)(nil), &ConsoleLogger{})
+	}).OnBoot(func(c *Nasc) error {
+		steps = append(steps, "boot")
+		return nil
+	}).OnShutdown(func(ctx context.Context) error {
+		steps = append(steps, "shutdown")
+		return errors.New("flush failed")
+	})
+
+	_ = container.RegisterProvider(provider)
+	_ = container.RegisterProvider(provider)
+	if err := container.BootProviders(); err != nil {
+		t.Fatalf("BootProviders() error = %v", err)
+	}
+	if !container.FromOrigin("logging").Has((*Logger)(nil)) {
+		t.Error("the provider name should be the origin of its bindings")
+	}
+
+	err := container.Shutdown(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "flush failed") {
+		t.Errorf("Shutdown() error = %v, want the provider's shutdown error", err)
+	}
+	if strings.Join(steps, ",") != "register,boot,shutdown" {
+		t.Errorf("steps = %v, want each step once", steps)
+	}
+}
+
+func TestNewProvider_Optional(t *testing.T) {
+	container := New()
+	_ = container.RegisterProvider(NewProvider("", func(c *Nasc) error { return nil }))
+	if err := container.BootProviders(); err != nil {
+		t.Errorf("BootProviders() error = %v, want no error without OnBoot", err)
+	}
+	if err := container.Shutdown(context.Background()); err != nil {
+		t.Errorf("Shutdown() error = %v, want no error without OnShutdown", err)
+	}
+}
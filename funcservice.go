@@ -0,0 +1,123 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// isServiceType reports whether values of t can be resolved as a service
+// dependency: interfaces, and function types bound with BindFunc.
+func isServiceType(t reflect.Type) bool {
+	return t.Kind() == reflect.Interface || t.Kind() == reflect.Func
+}
+
+// BindFunc registers a plain function as the implementation of
+// abstractType, so functional-style code can participate in DI. The
+// abstract type is either a function type, such as
+// `type Validator func(User) error`, or an interface the function
+// implements, such as http.Handler with an http.HandlerFunc.
+//
+// Every resolution returns the same function. Constructor parameters and
+// auto-wired fields of a bound function type are injected like interfaces.
+//
+// Example:
+//
+//	type Validator func(User) error
+//
+//	container.BindFunc((*Validator)(nil), func(u User) error {
+//	    return validateEmail(u.Email)
+//	})
+//	container.BindFunc((*http.Handler)(nil), http.HandlerFunc(healthz))
+func (n *Nasc) BindFunc(abstractType, fn interface{}) error {
+	binding, err := funcBinding(abstractType, fn)
+	if err != nil {
+		return err
+	}
+	return n.register(binding)
+}
+
+// BindFuncNamed registers a named function implementation (see BindFunc).
+//
+// Example:
+//
+//	container.BindFuncNamed((*Validator)(nil), validateAdmin, "admin")
+func (n *Nasc) BindFuncNamed(abstractType, fn interface{}, name string) error {
+	if name == "" {
+		return &InvalidBindingError{Reason: "name cannot be empty"}
+	}
+	binding, err := funcBinding(abstractType, fn)
+	if err != nil {
+		return err
+	}
+	binding.Name = name
+	return n.registerNamed(binding)
+}
+
+// BindFuncWithTags registers a tagged function implementation
+// (see BindFunc and BindWithTags).
+//
+// Example:
+//
+//	container.BindFuncWithTags((*Validator)(nil), validateEmail, []string{"user-rules"})
+//	container.BindFuncWithTags((*Validator)(nil), validateAge, []string{"user-rules"})
+//
+//	rules := container.MakeWithTag("user-rules")
+func (n *Nasc) BindFuncWithTags(abstractType, fn interface{}, tags []string) error {
+	if len(tags) == 0 {
+		return &InvalidBindingError{Reason: "at least one tag is required"}
+	}
+	binding, err := funcBinding(abstractType, fn)
+	if err != nil {
+		return err
+	}
+	binding.Tags = tags
+	binding.Name = n.tagBindingName(binding.AbstractType, tags[0], runtime.FuncForPC(reflect.ValueOf(fn).Pointer()).Name())
+	return n.registerNamed(binding)
+}
+
+// funcBinding validates a function implementation and builds its binding.
+func funcBinding(abstractType, fn interface{}) (*registry.Binding, error) {
+	if abstractType == nil {
+		return nil, &InvalidBindingError{Reason: "abstract type cannot be nil"}
+	}
+	if fn == nil {
+		return nil, &InvalidBindingError{Reason: "function cannot be nil"}
+	}
+
+	abstractT := typeOfToken(abstractType)
+	if !isServiceType(abstractT) {
+		return nil, &InvalidBindingError{
+			Reason: fmt.Sprintf("abstract type must be a function type or interface, got %v", abstractT),
+		}
+	}
+
+	fnV := reflect.ValueOf(fn)
+	if fnV.Kind() != reflect.Func || fnV.IsNil() {
+		return nil, &InvalidBindingError{Reason: fmt.Sprintf("implementation must be a non-nil function, got %T", fn)}
+	}
+
+	var impl interface{}
+	switch {
+	case abstractT.Kind() == reflect.Func && fnV.Type().ConvertibleTo(abstractT):
+		// Plain function literals convert to the named function type
+		impl = fnV.Convert(abstractT).Interface()
+	case fnV.Type().AssignableTo(abstractT):
+		impl = fn
+	default:
+		return nil, &InvalidBindingError{
+			Reason: fmt.Sprintf("%T is not assignable to %v", fn, abstractT),
+		}
+	}
+
+	return &registry.Binding{
+		AbstractType: abstractT,
+		ConcreteType: fnV.Type(),
+		Lifetime:     string(LifetimeFactory),
+		Factory: FactoryFunc(func(*Nasc) (interface{}, error) {
+			return impl, nil
+		}),
+	}, nil
+}
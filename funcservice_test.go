@@ -0,0 +1,102 @@
+package nasc
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type validator func(name string) error
+
+type signupService struct {
+	Validate validator `inject:""`
+}
+
+func newSignupService(v validator) *signupService {
+	return &signupService{Validate: v}
+}
+
+func requireName(name string) error {
+	if name == "" {
+		return errors.New("name required")
+	}
+	return nil
+}
+
+func TestBindFunc_FunctionType(t *testing.T) {
+	container := New()
+	if err := container.BindFunc((*validator)(nil), requireName); err != nil {
+		t.Fatalf("BindFunc failed: %v", err)
+	}
+
+	v := container.Make((*validator)(nil)).(validator)
+	if v("") == nil || v("ann") != nil {
+		t.Error("resolved function should be the bound implementation")
+	}
+
+	_ = container.BindConstructor((*signupService)(nil), newSignupService)
+	svc := container.Make((*signupService)(nil)).(*signupService)
+	if svc.Validate == nil {
+		t.Error("function-typed constructor parameter should be injected")
+	}
+
+	wired := &signupService{}
+	if err := container.AutoWire(wired); err != nil || wired.Validate == nil {
+		t.Errorf("function-typed field should be auto-wired, err = %v", err)
+	}
+}
+
+func TestBindFunc_Interface(t *testing.T) {
+	container := New()
+	healthz := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	if err := container.BindFunc((*http.Handler)(nil), healthz); err != nil {
+		t.Fatalf("BindFunc failed: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	container.Make((*http.Handler)(nil)).(http.Handler).ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+}
+
+func TestBindFunc_NamedAndTagged(t *testing.T) {
+	container := New()
+	_ = container.BindFuncNamed((*validator)(nil), requireName, "required")
+	_ = container.BindFuncWithTags((*validator)(nil), requireName, []string{"rules"})
+	_ = container.BindFuncWithTags((*validator)(nil), func(string) error { return nil }, []string{"rules"})
+
+	if _, ok := container.MakeNamed((*validator)(nil), "required").(validator); !ok {
+		t.Error("named function should resolve")
+	}
+	if rules := container.MakeWithTag("rules"); len(rules) != 2 {
+		t.Errorf("expected 2 tagged functions, got %d", len(rules))
+	}
+}
+
+func TestBindFunc_Invalid(t *testing.T) {
+	container := New()
+	tests := []struct {
+		name     string
+		abstract interface{}
+		fn       interface{}
+	}{
+		{"nil abstract", nil, requireName},
+		{"nil function", (*validator)(nil), nil},
+		{"not a function", (*validator)(nil), "nope"},
+		{"struct abstract", (*ConsoleLogger)(nil), requireName},
+		{"signature mismatch", (*validator)(nil), func(int) error { return nil }},
+		{"not implementing", (*http.Handler)(nil), requireName},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var invalid *InvalidBindingError
+			if err := container.BindFunc(tt.abstract, tt.fn); !errors.As(err, &invalid) {
+				t.Errorf("expected InvalidBindingError, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// keyOf returns the binding key for T: the interface itself, or the type a
+// pointer points to, matching the (*T)(nil) token convention.
+func keyOf[T any]() reflect.Type {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() == reflect.Ptr {
+		return t.Elem()
+	}
+	return t
+}
+
+// tokenOf returns the type token used by Make for T.
+func tokenOf[T any]() interface{} {
+	return reflect.Zero(reflect.PointerTo(keyOf[T]())).Interface()
+}
+
+// Provide registers a typed factory binding for T. Unlike Factory, the
+// factory returns T rather than interface{}, so the compiler checks it.
+// T is an interface or a pointer; pointers are registered under the type
+// they point to, as with Bind.
+//
+// Example:
+//
+//	nasc.Provide(container, func(c *nasc.Nasc) (Logger, error) {
+//	    return NewFileLogger("app.log"), nil
+//	})
+func Provide[T any](c *Nasc, fn func(*Nasc) (T, error)) error {
+	if fn == nil {
+		return &InvalidBindingError{Reason: "factory function cannot be nil"}
+	}
+	if kind := reflect.TypeOf((*T)(nil)).Elem().Kind(); kind != reflect.Interface && kind != reflect.Ptr {
+		return &InvalidBindingError{
+			Reason: fmt.Sprintf("Provide requires an interface or pointer type, got %v", reflect.TypeOf((*T)(nil)).Elem()),
+		}
+	}
+
+	return c.Factory(tokenOf[T](), func(c *Nasc) (interface{}, error) {
+		return fn(c)
+	})
+}
+
+// Resolve resolves T without a type assertion at the call site.
+// It panics like Make if T cannot be resolved.
+//
+// Example:
+//
+//	logger := nasc.Resolve[Logger](container)
+func Resolve[T any](c *Nasc) T {
+	instance := c.Make(tokenOf[T]())
+	return castTo[T](instance)
+}
+
+// ResolveSafe resolves T like MakeSafe, returning an error instead of panicking.
+//
+// Example:
+//
+//	server, err := nasc.ResolveSafe[*Server](container)
+func ResolveSafe[T any](c *Nasc) (T, error) {
+	instance, err := c.MakeSafe(tokenOf[T]())
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	typed, err := tryCastTo[T](instance)
+	if err != nil {
+		return typed, &ResolutionError{Type: keyOf[T](), Context: "type mismatch", Cause: err}
+	}
+	return typed, nil
+}
+
+// castTo converts a resolved instance to T; nil becomes the zero value.
+// It panics if the instance is not a T.
+func castTo[T any](instance interface{}) T {
+	typed, err := tryCastTo[T](instance)
+	if err != nil {
+		panic(err.Error())
+	}
+	return typed
+}
+
+// tryCastTo converts a resolved instance to T like castTo, returning an
+// error instead of panicking.
+func tryCastTo[T any](instance interface{}) (T, error) {
+	var zero T
+	if instance == nil {
+		return zero, nil
+	}
+	typed, ok := instance.(T)
+	if !ok {
+		return zero, fmt.Errorf("resolved %T, which is not a %v", instance, reflect.TypeOf((*T)(nil)).Elem())
+	}
+	return typed, nil
+}
+
+// ResolveAll resolves every binding of T like MakeAll, in the same order,
+// without type assertions at the call site.
+//
+// Example:
+//
+//	for _, logger := range nasc.ResolveAll[Logger](container) {
+//	    logger.Log("message")
+//	}
+func ResolveAll[T any](c *Nasc) []T {
+	instances := c.MakeAll(tokenOf[T]())
+	typed := make([]T, 0, len(instances))
+	for _, instance := range instances {
+		typed = append(typed, castTo[T](instance))
+	}
+	return typed
+}
+
+// ResolveTagged resolves the bindings of T carrying tag, ordered like
+// MakeWithTag. Bindings of other types sharing the tag are skipped
+// without being created.
+//
+// Example:
+//
+//	for _, plugin := range nasc.ResolveTagged[Plugin](container, "plugin") {
+//	    plugin.Init()
+//	}
+func ResolveTagged[T any](c *Nasc, tag string) []T {
+	if tag == "" {
+		panic("tag cannot be empty")
+	}
+
+	key := keyOf[T]()
+	typed := []T{}
+	for _, binding := range c.registry.GetByTag(tag) {
+		if binding.AbstractType != key {
+			continue
+		}
+		typed = append(typed, castTo[T](c.createInstanceFromBinding(binding, key)))
+	}
+	return typed
+}
@@ -0,0 +1,110 @@
+package nasc
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestProvide_Interface(t *testing.T) {
+	container := New()
+	err := Provide(container, func(c *Nasc) (Logger, error) {
+		return &ConsoleLogger{}, nil
+	})
+	if err != nil {
+		t.Fatalf("Provide failed: %v", err)
+	}
+
+	logger := Resolve[Logger](container)
+	if _, ok := logger.(*ConsoleLogger); !ok {
+		t.Errorf("Resolve returned %T", logger)
+	}
+	if _, ok := container.Make((*Logger)(nil)).(*ConsoleLogger); !ok {
+		t.Error("Provide should register a regular binding")
+	}
+}
+
+func TestProvide_Pointer(t *testing.T) {
+	container := New()
+	_ = Provide(container, func(c *Nasc) (*MockDB, error) {
+		return &MockDB{connected: true}, nil
+	})
+
+	db, err := ResolveSafe[*MockDB](container)
+	if err != nil {
+		t.Fatalf("ResolveSafe failed: %v", err)
+	}
+	if !db.connected {
+		t.Error("pointer result should come from the factory")
+	}
+	if _, ok := container.Make((*MockDB)(nil)).(*MockDB); !ok {
+		t.Error("pointer should be registered under the type it points to")
+	}
+}
+
+func TestProvide_Invalid(t *testing.T) {
+	container := New()
+	var invalid *InvalidBindingError
+	if err := Provide[Logger](container, nil); !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidBindingError for nil factory, got %v", err)
+	}
+	if err := Provide(container, func(c *Nasc) (string, error) { return "", nil }); !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidBindingError for value type, got %v", err)
+	}
+}
+
+func TestResolveSafe_Errors(t *testing.T) {
+	container := New()
+	if _, err := ResolveSafe[Logger](container); err == nil {
+		t.Error("expected error for unbound type")
+	}
+
+	_ = Provide(container, func(c *Nasc) (Logger, error) {
+		return nil, errors.New("boom")
+	})
+	if _, err := ResolveSafe[Logger](container); err == nil {
+		t.Error("expected factory error")
+	}
+
+	_ = container.Factory((*ConsoleLogger)(nil), func(*Nasc) (interface{}, error) {
+		return &FileLogger{}, nil
+	})
+	var resolution *ResolutionError
+	if _, err := ResolveSafe[*ConsoleLogger](container); !errors.As(err, &resolution) {
+		t.Errorf("expected ResolutionError for a mismatched instance, got %v", err)
+	}
+}
+
+func TestResolveAll(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+	_ = container.BindNamed((*Logger)(nil), &FileLogger{}, "file")
+
+	loggers := ResolveAll[Logger](container)
+	if len(loggers) != 2 {
+		t.Fatalf("ResolveAll returned %d loggers, want 2", len(loggers))
+	}
+	if _, ok := loggers[1].(*FileLogger); !ok {
+		t.Errorf("ResolveAll should keep MakeAll's order, got %T", loggers[1])
+	}
+	if got := ResolveAll[Database](container); got == nil || len(got) != 0 {
+		t.Errorf("ResolveAll without bindings = %v, want an empty slice", got)
+	}
+}
+
+func TestResolveTagged(t *testing.T) {
+	container := New()
+	_ = As[NotificationService, EmailNotifier](container, WithTags("outbound"))
+	_ = As[Logger, ConsoleLogger](container, WithTags("outbound"))
+	_ = As[NotificationService, SMSNotifier](container, WithTags("outbound"))
+
+	notifiers := ResolveTagged[NotificationService](container, "outbound")
+	if len(notifiers) != 2 {
+		t.Fatalf("ResolveTagged returned %d notifiers, want 2", len(notifiers))
+	}
+	if _, ok := notifiers[0].(*EmailNotifier); !ok {
+		t.Errorf("notifiers[0] = %T, want *EmailNotifier", notifiers[0])
+	}
+	if got := ResolveTagged[Database](container, "outbound"); len(got) != 0 {
+		t.Errorf("ResolveTagged for an untagged type = %v, want empty", got)
+	}
+}
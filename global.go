@@ -0,0 +1,35 @@
+package nasc
+
+import "sync"
+
+var (
+	defaultContainer *Nasc
+	defaultOnce      sync.Once
+)
+
+// Default returns the process-wide container.
+// The container is created lazily on first use and shared by all callers,
+// which lets small programs and libraries avoid threading *Nasc everywhere.
+//
+// Example:
+//
+//	nasc.BindGlobal((*Logger)(nil), &ConsoleLogger{})
+//	logger := nasc.MakeGlobal((*Logger)(nil)).(Logger)
+func Default() *Nasc {
+	defaultOnce.Do(func() {
+		defaultContainer = New()
+	})
+	return defaultContainer
+}
+
+// BindGlobal registers a binding on the default container.
+// It is equivalent to Default().Bind(abstractType, concreteType).
+func BindGlobal(abstractType, concreteType interface{}) error {
+	return Default().Bind(abstractType, concreteType)
+}
+
+// MakeGlobal resolves an instance from the default container.
+// It is equivalent to Default().Make(abstractType) and panics on failure.
+func MakeGlobal(abstractType interface{}) interface{} {
+	return Default().Make(abstractType)
+}
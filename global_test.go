@@ -0,0 +1,53 @@
+package nasc
+
+import (
+	"sync"
+	"testing"
+)
+
+type globalGreeter interface {
+	Greet() string
+}
+
+type globalGreeterImpl struct{}
+
+func (g *globalGreeterImpl) Greet() string {
+	return "hello"
+}
+
+func TestDefault_ReturnsSameContainer(t *testing.T) {
+	var wg sync.WaitGroup
+	containers := make([]*Nasc, 20)
+
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		i := i
+		go func() {
+			defer wg.Done()
+			containers[i] = Default()
+		}()
+	}
+	wg.Wait()
+
+	for i, c := range containers {
+		if c == nil || c != containers[0] {
+			t.Fatalf("Default() returned a different container at index %d", i)
+		}
+	}
+}
+
+func TestBindGlobal_MakeGlobal(t *testing.T) {
+	if err := BindGlobal((*globalGreeter)(nil), &globalGreeterImpl{}); err != nil {
+		t.Fatalf("BindGlobal failed: %v", err)
+	}
+
+	greeter := MakeGlobal((*globalGreeter)(nil)).(globalGreeter)
+	if greeter.Greet() != "hello" {
+		t.Error("MakeGlobal returned wrong instance")
+	}
+
+	// The binding must be visible through Default()
+	if _, err := Default().MakeSafe((*globalGreeter)(nil)); err != nil {
+		t.Errorf("binding not visible on Default(): %v", err)
+	}
+}
@@ -0,0 +1,71 @@
+package nasc
+
+import (
+	"fmt"
+	"sync"
+)
+
+var (
+	globalProviders  []ServiceProvider
+	globalProviderMu sync.Mutex
+)
+
+// RegisterGlobalProvider adds a provider to the process-wide list that
+// containers created with WithGlobalProviders register. Packages call it
+// from init, so importing a package is enough to plug its services in.
+// It panics if provider is nil.
+//
+// Example:
+//
+//	package redisplugin
+//
+//	func init() {
+//	    nasc.RegisterGlobalProvider(&RedisProvider{})
+//	}
+//
+//	// In main:
+//	import _ "example.com/app/redisplugin"
+func RegisterGlobalProvider(provider ServiceProvider) {
+	if provider == nil {
+		panic("global provider cannot be nil")
+	}
+
+	globalProviderMu.Lock()
+	defer globalProviderMu.Unlock()
+	globalProviders = append(globalProviders, provider)
+}
+
+// GlobalProviders returns the providers added with RegisterGlobalProvider,
+// in the order they were added.
+func GlobalProviders() []ServiceProvider {
+	globalProviderMu.Lock()
+	defer globalProviderMu.Unlock()
+	return append([]ServiceProvider(nil), globalProviders...)
+}
+
+// WithGlobalProviders registers the providers added with
+// RegisterGlobalProvider once the container's other options are applied.
+// New panics if one of them fails to register.
+//
+// Example:
+//
+//	container := nasc.New(nasc.WithGlobalProviders())
+//	if err := container.BootProviders(); err != nil {
+//	    log.Fatal(err)
+//	}
+func WithGlobalProviders() Option {
+	return func(n *Nasc) error {
+		n.useGlobalProviders = true
+		return nil
+	}
+}
+
+// registerGlobalProviders registers the global providers, for New.
+func (n *Nasc) registerGlobalProviders() error {
+	for _, provider := range GlobalProviders() {
+		if err := n.RegisterProvider(provider); err != nil {
+			return fmt.Errorf("global provider %T: %w", provider, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package nasc
+
+import "testing"
+
+// resetGlobalProviders restores the global provider list after a test.
+func resetGlobalProviders(t *testing.T) {
+	saved := GlobalProviders()
+	t.Cleanup(func() {
+		globalProviderMu.Lock()
+		globalProviders = saved
+		globalProviderMu.Unlock()
+	})
+}
+
+func TestWithGlobalProviders(t *testing.T) {
+	resetGlobalProviders(t)
+	RegisterGlobalProvider(&LoggingProvider{})
+	RegisterGlobalProvider(&LoggingProvider{})
+
+	container := New(WithGlobalProviders())
+	if len(container.GetProviders()) != 1 {
+		t.Errorf("registered %d providers, want duplicates skipped", len(container.GetProviders()))
+	}
+	if _, ok := container.Make((*Logger)(nil)).(*ConsoleLogger); !ok {
+		t.Error("global provider bindings should be registered")
+	}
+
+	if len(New().GetProviders()) != 0 {
+		t.Error("containers without WithGlobalProviders should not register global providers")
+	}
+}
+
+func TestWithGlobalProviders_Failure(t *testing.T) {
+	resetGlobalProviders(t)
+	RegisterGlobalProvider(&FailingProvider{})
+
+	defer func() {
+		if recover() == nil {
+			t.Error("New should panic when a global provider fails to register")
+		}
+	}()
+	New(WithGlobalProviders())
+}
+
+func TestRegisterGlobalProvider_Nil(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("RegisterGlobalProvider should panic on a nil provider")
+		}
+	}()
+	RegisterGlobalProvider(nil)
+}
@@ -0,0 +1,246 @@
+package nasc
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// DependencyGraph is a snapshot of the container's bindings and the
+// dependencies declared between them (see Graph).
+type DependencyGraph struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+}
+
+// GraphNode is one binding in a DependencyGraph.
+type GraphNode struct {
+	// ID is the abstract type, followed by "#name" for named bindings
+	ID string `json:"id"`
+
+	Type     string   `json:"type"`
+	Name     string   `json:"name,omitempty"`
+	Lifetime string   `json:"lifetime"`
+	Concrete string   `json:"concrete,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+	Origin   string   `json:"origin,omitempty"`
+}
+
+// GraphEdge is a dependency of one binding on another in a DependencyGraph.
+type GraphEdge struct {
+	// From is the ID of the binding declaring the dependency
+	From string `json:"from"`
+
+	// To is the ID of the binding the dependency resolves to
+	To string `json:"to"`
+
+	// Kind qualifies the edge, e.g. "lazy", "optional" or "tag=plugin";
+	// empty for a plain dependency
+	Kind string `json:"kind,omitempty"`
+
+	// Missing marks a required dependency that no binding satisfies; To
+	// then names the missing type
+	Missing bool `json:"missing,omitempty"`
+}
+
+// Graph returns the container's dependency graph. Dependencies come from
+// constructor parameters and auto-wired fields, as for PrintTree; factory
+// bindings have none. Nodes are ordered by type string, then name, and
+// edges by their From, To and Kind, so the output is the same on every
+// run. Nothing is resolved.
+//
+// Example:
+//
+//	for _, edge := range container.Graph().Edges {
+//	    if edge.Missing {
+//	        fmt.Printf("%s needs unbound %s\n", edge.From, edge.To)
+//	    }
+//	}
+func (n *Nasc) Graph() DependencyGraph {
+	bindings := n.sortedBindings()
+	graph := DependencyGraph{
+		Nodes: make([]GraphNode, 0, len(bindings)),
+		Edges: []GraphEdge{},
+	}
+
+	for _, b := range bindings {
+		node := GraphNode{
+			ID:       graphNodeID(b.AbstractType.String(), b.Name),
+			Type:     b.AbstractType.String(),
+			Name:     b.Name,
+			Lifetime: b.Lifetime,
+			Tags:     append([]string(nil), b.Tags...),
+			Origin:   b.Origin,
+		}
+		if b.ConcreteType != nil {
+			node.Concrete = b.ConcreteType.String()
+		}
+		graph.Nodes = append(graph.Nodes, node)
+		graph.Edges = append(graph.Edges, n.graphEdges(node.ID, b)...)
+	}
+
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		a, b := graph.Edges[i], graph.Edges[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.To != b.To {
+			return a.To < b.To
+		}
+		return a.Kind < b.Kind
+	})
+	return graph
+}
+
+// graphEdges lists the edges leaving the binding with the given node ID.
+func (n *Nasc) graphEdges(from string, b *registry.Binding) []GraphEdge {
+	var edges []GraphEdge
+	for _, dep := range n.treeDependencies(b) {
+		if dep.note == "ambient" {
+			continue
+		}
+		kind := dep.note
+		if dep.optional && kind == "" {
+			kind = "optional"
+		}
+
+		targets := n.dependencyBindings(dep)
+		if len(targets) == 0 && !dep.optional && !dep.collection {
+			edges = append(edges, GraphEdge{From: from, To: graphNodeID(dep.t.String(), dep.name), Kind: kind, Missing: true})
+		}
+		for _, target := range targets {
+			edges = append(edges, GraphEdge{From: from, To: graphNodeID(target.AbstractType.String(), target.Name), Kind: kind})
+		}
+	}
+	return edges
+}
+
+// graphNodeID identifies a binding in a DependencyGraph.
+func graphNodeID(typeName, name string) string {
+	if name == "" {
+		return typeName
+	}
+	return typeName + "#" + name
+}
+
+// GraphDOT writes the dependency graph (see Graph) to w in Graphviz DOT
+// format. Qualified edges are dashed and labeled, and missing dependencies
+// are drawn in red.
+//
+// Example:
+//
+//	f, _ := os.Create("deps.dot")
+//	defer f.Close()
+//	container.GraphDOT(f)
+//	// dot -Tsvg deps.dot -o deps.svg
+func (n *Nasc) GraphDOT(w io.Writer) error {
+	graph := n.Graph()
+
+	var b strings.Builder
+	b.WriteString("digraph nasc {\n")
+	b.WriteString("\tnode [shape=box];\n")
+	for _, node := range graph.Nodes {
+		label := node.ID + "\n(" + node.Lifetime + ")"
+		if node.Concrete != "" {
+			label += " " + node.Concrete
+		}
+		fmt.Fprintf(&b, "\t%q [label=%q];\n", node.ID, label)
+	}
+
+	missing := make(map[string]bool)
+	for _, edge := range graph.Edges {
+		var attrs []string
+		if edge.Kind != "" {
+			attrs = append(attrs, "style=dashed", fmt.Sprintf("label=%q", edge.Kind))
+		}
+		if edge.Missing {
+			attrs = append(attrs, "color=red")
+			if !missing[edge.To] {
+				missing[edge.To] = true
+				fmt.Fprintf(&b, "\t%q [color=red, fontcolor=red];\n", edge.To)
+			}
+		}
+		if len(attrs) > 0 {
+			fmt.Fprintf(&b, "\t%q -> %q [%s];\n", edge.From, edge.To, strings.Join(attrs, ", "))
+		} else {
+			fmt.Fprintf(&b, "\t%q -> %q;\n", edge.From, edge.To)
+		}
+	}
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// ExportJSON writes the dependency graph (see Graph) to w as indented
+// JSON, for tools that render or diff the wiring.
+//
+// Example:
+//
+//	f, _ := os.Create("deps.json")
+//	defer f.Close()
+//	container.ExportJSON(f)
+func (n *Nasc) ExportJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(n.Graph()); err != nil {
+		return fmt.Errorf("failed to write graph: %w", err)
+	}
+	return nil
+}
+
+// Report writes a plain-text summary of the dependency graph (see Graph)
+// to w: each binding with its lifetime and implementation, its
+// dependencies below it, and a closing count of bindings, dependencies
+// and missing dependencies.
+//
+// Example:
+//
+//	container.Report(os.Stdout)
+//
+//	// nasc.App (singleton) *main.app
+//	//   -> nasc.Database#primary
+//	//   -> nasc.Logger <lazy>
+//	//   -> nasc.Mailer (missing)
+//	//
+//	// 3 bindings, 3 dependencies, 1 missing
+func (n *Nasc) Report(w io.Writer) error {
+	graph := n.Graph()
+
+	edges := make(map[string][]GraphEdge, len(graph.Nodes))
+	missing := 0
+	for _, edge := range graph.Edges {
+		edges[edge.From] = append(edges[edge.From], edge)
+		if edge.Missing {
+			missing++
+		}
+	}
+
+	var b strings.Builder
+	for _, node := range graph.Nodes {
+		line := node.ID + " (" + node.Lifetime + ")"
+		if node.Concrete != "" {
+			line += " " + node.Concrete
+		}
+		b.WriteString(line + "\n")
+
+		for _, edge := range edges[node.ID] {
+			line := "  -> " + edge.To
+			if edge.Kind != "" {
+				line += " <" + edge.Kind + ">"
+			}
+			if edge.Missing {
+				line += " (missing)"
+			}
+			b.WriteString(line + "\n")
+		}
+	}
+	fmt.Fprintf(&b, "\n%d bindings, %d dependencies, %d missing\n", len(graph.Nodes), len(graph.Edges), missing)
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
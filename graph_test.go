@@ -0,0 +1,110 @@
+package nasc
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func graphContainer() *Nasc {
+	container := New()
+	_ = container.Singleton((*Logger)(nil), &ConsoleLogger{})
+	_ = container.BindNamed((*Logger)(nil), &FileLogger{}, "file")
+	_ = container.BindConstructor((*ConstructorService)(nil), NewServiceWithDeps)
+	return container
+}
+
+func TestGraph(t *testing.T) {
+	graph := graphContainer().Graph()
+
+	var ids []string
+	for _, node := range graph.Nodes {
+		ids = append(ids, node.ID)
+	}
+	if got := strings.Join(ids, ","); got != "nasc.ConstructorService,nasc.Logger,nasc.Logger#file" {
+		t.Errorf("nodes = %s, want them ordered by type, then name", got)
+	}
+
+	want := []GraphEdge{
+		{From: "nasc.ConstructorService", To: "nasc.Database", Missing: true},
+		{From: "nasc.ConstructorService", To: "nasc.Logger"},
+	}
+	if len(graph.Edges) != len(want) {
+		t.Fatalf("edges = %+v, want %+v", graph.Edges, want)
+	}
+	for i, edge := range graph.Edges {
+		if edge.From != want[i].From || edge.To != want[i].To || edge.Missing != want[i].Missing {
+			t.Errorf("edge %d = %+v, want %+v", i, edge, want[i])
+		}
+	}
+}
+
+func TestGraph_Deterministic(t *testing.T) {
+	var first bytes.Buffer
+	_ = graphContainer().GraphDOT(&first)
+	for i := 0; i < 10; i++ {
+		var again bytes.Buffer
+		_ = graphContainer().GraphDOT(&again)
+		if again.String() != first.String() {
+			t.Fatalf("GraphDOT output changed between runs:\n%s\nthen:\n%s", first.String(), again.String())
+		}
+	}
+}
+
+func TestGraphDOT(t *testing.T) {
+	var buf bytes.Buffer
+	if err := graphContainer().GraphDOT(&buf); err != nil {
+		t.Fatalf("GraphDOT failed: %v", err)
+	}
+
+	out := buf.String()
+	for _, line := range []string{
+		"digraph nasc {",
+		`"nasc.Logger#file" [label="nasc.Logger#file\n(transient) *nasc.FileLogger"];`,
+		`"nasc.ConstructorService" -> "nasc.Logger";`,
+		`"nasc.Database" [color=red, fontcolor=red];`,
+		`"nasc.ConstructorService" -> "nasc.Database" [color=red];`,
+	} {
+		if !strings.Contains(out, line) {
+			t.Errorf("DOT output missing %q:\n%s", line, out)
+		}
+	}
+}
+
+func TestExportJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := graphContainer().ExportJSON(&buf); err != nil {
+		t.Fatalf("ExportJSON failed: %v", err)
+	}
+
+	var graph DependencyGraph
+	if err := json.Unmarshal(buf.Bytes(), &graph); err != nil {
+		t.Fatalf("ExportJSON wrote invalid JSON: %v", err)
+	}
+	if len(graph.Nodes) != 3 || len(graph.Edges) != 2 {
+		t.Errorf("decoded %d nodes and %d edges, want 3 and 2", len(graph.Nodes), len(graph.Edges))
+	}
+	if graph.Nodes[1].Lifetime != "singleton" || graph.Nodes[1].Concrete != "*nasc.ConsoleLogger" {
+		t.Errorf("node = %+v, want the singleton logger", graph.Nodes[1])
+	}
+}
+
+func TestReport(t *testing.T) {
+	var buf bytes.Buffer
+	if err := graphContainer().Report(&buf); err != nil {
+		t.Fatalf("Report failed: %v", err)
+	}
+
+	want := `nasc.ConstructorService (transient) *nasc.ConstructorServiceImpl
+  -> nasc.Database (missing)
+  -> nasc.Logger
+nasc.Logger (singleton) *nasc.ConsoleLogger
+nasc.Logger#file (transient) *nasc.FileLogger
+
+3 bindings, 2 dependencies, 1 missing
+`
+	if buf.String() != want {
+		t.Errorf("Report output:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
@@ -0,0 +1,239 @@
+package nasc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// Liveness is implemented by services that can tell whether they are
+// working at all. A failing critical liveness check means the process
+// should be restarted.
+type Liveness interface {
+	Liveness(ctx context.Context) error
+}
+
+// Readiness is implemented by services that can tell whether they can
+// serve traffic right now, such as a connection pool that has connected.
+// A failing critical readiness check means traffic should be held back.
+type Readiness interface {
+	Readiness(ctx context.Context) error
+}
+
+// HealthCheck describes a service checked by CheckLiveness and
+// CheckReadiness.
+type HealthCheck struct {
+	// Name identifies the check in reports. Defaults to the service type.
+	Name string
+
+	// Critical checks fail the report; other failing checks only degrade it.
+	Critical bool
+
+	// Timeout bounds the check. Zero means the context's own deadline.
+	Timeout time.Duration
+}
+
+// HealthStatus is the outcome of a check or of a whole report.
+type HealthStatus string
+
+const (
+	// HealthPass means the check succeeded.
+	HealthPass HealthStatus = "pass"
+	// HealthWarn means a non-critical check failed.
+	HealthWarn HealthStatus = "warn"
+	// HealthFail means a critical check failed.
+	HealthFail HealthStatus = "fail"
+)
+
+// HealthCheckResult is the outcome of one check in a HealthReport.
+type HealthCheckResult struct {
+	Name     string        `json:"name"`
+	Status   HealthStatus  `json:"status"`
+	Critical bool          `json:"critical"`
+	Duration time.Duration `json:"duration"`
+	Error    string        `json:"error,omitempty"`
+}
+
+// HealthReport is the structured result of CheckLiveness or
+// CheckReadiness, ready to be encoded as JSON by a probe endpoint. Its
+// status is the worst status of its checks.
+type HealthReport struct {
+	Status HealthStatus        `json:"status"`
+	Checks []HealthCheckResult `json:"checks"`
+}
+
+// Healthy reports whether no critical check failed, which is what a
+// Kubernetes probe should act on.
+func (r HealthReport) Healthy() bool {
+	return r.Status != HealthFail
+}
+
+// healthEntry is a service registered with AddHealthCheck.
+type healthEntry struct {
+	abstractT reflect.Type
+	check     HealthCheck
+}
+
+// healthChecks holds the services registered with AddHealthCheck.
+type healthChecks struct {
+	mu      sync.Mutex
+	entries []healthEntry
+}
+
+// AddHealthCheck registers abstractType's service as a health check. The
+// service is resolved when checks run and is consulted by CheckLiveness if
+// it implements Liveness and by CheckReadiness if it implements Readiness.
+//
+// Example:
+//
+//	container.AddHealthCheck((*Database)(nil), nasc.HealthCheck{
+//	    Name:     "database",
+//	    Critical: true,
+//	    Timeout:  2 * time.Second,
+//	})
+//
+//	http.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+//	    report := container.CheckReadiness(r.Context())
+//	    if !report.Healthy() {
+//	        w.WriteHeader(http.StatusServiceUnavailable)
+//	    }
+//	    json.NewEncoder(w).Encode(report)
+//	})
+func (n *Nasc) AddHealthCheck(abstractType interface{}, check HealthCheck) error {
+	if abstractType == nil {
+		return &InvalidBindingError{Reason: "health check type cannot be nil"}
+	}
+	if check.Timeout < 0 {
+		return &InvalidBindingError{Reason: fmt.Sprintf("health check timeout cannot be negative, got %v", check.Timeout)}
+	}
+
+	abstractT := typeOfToken(abstractType)
+	if check.Name == "" {
+		check.Name = abstractT.String()
+	}
+
+	n.health.mu.Lock()
+	defer n.health.mu.Unlock()
+	n.health.entries = append(n.health.entries, healthEntry{abstractT: abstractT, check: check})
+	return nil
+}
+
+// CheckLiveness runs the liveness checks concurrently and reports their
+// outcome in registration order.
+func (n *Nasc) CheckLiveness(ctx context.Context) HealthReport {
+	return n.checkHealth(ctx, func(instance interface{}) (func(context.Context) error, bool) {
+		live, ok := instance.(Liveness)
+		if !ok {
+			return nil, false
+		}
+		return live.Liveness, true
+	})
+}
+
+// CheckReadiness runs the readiness checks concurrently and reports their
+// outcome in registration order.
+func (n *Nasc) CheckReadiness(ctx context.Context) HealthReport {
+	return n.checkHealth(ctx, func(instance interface{}) (func(context.Context) error, bool) {
+		ready, ok := instance.(Readiness)
+		if !ok {
+			return nil, false
+		}
+		return ready.Readiness, true
+	})
+}
+
+// checkHealth resolves each registered service and runs the probe
+// selected for it, concurrently and bounded by the check's timeout. A
+// service that cannot be resolved in time fails its check.
+func (n *Nasc) checkHealth(ctx context.Context, probeOf func(instance interface{}) (func(context.Context) error, bool)) HealthReport {
+	n.health.mu.Lock()
+	entries := append([]healthEntry(nil), n.health.entries...)
+	n.health.mu.Unlock()
+
+	results := make([]*HealthCheckResult, len(entries))
+	var wg sync.WaitGroup
+	for i, entry := range entries {
+		wg.Add(1)
+		go func(i int, entry healthEntry) {
+			defer wg.Done()
+			start := time.Now()
+			err := runProbe(ctx, entry.check.Timeout, func(ctx context.Context) error {
+				instance, err := n.MakeSafe(reflect.Zero(reflect.PointerTo(entry.abstractT)).Interface())
+				if err != nil {
+					return err
+				}
+				probe, ok := probeOf(instance)
+				if !ok {
+					return errNoProbe
+				}
+				return probe(ctx)
+			})
+			if err == errNoProbe {
+				return
+			}
+
+			result := &HealthCheckResult{Name: entry.check.Name, Critical: entry.check.Critical}
+			result.finish(err, time.Since(start))
+			results[i] = result
+		}(i, entry)
+	}
+	wg.Wait()
+
+	report := HealthReport{Status: HealthPass, Checks: []HealthCheckResult{}}
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		report.Checks = append(report.Checks, *result)
+		if result.Status == HealthFail || (result.Status == HealthWarn && report.Status == HealthPass) {
+			report.Status = result.Status
+		}
+	}
+	return report
+}
+
+// finish records a check's outcome.
+func (r *HealthCheckResult) finish(err error, duration time.Duration) {
+	r.Duration = duration
+	switch {
+	case err == nil:
+		r.Status = HealthPass
+	case r.Critical:
+		r.Status, r.Error = HealthFail, err.Error()
+	default:
+		r.Status, r.Error = HealthWarn, err.Error()
+	}
+}
+
+// errNoProbe reports that a service does not implement the probe being run.
+var errNoProbe = errors.New("service has no probe")
+
+// runProbe runs a probe bounded by timeout, returning when the timeout
+// expires even if the probe ignores its context. A panicking probe fails
+// the check.
+func runProbe(ctx context.Context, timeout time.Duration, probe func(context.Context) error) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- fmt.Errorf("probe panicked: %v", r)
+			}
+		}()
+		done <- probe(ctx)
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,124 @@
+package nasc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type probedDB struct {
+	MockDB
+	ready error
+	block bool
+}
+
+func (d *probedDB) Liveness(ctx context.Context) error { return nil }
+
+func (d *probedDB) Readiness(ctx context.Context) error {
+	if d.block {
+		// Ignore ctx, like a probe stuck on a dead connection
+		time.Sleep(time.Second)
+	}
+	return d.ready
+}
+
+type probedCache struct{ ready error }
+
+func (c *probedCache) Readiness(ctx context.Context) error { return c.ready }
+
+type Cache interface{}
+
+func TestCheckReadiness(t *testing.T) {
+	container := New()
+	_ = container.BindInstanceAs(&probedDB{}, (*Database)(nil))
+	_ = container.BindInstanceAs(&probedCache{ready: errors.New("warming up")}, (*Cache)(nil))
+	_ = container.AddHealthCheck((*Database)(nil), HealthCheck{Name: "database", Critical: true})
+	_ = container.AddHealthCheck((*Cache)(nil), HealthCheck{})
+
+	report := container.CheckReadiness(context.Background())
+	if report.Status != HealthWarn || !report.Healthy() {
+		t.Errorf("status = %s, want warn for a failing non-critical check", report.Status)
+	}
+	if len(report.Checks) != 2 || report.Checks[0].Name != "database" || report.Checks[1].Name != "nasc.Cache" {
+		t.Fatalf("checks = %+v, want database and the defaulted cache name", report.Checks)
+	}
+	if report.Checks[1].Status != HealthWarn || report.Checks[1].Error != "warming up" {
+		t.Errorf("cache check = %+v", report.Checks[1])
+	}
+
+	encoded, _ := json.Marshal(report)
+	if !strings.Contains(string(encoded), `"status":"warn"`) {
+		t.Errorf("JSON report = %s", encoded)
+	}
+}
+
+func TestCheckLiveness(t *testing.T) {
+	container := New()
+	_ = container.BindInstanceAs(&probedDB{ready: errors.New("down")}, (*Database)(nil))
+	_ = container.BindInstanceAs(&probedCache{}, (*Cache)(nil))
+	_ = container.AddHealthCheck((*Database)(nil), HealthCheck{Critical: true})
+	_ = container.AddHealthCheck((*Cache)(nil), HealthCheck{Critical: true})
+
+	report := container.CheckLiveness(context.Background())
+	if report.Status != HealthPass || len(report.Checks) != 1 {
+		t.Errorf("report = %+v, want only the liveness check, passing", report)
+	}
+}
+
+func TestCheckReadiness_CriticalAndTimeout(t *testing.T) {
+	container := New()
+	_ = container.BindInstanceAs(&probedDB{block: true}, (*Database)(nil))
+	_ = container.AddHealthCheck((*Database)(nil), HealthCheck{Critical: true, Timeout: 10 * time.Millisecond})
+	_ = container.AddHealthCheck((*Logger)(nil), HealthCheck{Name: "unbound"})
+
+	report := container.CheckReadiness(context.Background())
+	if report.Healthy() || report.Status != HealthFail {
+		t.Errorf("status = %s, want fail for a timed out critical check", report.Status)
+	}
+	if !strings.Contains(report.Checks[0].Error, "deadline exceeded") {
+		t.Errorf("timed out check = %+v", report.Checks[0])
+	}
+	if report.Checks[1].Status != HealthWarn {
+		t.Errorf("unresolvable check = %+v, want it to fail as non-critical", report.Checks[1])
+	}
+
+	if err := container.AddHealthCheck(nil, HealthCheck{}); err == nil {
+		t.Error("a nil health check type should fail")
+	}
+}
+
+type panickingProbe struct{}
+
+func (panickingProbe) Readiness(ctx context.Context) error { panic("probe bug") }
+
+func TestCheckReadiness_PanickingProbe(t *testing.T) {
+	container := New()
+	_ = container.BindInstanceAs(panickingProbe{}, (*Cache)(nil))
+	_ = container.AddHealthCheck((*Cache)(nil), HealthCheck{Critical: true})
+
+	report := container.CheckReadiness(context.Background())
+	if report.Status != HealthFail || !strings.Contains(report.Checks[0].Error, "probe bug") {
+		t.Errorf("report = %+v, want the panic reported as a failed check", report)
+	}
+}
+
+func TestCheckReadiness_SlowResolution(t *testing.T) {
+	container := New()
+	_ = container.Factory((*Database)(nil), func(c *Nasc) (interface{}, error) {
+		time.Sleep(time.Second)
+		return &probedDB{}, nil
+	})
+	_ = container.AddHealthCheck((*Database)(nil), HealthCheck{Critical: true, Timeout: 10 * time.Millisecond})
+
+	start := time.Now()
+	report := container.CheckReadiness(context.Background())
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("check took %v, want resolution bounded by the timeout", elapsed)
+	}
+	if report.Status != HealthFail || !strings.Contains(report.Checks[0].Error, "deadline exceeded") {
+		t.Errorf("report = %+v, want a timed out check", report)
+	}
+}
@@ -0,0 +1,211 @@
+package nasc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// HostedService is a background service, such as a queue consumer or a
+// scheduler, whose lifetime the container manages. Start runs on its own
+// goroutine and may block until the service finishes; its context is
+// canceled when the service is stopped. Stop is called during shutdown,
+// before the service's dependencies are disposed.
+//
+// Example:
+//
+//	func (w *Worker) Start(ctx context.Context) error {
+//	    for {
+//	        select {
+//	        case job := <-w.jobs:
+//	            w.process(job)
+//	        case <-ctx.Done():
+//	            return nil
+//	        }
+//	    }
+//	}
+//
+//	func (w *Worker) Stop(ctx context.Context) error {
+//	    return w.flush(ctx)
+//	}
+type HostedService interface {
+	Start(ctx context.Context) error
+	Stoppable
+}
+
+// runningService is a hosted service whose Start goroutine was launched.
+type runningService struct {
+	abstractT reflect.Type
+	service   HostedService
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+// hostedServices tracks the container's hosted services.
+type hostedServices struct {
+	mu       sync.Mutex
+	types    []reflect.Type
+	running  []*runningService
+	stopping bool
+
+	// failures receives the errors of Start calls that fail while running
+	failures chan error
+}
+
+// AddHostedService registers abstractType as a hosted service. Its binding
+// is resolved when hosted services start, so it can depend on anything in
+// the container, and the instance must implement HostedService. Bind it as
+// a singleton so other services resolve the running instance.
+//
+// Example:
+//
+//	container.Singleton((*Worker)(nil), &QueueWorker{})
+//	container.AddHostedService((*Worker)(nil))
+func (n *Nasc) AddHostedService(abstractType interface{}) error {
+	if abstractType == nil {
+		return &InvalidBindingError{Reason: "hosted service type cannot be nil"}
+	}
+
+	n.hosted.mu.Lock()
+	defer n.hosted.mu.Unlock()
+	n.hosted.types = append(n.hosted.types, typeOfToken(abstractType))
+	return nil
+}
+
+// StartHostedServices resolves the hosted services in the order they were
+// added and starts each on its own goroutine. If one cannot be resolved,
+// the services already started are stopped and the error is returned.
+// App starts hosted services once providers have booted.
+//
+// A Start call that fails while the service is running is reported through
+// the container's error sink (see Errors) and on HostedServiceErrors.
+func (n *Nasc) StartHostedServices(ctx context.Context) error {
+	n.hosted.mu.Lock()
+	if len(n.hosted.running) > 0 {
+		n.hosted.mu.Unlock()
+		return fmt.Errorf("hosted services are already running")
+	}
+	types := append([]reflect.Type(nil), n.hosted.types...)
+	n.hosted.stopping = false
+	if n.hosted.failures == nil {
+		n.hosted.failures = make(chan error, 1)
+	}
+	n.hosted.mu.Unlock()
+
+	for _, t := range types {
+		end := n.trace(TimelineHostedStart, t.String())
+		instance, err := n.MakeSafe(reflect.Zero(reflect.PointerTo(t)).Interface())
+		if err == nil {
+			if _, ok := instance.(HostedService); !ok {
+				err = fmt.Errorf("%T does not implement HostedService", instance)
+			}
+		}
+		if err != nil {
+			err = fmt.Errorf("hosted service %v: %w", t, err)
+			end(err)
+			return errors.Join(err, n.StopHostedServices(ctx))
+		}
+		n.startHostedService(ctx, t, instance.(HostedService))
+		end(nil)
+	}
+	return nil
+}
+
+// startHostedService launches one hosted service's Start goroutine.
+func (n *Nasc) startHostedService(ctx context.Context, t reflect.Type, service HostedService) {
+	runCtx, cancel := context.WithCancel(ctx)
+	running := &runningService{abstractT: t, service: service, cancel: cancel, done: make(chan struct{})}
+
+	n.hosted.mu.Lock()
+	n.hosted.running = append(n.hosted.running, running)
+	n.hosted.mu.Unlock()
+
+	go func() {
+		defer close(running.done)
+		err := service.Start(runCtx)
+		if err == nil {
+			return
+		}
+
+		n.hosted.mu.Lock()
+		stopping := n.hosted.stopping
+		n.hosted.mu.Unlock()
+		if stopping {
+			return
+		}
+		err = fmt.Errorf("hosted service %v: %w", t, err)
+		n.reportError("hosted service", t, err)
+		select {
+		case n.hosted.failures <- err:
+		default:
+			// A failure is already waiting to be received
+		}
+	}()
+}
+
+// HostedServiceErrors returns a channel receiving the error of a hosted
+// service whose Start fails while running. Only one failure is buffered;
+// App.Run uses it to shut down when a hosted service fails.
+func (n *Nasc) HostedServiceErrors() <-chan error {
+	n.hosted.mu.Lock()
+	defer n.hosted.mu.Unlock()
+	if n.hosted.failures == nil {
+		n.hosted.failures = make(chan error, 1)
+	}
+	return n.hosted.failures
+}
+
+// StopHostedServices stops the running hosted services in reverse start
+// order: Stop is called, the service's context is canceled and its Start
+// goroutine is awaited, bounded by ctx. Shutdown calls it before stopping
+// anything else.
+func (n *Nasc) StopHostedServices(ctx context.Context) error {
+	_, err := n.stopHostedServices(ctx)
+	return err
+}
+
+// stopHostedServices stops the running hosted services and returns them,
+// so Shutdown does not stop them again.
+func (n *Nasc) stopHostedServices(ctx context.Context) ([]interface{}, error) {
+	n.hosted.mu.Lock()
+	running := n.hosted.running
+	n.hosted.running = nil
+	n.hosted.stopping = true
+	n.hosted.mu.Unlock()
+
+	var errs []error
+	stopped := make([]interface{}, 0, len(running))
+	for i := len(running) - 1; i >= 0; i-- {
+		r := running[i]
+		stopped = append(stopped, r.service)
+		end := n.trace(TimelineHostedStop, r.abstractT.String())
+		var serviceErrs []error
+		if err := r.service.Stop(ctx); err != nil {
+			serviceErrs = append(serviceErrs, fmt.Errorf("stop error for hosted service %v: %w", r.abstractT, err))
+		}
+		r.cancel()
+		select {
+		case <-r.done:
+		case <-ctx.Done():
+			serviceErrs = append(serviceErrs, fmt.Errorf("hosted service %v did not finish: %w", r.abstractT, ctx.Err()))
+		}
+		end(errors.Join(serviceErrs...))
+		errs = append(errs, serviceErrs...)
+	}
+	return stopped, errors.Join(errs...)
+}
+
+// containsInstance reports whether instances holds instance itself.
+func containsInstance(instances []interface{}, instance interface{}) bool {
+	if !reflect.TypeOf(instance).Comparable() {
+		return false
+	}
+	for _, other := range instances {
+		if reflect.TypeOf(other) == reflect.TypeOf(instance) && other == instance {
+			return true
+		}
+	}
+	return false
+}
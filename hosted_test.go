@@ -0,0 +1,130 @@
+package nasc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type Worker interface {
+	HostedService
+}
+
+type queueWorker struct {
+	mu      sync.Mutex
+	events  []string
+	fail    error
+	started chan struct{}
+}
+
+func newQueueWorker() *queueWorker {
+	return &queueWorker{started: make(chan struct{})}
+}
+
+func (w *queueWorker) record(event string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.events = append(w.events, event)
+}
+
+func (w *queueWorker) Events() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return strings.Join(w.events, ",")
+}
+
+func (w *queueWorker) Start(ctx context.Context) error {
+	w.record("start")
+	close(w.started)
+	if w.fail != nil {
+		return w.fail
+	}
+	<-ctx.Done()
+	w.record("done")
+	return nil
+}
+
+func (w *queueWorker) Stop(ctx context.Context) error {
+	w.record("stop")
+	return nil
+}
+
+func TestHostedService_StartAndShutdown(t *testing.T) {
+	container := New()
+	worker := newQueueWorker()
+	_ = container.BindInstanceAs(worker, (*Worker)(nil))
+	if err := container.AddHostedService((*Worker)(nil)); err != nil {
+		t.Fatalf("AddHostedService() error = %v", err)
+	}
+
+	if err := container.StartHostedServices(context.Background()); err != nil {
+		t.Fatalf("StartHostedServices() error = %v", err)
+	}
+	<-worker.started
+	if err := container.StartHostedServices(context.Background()); err == nil {
+		t.Error("starting running hosted services should fail")
+	}
+
+	if err := container.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+	if got := worker.Events(); got != "start,stop,done" {
+		t.Errorf("events = %s, want start,stop,done with one stop", got)
+	}
+}
+
+func TestHostedService_Failure(t *testing.T) {
+	container := New()
+	worker := newQueueWorker()
+	worker.fail = errors.New("broker unreachable")
+	_ = container.BindInstanceAs(worker, (*Worker)(nil))
+	_ = container.AddHostedService((*Worker)(nil))
+
+	if err := container.StartHostedServices(context.Background()); err != nil {
+		t.Fatalf("StartHostedServices() error = %v", err)
+	}
+	select {
+	case err := <-container.HostedServiceErrors():
+		if !strings.Contains(err.Error(), "broker unreachable") {
+			t.Errorf("failure = %v, want the Start error", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("a failing hosted service should be reported")
+	}
+}
+
+func TestHostedService_Unresolvable(t *testing.T) {
+	container := New()
+	_ = container.BindInstanceAs(&ConsoleLogger{}, (*Logger)(nil))
+	_ = container.AddHostedService((*Logger)(nil))
+
+	err := container.StartHostedServices(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "does not implement HostedService") {
+		t.Errorf("StartHostedServices() error = %v", err)
+	}
+	if err := container.AddHostedService(nil); err == nil {
+		t.Error("a nil hosted service type should fail")
+	}
+}
+
+func TestApp_HostedServiceFailure(t *testing.T) {
+	worker := newQueueWorker()
+	worker.fail = errors.New("broker unreachable")
+	app := NewApp()
+	_ = app.Container().BindInstanceAs(worker, (*Worker)(nil))
+	_ = app.Container().AddHostedService((*Worker)(nil))
+
+	done := make(chan error)
+	go func() { done <- app.Run(context.Background()) }()
+	select {
+	case err := <-done:
+		if err == nil || !strings.Contains(err.Error(), "broker unreachable") {
+			t.Errorf("Run() error = %v, want the hosted service failure", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run should stop when a hosted service fails")
+	}
+}
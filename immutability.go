@@ -0,0 +1,293 @@
+package nasc
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"math"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+)
+
+// WithImmutabilityChecks records a shallow fingerprint of every singleton
+// when it is created, so CheckImmutability and WatchImmutability can flag
+// singletons that were mutated afterwards. Shared singletons are usually
+// meant to be stateless or configuration-only; unexpected writes to them
+// often point at data races in user services.
+//
+// Fingerprints are shallow: field values are compared directly, and
+// pointers, maps, slices and channels are compared by identity and length,
+// not by the data they refer to. Fields of sync and sync/atomic types are
+// ignored. Types listed in exempt are never fingerprinted, for singletons
+// that are stateful by design.
+//
+// This is a debugging aid: the checks read fields without synchronization,
+// so do not enable it together with the race detector in tests that
+// mutate singletons concurrently.
+//
+// Example:
+//
+//	container := nasc.New(nasc.WithImmutabilityChecks((*MetricsRegistry)(nil)))
+func WithImmutabilityChecks(exempt ...interface{}) Option {
+	return func(n *Nasc) error {
+		types := make(map[reflect.Type]bool, len(exempt))
+		for _, token := range exempt {
+			if token == nil {
+				return fmt.Errorf("exempt type cannot be nil")
+			}
+			types[typeOfToken(token)] = true
+		}
+		n.enableImmutabilityChecks(types)
+		return nil
+	}
+}
+
+// CheckImmutability compares every fingerprinted singleton with its
+// fingerprint and returns a *SingletonMutationError for each one that
+// changed, joined with errors.Join in type order. Mutated singletons are
+// fingerprinted again, so each change is reported once.
+//
+// Example:
+//
+//	if err := container.CheckImmutability(); err != nil {
+//	    log.Printf("singleton mutated: %v", err)
+//	}
+func (n *Nasc) CheckImmutability() error {
+	if n.immutability == nil {
+		return fmt.Errorf("immutability checks are not enabled (see WithImmutabilityChecks)")
+	}
+
+	mutations := n.immutability.check()
+	errs := make([]error, len(mutations))
+	for i, mutation := range mutations {
+		errs[i] = mutation
+	}
+	return errors.Join(errs...)
+}
+
+// WatchImmutability checks singletons every interval until ctx is done,
+// then checks once more, so mutations are also caught at shutdown. Each
+// mutation is delivered through Errors and WithOnError as a
+// *LifecycleError wrapping a *SingletonMutationError.
+//
+// Example:
+//
+//	go container.WatchImmutability(ctx, time.Minute)
+func (n *Nasc) WatchImmutability(ctx context.Context, interval time.Duration) error {
+	if n.immutability == nil {
+		return fmt.Errorf("immutability checks are not enabled (see WithImmutabilityChecks)")
+	}
+	if interval <= 0 {
+		return fmt.Errorf("watch interval must be positive, got %v", interval)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			n.reportMutations()
+			return nil
+		case <-ticker.C:
+			n.reportMutations()
+		}
+	}
+}
+
+// reportMutations delivers the current mutations as background errors.
+func (n *Nasc) reportMutations() {
+	for _, mutation := range n.immutability.check() {
+		n.reportError("immutability check", mutation.Type, mutation)
+	}
+}
+
+// enableImmutabilityChecks fingerprints singletons as the cache creates them.
+func (n *Nasc) enableImmutabilityChecks(exempt map[reflect.Type]bool) {
+	checker := &immutabilityChecker{
+		exempt: exempt,
+		prints: make(map[singletonKey]*instancePrint),
+	}
+	n.immutability = checker
+	n.singletonCache.onCreate = checker.record
+}
+
+// immutabilityChecker holds the fingerprints of created singletons.
+type immutabilityChecker struct {
+	mu     sync.Mutex
+	exempt map[reflect.Type]bool
+	prints map[singletonKey]*instancePrint
+}
+
+// instancePrint is a singleton and its fingerprint, field by field.
+type instancePrint struct {
+	instance interface{}
+	fields   []fieldPrint
+}
+
+// fieldPrint is the shallow hash of one field. Nested struct fields are
+// named with dotted paths.
+type fieldPrint struct {
+	name string
+	sum  uint64
+}
+
+// record fingerprints a newly created singleton.
+func (c *immutabilityChecker) record(key singletonKey, instance interface{}) {
+	if c.exempt[key.t] {
+		return
+	}
+
+	fields := fingerprint(instance)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.prints[key] = &instancePrint{instance: instance, fields: fields}
+}
+
+// check returns the singletons whose fingerprint changed, in type order,
+// and fingerprints them again.
+func (c *immutabilityChecker) check() []*SingletonMutationError {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	keys := make([]singletonKey, 0, len(c.prints))
+	for key := range c.prints {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return singletonKeyLess(keys[i], keys[j])
+	})
+
+	var mutations []*SingletonMutationError
+	for _, key := range keys {
+		recorded := c.prints[key]
+		current := fingerprint(recorded.instance)
+		if changed := mutatedFields(recorded.fields, current); len(changed) > 0 {
+			mutations = append(mutations, &SingletonMutationError{Type: key.t, Name: key.name, Fields: changed})
+			recorded.fields = current
+		}
+	}
+	return mutations
+}
+
+// mutatedFields returns the names of fields whose hashes differ.
+func mutatedFields(before, after []fieldPrint) []string {
+	var changed []string
+	for i := range before {
+		if before[i].sum != after[i].sum {
+			changed = append(changed, before[i].name)
+		}
+	}
+	return changed
+}
+
+// fingerprint hashes each field of a struct instance, or the whole value
+// for other instances.
+func fingerprint(instance interface{}) []fieldPrint {
+	v := reflect.ValueOf(instance)
+	if v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		h := fnv.New64a()
+		writeShallow(h, v)
+		return []fieldPrint{{name: "(value)", sum: h.Sum64()}}
+	}
+
+	var prints []fieldPrint
+	fingerprintFields(v, "", &prints)
+	return prints
+}
+
+// fingerprintFields appends the hash of each field of a struct value,
+// descending into nested struct values.
+func fingerprintFields(v reflect.Value, prefix string, prints *[]fieldPrint) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if isSyncType(field.Type) {
+			continue
+		}
+
+		name := prefix + field.Name
+		if field.Type.Kind() == reflect.Struct {
+			fingerprintFields(v.Field(i), name+".", prints)
+			continue
+		}
+
+		h := fnv.New64a()
+		writeShallow(h, v.Field(i))
+		*prints = append(*prints, fieldPrint{name: name, sum: h.Sum64()})
+	}
+}
+
+// isSyncType reports whether t is a synchronization type, whose state
+// changes under normal concurrent use.
+func isSyncType(t reflect.Type) bool {
+	return t.PkgPath() == "sync" || t.PkgPath() == "sync/atomic"
+}
+
+// writeShallow writes a value to h without following references.
+// Unexported fields are read through Int, String, Pointer and friends,
+// which reflect permits.
+func writeShallow(h hash.Hash64, v reflect.Value) {
+	var buf [8]byte
+	writeUint := func(u uint64) {
+		binary.LittleEndian.PutUint64(buf[:], u)
+		h.Write(buf[:])
+	}
+
+	switch v.Kind() {
+	case reflect.Invalid:
+		writeUint(0)
+	case reflect.Bool:
+		if v.Bool() {
+			writeUint(1)
+		} else {
+			writeUint(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeUint(math.Float64bits(v.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		writeUint(math.Float64bits(real(c)))
+		writeUint(math.Float64bits(imag(c)))
+	case reflect.String:
+		h.Write([]byte(v.String()))
+	case reflect.Map:
+		writeUint(uint64(v.Pointer()))
+		writeUint(uint64(v.Len()))
+	case reflect.Slice:
+		writeUint(uint64(v.Pointer()))
+		writeUint(uint64(v.Len()))
+	case reflect.Ptr, reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		writeUint(uint64(v.Pointer()))
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			writeShallow(h, v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if !isSyncType(v.Type().Field(i).Type) {
+				writeShallow(h, v.Field(i))
+			}
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			writeUint(0)
+			return
+		}
+		h.Write([]byte(v.Elem().Type().String()))
+		writeShallow(h, v.Elem())
+	}
+}
@@ -0,0 +1,125 @@
+package nasc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+type frozenConfig struct {
+	Name    string
+	Limits  struct{ Max int }
+	Tags    []string
+	mu      sync.Mutex
+	counter int
+}
+
+type metricsRegistry struct {
+	hits int
+}
+
+func TestImmutability_DetectsMutation(t *testing.T) {
+	container := New(WithImmutabilityChecks())
+	_ = container.Singleton((*frozenConfig)(nil), &frozenConfig{})
+
+	cfg := container.Make((*frozenConfig)(nil)).(*frozenConfig)
+	if err := container.CheckImmutability(); err != nil {
+		t.Fatalf("unmodified singleton reported: %v", err)
+	}
+
+	cfg.Name = "changed"
+	cfg.Limits.Max = 10
+
+	err := container.CheckImmutability()
+	var mutation *SingletonMutationError
+	if !errors.As(err, &mutation) {
+		t.Fatalf("expected SingletonMutationError, got %v", err)
+	}
+	if len(mutation.Fields) != 2 || mutation.Fields[0] != "Name" || mutation.Fields[1] != "Limits.Max" {
+		t.Errorf("changed fields = %v, want [Name Limits.Max]", mutation.Fields)
+	}
+
+	if err := container.CheckImmutability(); err != nil {
+		t.Errorf("a mutation should be reported once, got %v", err)
+	}
+}
+
+func TestImmutability_ShallowAndSyncFields(t *testing.T) {
+	container := New(WithImmutabilityChecks())
+	_ = container.Singleton((*frozenConfig)(nil), &frozenConfig{})
+	cfg := container.Make((*frozenConfig)(nil)).(*frozenConfig)
+
+	cfg.Tags = append(cfg.Tags, "a")
+	cfg.mu.Lock()
+	defer cfg.mu.Unlock()
+
+	err := container.CheckImmutability()
+	var mutation *SingletonMutationError
+	if !errors.As(err, &mutation) || len(mutation.Fields) != 1 || mutation.Fields[0] != "Tags" {
+		t.Errorf("expected only Tags to change, got %v", err)
+	}
+
+	cfg.counter++
+	if err := container.CheckImmutability(); err == nil {
+		t.Error("unexported field changes should be detected")
+	}
+}
+
+func TestImmutability_Exempt(t *testing.T) {
+	container := New(WithImmutabilityChecks((*metricsRegistry)(nil)))
+	_ = container.Singleton((*metricsRegistry)(nil), &metricsRegistry{})
+
+	container.Make((*metricsRegistry)(nil)).(*metricsRegistry).hits++
+	if err := container.CheckImmutability(); err != nil {
+		t.Errorf("exempt singleton reported: %v", err)
+	}
+}
+
+func TestImmutability_NotEnabled(t *testing.T) {
+	container := New()
+	if err := container.CheckImmutability(); err == nil {
+		t.Error("expected error when checks are not enabled")
+	}
+	if err := container.WatchImmutability(context.Background(), time.Second); err == nil {
+		t.Error("expected error when checks are not enabled")
+	}
+}
+
+func TestImmutability_WatchReportsAtShutdown(t *testing.T) {
+	reported := make(chan error, 1)
+	container := New(WithImmutabilityChecks(), WithOnError(func(err error) {
+		reported <- err
+	}))
+	_ = container.Singleton((*frozenConfig)(nil), &frozenConfig{})
+	container.Make((*frozenConfig)(nil)).(*frozenConfig).Name = "changed"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := container.WatchImmutability(ctx, time.Hour); err != nil {
+		t.Fatalf("WatchImmutability failed: %v", err)
+	}
+
+	select {
+	case err := <-reported:
+		var lifecycle *LifecycleError
+		var mutation *SingletonMutationError
+		if !errors.As(err, &lifecycle) || !errors.As(err, &mutation) {
+			t.Errorf("expected LifecycleError wrapping SingletonMutationError, got %v", err)
+		}
+	default:
+		t.Error("expected the final check to report the mutation")
+	}
+}
+
+func TestImmutability_Clone(t *testing.T) {
+	base := New(WithImmutabilityChecks())
+	_ = base.Singleton((*frozenConfig)(nil), &frozenConfig{})
+
+	clone := base.Clone()
+	clone.Make((*frozenConfig)(nil)).(*frozenConfig).Name = "changed"
+	if err := clone.CheckImmutability(); err == nil {
+		t.Error("clone should keep immutability checks")
+	}
+}
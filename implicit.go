@@ -0,0 +1,71 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// WithImplicitBinding resolves an interface that has no binding from the
+// one registered concrete type implementing it. The instance comes from
+// that type's own binding, so its lifetime applies: an implicitly resolved
+// singleton is the same instance Make returns for the binding itself.
+//
+// Implicit resolution is consulted before fallback resolvers (see
+// WithFallbackResolver). When several registered types implement the
+// interface the resolution fails, naming them.
+//
+// Example:
+//
+//	container := nasc.New(nasc.WithImplicitBinding())
+//	container.Singleton((*UserStore)(nil), &PostgresUserStore{})
+//
+//	// PostgresUserStore also implements UserReader, which was never bound
+//	reader := container.Make((*UserReader)(nil)).(UserReader)
+func WithImplicitBinding() Option {
+	return func(n *Nasc) error {
+		n.implicitBinding = true
+		return nil
+	}
+}
+
+// implicitBindingFor finds the binding whose concrete type implements the
+// unbound interface t. It reports false if implicit binding is disabled or
+// no registered type implements t, and an error if several do. Default
+// bindings are preferred over named ones of the same concrete type.
+func (n *Nasc) implicitBindingFor(t reflect.Type) (*registry.Binding, bool, error) {
+	if !n.implicitBinding || t.Kind() != reflect.Interface {
+		return nil, false, nil
+	}
+
+	var found *registry.Binding
+	for _, binding := range n.sortedBindings() {
+		concreteT := binding.ConcreteType
+		if concreteT == nil || concreteT.Kind() == reflect.Interface || isTagBindingName(binding.Name) {
+			continue
+		}
+		if !concreteT.Implements(t) {
+			continue
+		}
+		switch {
+		case found == nil:
+			found = binding
+		case found.ConcreteType != concreteT:
+			return nil, true, fmt.Errorf("no binding for %v and it is implemented by both %v and %v", t, found.ConcreteType, concreteT)
+		case found.Name != "" && binding.Name == "":
+			found = binding
+		}
+	}
+	return found, found != nil, nil
+}
+
+// makeImplicit resolves an implicit binding through its own abstract type
+// and name, with the Make and MakeNamed of the container or a scope.
+func makeImplicit(binding *registry.Binding, makeDefault func(interface{}) interface{}, makeNamed func(interface{}, string) interface{}) interface{} {
+	token := reflect.Zero(reflect.PointerTo(binding.AbstractType)).Interface()
+	if binding.Name != "" {
+		return makeNamed(token, binding.Name)
+	}
+	return makeDefault(token)
+}
@@ -0,0 +1,63 @@
+package nasc
+
+import (
+	"strings"
+	"testing"
+)
+
+// Connector is implemented by MockDB but never bound directly.
+type Connector interface {
+	Connect() error
+}
+
+func TestWithImplicitBinding(t *testing.T) {
+	c := New(WithImplicitBinding())
+	_ = c.Singleton((*Database)(nil), &MockDB{})
+
+	reader, ok := c.Make((*Connector)(nil)).(*MockDB)
+	if !ok {
+		t.Fatal("Make(Connector) did not resolve the implementing binding")
+	}
+	if reader != c.Make((*Database)(nil)) {
+		t.Error("implicit resolution did not reuse the binding's singleton")
+	}
+
+	if _, err := c.MakeSafe((*Connector)(nil)); err != nil {
+		t.Errorf("MakeSafe() error = %v", err)
+	}
+}
+
+func TestWithImplicitBinding_Scope(t *testing.T) {
+	c := New(WithImplicitBinding())
+	_ = c.Scoped((*Database)(nil), &MockDB{})
+
+	scope := c.CreateScope()
+	defer scope.Dispose()
+
+	if scope.Make((*Connector)(nil)) != scope.Make((*Database)(nil)) {
+		t.Error("implicit resolution in a scope did not reuse the scoped instance")
+	}
+}
+
+func TestWithImplicitBinding_Ambiguous(t *testing.T) {
+	c := New(WithImplicitBinding())
+	_ = c.Bind((*Logger)(nil), &ConsoleLogger{})
+	_ = c.BindNamed((*Logger)(nil), &FileLogger{}, "file")
+
+	type logSink interface {
+		Log(message string)
+	}
+	_, err := c.MakeSafe((*logSink)(nil))
+	if err == nil || !strings.Contains(err.Error(), "implemented by both") {
+		t.Errorf("MakeSafe() error = %v, want an ambiguity error", err)
+	}
+}
+
+func TestWithImplicitBinding_Disabled(t *testing.T) {
+	c := New()
+	_ = c.Bind((*Database)(nil), &MockDB{})
+
+	if _, err := c.MakeSafe((*Connector)(nil)); err == nil {
+		t.Error("MakeSafe() should fail without WithImplicitBinding")
+	}
+}
@@ -0,0 +1,94 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// In marks a parameter object. A constructor parameter whose struct type
+// embeds In is not resolved as one dependency; instead each exported field
+// is resolved on its own, like a constructor parameter, honoring `inject` tags:
+//   - `inject:"name=foo"` - uses named binding
+//   - `inject:"optional"` - leaves the field zero if it cannot be resolved
+//   - `inject:"-"` - leaves the field alone
+//
+// This keeps constructors with many dependencies readable.
+//
+// Example:
+//
+//	type ReportParams struct {
+//	    nasc.In
+//
+//	    DB     Database
+//	    Cache  Cache  `inject:"optional"`
+//	    Mailer Mailer `inject:"name=smtp"`
+//	}
+//
+//	func NewReportService(p ReportParams) *ReportService { ... }
+type In struct{}
+
+var inType = reflect.TypeOf(In{})
+
+// isInStruct reports whether t is a struct embedding In.
+func isInStruct(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Type == inType {
+			return true
+		}
+	}
+	return false
+}
+
+// buildIn resolves every field of a parameter object of type t with r.
+func (n *Nasc) buildIn(t reflect.Type, r resolver) (reflect.Value, error) {
+	params := reflect.New(t).Elem()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Type == inType || field.PkgPath != "" {
+			continue
+		}
+
+		opts := parseInjectTag(field.Tag.Get("inject"))
+		if opts.skip {
+			continue
+		}
+
+		value, err := n.resolveInField(field.Type, opts, r)
+		if err != nil {
+			if opts.optional {
+				continue
+			}
+			return reflect.Value{}, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		params.Field(i).Set(value)
+	}
+
+	return params, nil
+}
+
+// resolveInField resolves one field of a parameter object with r, like a
+// constructor parameter: a nil result leaves the field zero.
+func (n *Nasc) resolveInField(t reflect.Type, opts tagOptions, r resolver) (value reflect.Value, err error) {
+	if opts.name == "" {
+		if value, ok, err := n.resolveInjectable(t, r); ok {
+			return value, err
+		}
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("resolution panicked: %v", p)
+		}
+	}()
+
+	token := reflect.Zero(reflect.PointerTo(t)).Interface()
+	if opts.name != "" {
+		return paramValue(r.MakeNamed(token, opts.name), t)
+	}
+	return paramValue(r.Make(token), t)
+}
@@ -0,0 +1,117 @@
+package nasc
+
+import "testing"
+
+type reportParams struct {
+	In
+
+	Logger   Logger
+	Database Database            `inject:"optional"`
+	Notifier NotificationService `inject:"name=sms"`
+	Loggers  []Logger
+	Ignored  Logger `inject:"-"`
+}
+
+type paramService struct {
+	params reportParams
+}
+
+func newParamService(p reportParams) *paramService {
+	return &paramService{params: p}
+}
+
+func TestIn_ResolvesFields(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+	_ = container.BindNamed((*NotificationService)(nil), &SMSNotifier{}, "sms")
+	_ = container.BindConstructor((*paramService)(nil), newParamService)
+
+	svc, err := container.MakeSafe((*paramService)(nil))
+	if err != nil {
+		t.Fatalf("MakeSafe failed: %v", err)
+	}
+
+	p := svc.(*paramService).params
+	if p.Logger == nil {
+		t.Error("Logger should be resolved")
+	}
+	if p.Database != nil {
+		t.Error("optional unbound Database should be left nil")
+	}
+	if _, ok := p.Notifier.(*SMSNotifier); !ok {
+		t.Error("named field should use the named binding")
+	}
+	if len(p.Loggers) != 1 {
+		t.Errorf("slice field should hold all loggers, got %d", len(p.Loggers))
+	}
+	if p.Ignored != nil {
+		t.Error(`inject:"-" field should be skipped`)
+	}
+}
+
+func TestIn_MissingRequiredField(t *testing.T) {
+	container := New()
+	_ = container.BindConstructor((*paramService)(nil), newParamService)
+
+	if _, err := container.MakeSafe((*paramService)(nil)); err == nil {
+		t.Error("expected error for unbound required field")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected Make to panic for unbound required field")
+		}
+	}()
+	container.Make((*paramService)(nil))
+}
+
+type configParams struct {
+	In
+
+	Config  *appConfig
+	Replica Database `inject:"name=replica"`
+}
+
+func TestIn_ConcreteAndNilFields(t *testing.T) {
+	container := New()
+	_ = container.Factory((**appConfig)(nil), func(*Nasc) (interface{}, error) {
+		return nil, nil
+	})
+	_ = container.BindNamed((*Database)(nil), &ReplicaDB{}, "replica")
+
+	var got configParams
+	_ = container.BindConstructor((*paramService)(nil), func(p configParams) *paramService {
+		got = p
+		return &paramService{}
+	})
+
+	if _, err := container.MakeSafe((*paramService)(nil)); err != nil {
+		t.Fatalf("MakeSafe failed: %v", err)
+	}
+	if got.Config != nil {
+		t.Errorf("Config = %v, want nil from the factory", got.Config)
+	}
+}
+
+func TestIn_ScopedNamedField(t *testing.T) {
+	container := New()
+	_ = container.BindInstanceAs(&appConfig{DSN: "postgres://"}, (**appConfig)(nil))
+	_ = As[Database, ReplicaDB](container, WithName("replica"), WithLifetime(LifetimeScoped))
+
+	var got configParams
+	_ = container.ScopedConstructor((*paramService)(nil), func(p configParams) *paramService {
+		got = p
+		return &paramService{}
+	})
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+	scope.Make((*paramService)(nil))
+
+	if got.Config == nil || got.Config.DSN != "postgres://" {
+		t.Errorf("Config = %v, want the bound instance", got.Config)
+	}
+	if got.Replica != scope.MakeNamed((*Database)(nil), "replica") {
+		t.Error("named scoped field should be resolved from the scope")
+	}
+}
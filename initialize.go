@@ -0,0 +1,45 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// InitializableWithContainer is a variant of Initializable for services
+// whose initialization needs the container, for example to resolve
+// optional collaborators or register cleanup. A type implements at most
+// one of the two, since both declare Initialize.
+//
+// Example:
+//
+//	func (s *Service) Initialize(c *nasc.Nasc) error {
+//	    if metrics, err := c.MakeSafe((*Metrics)(nil)); err == nil {
+//	        s.metrics = metrics.(Metrics)
+//	    }
+//	    return nil
+//	}
+type InitializableWithContainer interface {
+	Initialize(c *Nasc) error
+}
+
+// initialize runs the post-construct hook of a newly created instance.
+// It is called once per instance on every creation path and lifetime,
+// after constructor injection and auto-wiring. Instances returned by
+// factory functions are not initialized: a factory may hand out an
+// existing instance, and it can initialize what it creates itself.
+func (n *Nasc) initialize(instance interface{}) error {
+	switch i := instance.(type) {
+	case InitializableWithContainer:
+		return i.Initialize(n)
+	case Initializable:
+		return i.Initialize()
+	}
+	return nil
+}
+
+// mustInitialize initializes an instance, panicking on failure as Make does.
+func (n *Nasc) mustInitialize(instance interface{}, abstractT reflect.Type) {
+	if err := n.initialize(instance); err != nil {
+		panic(fmt.Sprintf("failed to initialize instance of type %v: %v", abstractT, err))
+	}
+}
@@ -0,0 +1,124 @@
+package nasc
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type Initialized interface {
+	Inits() int
+}
+
+type countingService struct {
+	inits int
+}
+
+func (s *countingService) Inits() int { return s.inits }
+func (s *countingService) Initialize() error {
+	s.inits++
+	return nil
+}
+
+type containerAwareService struct {
+	logger Logger
+}
+
+func (s *containerAwareService) Inits() int { return 1 }
+func (s *containerAwareService) Initialize(c *Nasc) error {
+	s.logger = c.Make((*Logger)(nil)).(Logger)
+	return nil
+}
+
+type failingInitService struct{ inits int }
+
+func (s *failingInitService) Inits() int        { return s.inits }
+func (s *failingInitService) Initialize() error { return errors.New("not ready") }
+
+func TestInitialize_AllLifetimes(t *testing.T) {
+	tests := map[string]func(c *Nasc) error{
+		"transient": func(c *Nasc) error { return c.Bind((*Initialized)(nil), &countingService{}) },
+		"singleton": func(c *Nasc) error { return c.Singleton((*Initialized)(nil), &countingService{}) },
+		"transient constructor": func(c *Nasc) error {
+			return c.BindConstructor((*Initialized)(nil), func() *countingService { return &countingService{} })
+		},
+		"singleton constructor": func(c *Nasc) error {
+			return c.SingletonConstructor((*Initialized)(nil), func() *countingService { return &countingService{} })
+		},
+		"autowired": func(c *Nasc) error { return c.BindAutoWire((*Initialized)(nil), &countingService{}) },
+		"cached":    func(c *Nasc) error { return c.Cached((*Initialized)(nil), &countingService{}, time.Minute) },
+	}
+
+	for name, register := range tests {
+		t.Run(name, func(t *testing.T) {
+			container := New()
+			if err := register(container); err != nil {
+				t.Fatalf("register failed: %v", err)
+			}
+
+			if got := container.Make((*Initialized)(nil)).(Initialized).Inits(); got != 1 {
+				t.Errorf("Make: Initialize ran %d times, want 1", got)
+			}
+			instance, err := container.MakeSafe((*Initialized)(nil))
+			if err != nil {
+				t.Fatalf("MakeSafe failed: %v", err)
+			}
+			if got := instance.(Initialized).Inits(); got != 1 {
+				t.Errorf("MakeSafe: Initialize ran %d times, want 1", got)
+			}
+		})
+	}
+}
+
+func TestInitialize_FactoriesAreNotInitialized(t *testing.T) {
+	container := New()
+	shared := &countingService{}
+	_ = container.BindInstanceAs(shared, (*Initialized)(nil))
+
+	container.Make((*Initialized)(nil))
+	container.Make((*Initialized)(nil))
+	if shared.inits != 0 {
+		t.Errorf("factory results should not be initialized, ran %d times", shared.inits)
+	}
+}
+
+func TestInitialize_WithContainer(t *testing.T) {
+	container := New()
+	_ = container.Singleton((*Logger)(nil), &ConsoleLogger{})
+	_ = container.Scoped((*Initialized)(nil), &containerAwareService{})
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+
+	svc := scope.Make((*Initialized)(nil)).(*containerAwareService)
+	if svc.logger == nil {
+		t.Error("InitializableWithContainer should receive the container")
+	}
+}
+
+func TestInitialize_Failure(t *testing.T) {
+	container := New()
+	_ = container.Singleton((*Initialized)(nil), &failingInitService{})
+
+	_, err := container.MakeSafe((*Initialized)(nil))
+	if err == nil || !strings.Contains(err.Error(), "not ready") {
+		t.Errorf("expected initialization error, got %v", err)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Make should panic when Initialize fails")
+		}
+	}()
+	container.Make((*Initialized)(nil))
+}
+
+func TestInitialize_SkippedDuringValidation(t *testing.T) {
+	container := New()
+	_ = container.Singleton((*Initialized)(nil), &failingInitService{})
+
+	if err := container.Validate(); err != nil {
+		t.Errorf("Validate should not run Initialize, got %v", err)
+	}
+}
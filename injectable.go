@@ -0,0 +1,173 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// tagBindingPrefix starts the generated names of tagged bindings.
+const tagBindingPrefix = "_tag_"
+
+// tagBindingName returns a name for a binding of abstractT tagged with tag,
+// derived from label, the registered concrete type or function. Repeated
+// labels get a numeric suffix, so names follow registration order and are
+// the same on every run.
+func (n *Nasc) tagBindingName(abstractT reflect.Type, tag, label string) string {
+	base := fmt.Sprintf("%s%s_%s", tagBindingPrefix, tag, label)
+	name := base
+	for i := 2; ; i++ {
+		if _, err := n.registry.GetNamed(abstractT, name); err != nil {
+			return name
+		}
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+}
+
+// isTagBindingName reports whether a binding name was generated by BindWithTags.
+func isTagBindingName(name string) bool {
+	return strings.HasPrefix(name, tagBindingPrefix)
+}
+
+// resolveInjectable builds values for parameter and field types that wrap
+// a dependency instead of being bound themselves:
+//   - *Lazy[T] resolves T on first Get
+//   - Optional[T] holds T, or nothing if T is not bound
+//   - structs embedding In have each field resolved
+//   - func() T and func() (T, error) resolve T on every call
+//   - []T receives every implementation of T, as returned by MakeAll
+//   - map[string]T receives the named bindings of T, keyed by name
+//
+// Dependencies are resolved with r, the container or the scope creating
+// the instance. It reports false for ordinary types and for types with
+// their own binding.
+func (n *Nasc) resolveInjectable(t reflect.Type, r resolver) (reflect.Value, bool, error) {
+	if t.Kind() == reflect.Ptr && t.Implements(lazyInjectableType) {
+		lazy := reflect.New(t.Elem())
+		lazy.Interface().(lazyInjectable).bind(r.Make)
+		return lazy, true, nil
+	}
+
+	if t.Kind() == reflect.Struct && reflect.PointerTo(t).Implements(optionalInjectableType) {
+		optional := reflect.New(t)
+		if err := optional.Interface().(optionalInjectable).fill(r.Make); err != nil {
+			return reflect.Value{}, true, err
+		}
+		return optional.Elem(), true, nil
+	}
+
+	if isInStruct(t) {
+		value, err := n.buildIn(t, r)
+		return value, true, err
+	}
+
+	if isProviderFunc(t) && !n.registry.Has(t) {
+		return providerFunc(t, r.Make), true, nil
+	}
+
+	if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Interface && !n.registry.Has(t) {
+		return allImplementations(t, r)
+	}
+
+	if isNamedMap(t) && !n.registry.Has(t) {
+		return n.namedImplementations(t, r)
+	}
+
+	return reflect.Value{}, false, nil
+}
+
+// isProviderFunc reports whether t is func() T or func() (T, error)
+// for an interface type T.
+func isProviderFunc(t reflect.Type) bool {
+	if t.Kind() != reflect.Func || t.NumIn() != 0 || t.IsVariadic() {
+		return false
+	}
+	switch t.NumOut() {
+	case 1:
+		return t.Out(0).Kind() == reflect.Interface
+	case 2:
+		return t.Out(0).Kind() == reflect.Interface && t.Out(1) == errorType
+	default:
+		return false
+	}
+}
+
+// providerFunc builds a function of type t that resolves a fresh instance
+// of its result type on every call.
+func providerFunc(t reflect.Type, resolve func(interface{}) interface{}) reflect.Value {
+	target := t.Out(0)
+	token := reflect.Zero(reflect.PointerTo(target)).Interface()
+	returnsError := t.NumOut() == 2
+
+	return reflect.MakeFunc(t, func([]reflect.Value) []reflect.Value {
+		if !returnsError {
+			return []reflect.Value{reflect.ValueOf(resolve(token)).Convert(target)}
+		}
+
+		var resolved interface{}
+		var err error
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					err = fmt.Errorf("failed to resolve %v: %v", target, r)
+				}
+			}()
+			resolved = resolve(token)
+		}()
+
+		if err != nil {
+			return []reflect.Value{reflect.Zero(target), reflect.ValueOf(&err).Elem()}
+		}
+		return []reflect.Value{reflect.ValueOf(resolved).Convert(target), reflect.Zero(errorType)}
+	})
+}
+
+// allImplementations resolves every binding of a slice's element type
+// with r into a slice of type t.
+func allImplementations(t reflect.Type, r resolver) (value reflect.Value, ok bool, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("failed to resolve %v: %v", t, p)
+		}
+	}()
+
+	token := reflect.Zero(reflect.PointerTo(t.Elem())).Interface()
+	instances := r.MakeAll(token)
+
+	slice := reflect.MakeSlice(t, 0, len(instances))
+	for _, instance := range instances {
+		slice = reflect.Append(slice, reflect.ValueOf(instance))
+	}
+	return slice, true, nil
+}
+
+// isNamedMap reports whether t is map[string]T for an interface type T.
+func isNamedMap(t reflect.Type) bool {
+	return t.Kind() == reflect.Map && t.Key().Kind() == reflect.String && t.Elem().Kind() == reflect.Interface
+}
+
+// namedImplementations resolves every named binding of a map's element
+// type with r into a map of type t keyed by binding name. Tagged bindings,
+// whose names are generated, are not included.
+func (n *Nasc) namedImplementations(t reflect.Type, r resolver) (value reflect.Value, ok bool, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("failed to resolve %v: %v", t, p)
+		}
+	}()
+
+	token := reflect.Zero(reflect.PointerTo(t.Elem())).Interface()
+	names := n.registry.GetAllNamedFor(t.Elem())
+
+	m := reflect.MakeMapWithSize(t, len(names))
+	for _, name := range names {
+		if isTagBindingName(name) {
+			continue
+		}
+		instance := r.MakeNamed(token, name)
+		m.SetMapIndex(reflect.ValueOf(name).Convert(t.Key()), reflect.ValueOf(instance))
+	}
+	return m, true, nil
+}
@@ -0,0 +1,180 @@
+package nasc
+
+import (
+	"fmt"
+	"testing"
+)
+
+type loggerFactoryService struct {
+	newLogger func() Logger
+}
+
+func TestProviderFunc_ConstructorParameter(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+	_ = container.SingletonConstructor((*loggerFactoryService)(nil), func(newLogger func() Logger) *loggerFactoryService {
+		return &loggerFactoryService{newLogger: newLogger}
+	})
+
+	svc := container.Make((*loggerFactoryService)(nil)).(*loggerFactoryService)
+	first, second := svc.newLogger(), svc.newLogger()
+	if first == nil || first == second {
+		t.Error("each call should resolve a fresh transient instance")
+	}
+}
+
+func TestProviderFunc_WithError(t *testing.T) {
+	container := New()
+	var newDB func() (Database, error)
+	_ = container.BindConstructor((*ConstructorService)(nil), func(f func() (Database, error)) *BasicConstructorService {
+		newDB = f
+		return &BasicConstructorService{}
+	})
+	container.Make((*ConstructorService)(nil))
+
+	if _, err := newDB(); err == nil {
+		t.Error("expected error for unbound dependency")
+	}
+
+	_ = container.Bind((*Database)(nil), &MockDB{})
+	if db, err := newDB(); err != nil || db == nil {
+		t.Errorf("newDB() = %v, %v", db, err)
+	}
+}
+
+func TestProviderFunc_AutoWireField(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	consumer := &struct {
+		NewLogger func() Logger `inject:""`
+	}{}
+	if err := container.AutoWire(consumer); err != nil {
+		t.Fatalf("AutoWire failed: %v", err)
+	}
+	if consumer.NewLogger() == nil {
+		t.Error("injected func should resolve the logger")
+	}
+}
+
+func TestIsProviderFunc(t *testing.T) {
+	if !isProviderFunc(typeOfToken((*func() Logger)(nil))) {
+		t.Fatal("func() Logger should be a provider func")
+	}
+	if isProviderFunc(typeOfToken((*func(int) Logger)(nil))) {
+		t.Error("funcs with parameters are not provider funcs")
+	}
+	if isProviderFunc(typeOfToken((*func() *ConsoleLogger)(nil))) {
+		t.Error("funcs returning concrete types are not provider funcs")
+	}
+}
+
+type notifierHub struct {
+	notifiers []NotificationService
+}
+
+func TestSliceParameter_AllImplementations(t *testing.T) {
+	container := New()
+	_ = container.BindNamed((*NotificationService)(nil), &SMSNotifier{}, "sms")
+	_ = container.BindWithTags((*NotificationService)(nil), &PushNotifier{}, []string{"mobile"})
+	_ = container.BindNamed((*NotificationService)(nil), &EmailNotifier{}, "email")
+	_ = container.Bind((*NotificationService)(nil), &EmailNotifier{})
+	_ = container.BindConstructor((*notifierHub)(nil), func(all []NotificationService) *notifierHub {
+		return &notifierHub{notifiers: all}
+	})
+
+	for i := 0; i < 5; i++ {
+		hub := container.Make((*notifierHub)(nil)).(*notifierHub)
+		if len(hub.notifiers) != 4 {
+			t.Fatalf("expected 4 notifiers, got %d", len(hub.notifiers))
+		}
+
+		// Default binding first, then registration order
+		want := []string{"*nasc.EmailNotifier", "*nasc.SMSNotifier", "*nasc.PushNotifier", "*nasc.EmailNotifier"}
+		for j, n := range hub.notifiers {
+			if got := fmt.Sprintf("%T", n); got != want[j] {
+				t.Errorf("notifier %d = %s, want %s", j, got, want[j])
+			}
+		}
+	}
+}
+
+func TestSliceParameter_AutoWireField(t *testing.T) {
+	container := New()
+	_ = container.BindNamed((*Logger)(nil), &ConsoleLogger{}, "console")
+	_ = container.BindNamed((*Logger)(nil), &FileLogger{}, "file")
+
+	consumer := &struct {
+		Loggers []Logger `inject:""`
+	}{}
+	if err := container.AutoWire(consumer); err != nil {
+		t.Fatalf("AutoWire failed: %v", err)
+	}
+	if len(consumer.Loggers) != 2 {
+		t.Errorf("expected 2 loggers, got %d", len(consumer.Loggers))
+	}
+}
+
+func TestSliceParameter_Empty(t *testing.T) {
+	container := New()
+	_ = container.BindConstructor((*notifierHub)(nil), func(all []NotificationService) *notifierHub {
+		return &notifierHub{notifiers: all}
+	})
+
+	hub, err := container.MakeSafe((*notifierHub)(nil))
+	if err != nil {
+		t.Fatalf("MakeSafe failed: %v", err)
+	}
+	if len(hub.(*notifierHub).notifiers) != 0 {
+		t.Error("expected an empty slice")
+	}
+}
+
+type notifierRouter struct {
+	byChannel map[string]NotificationService
+}
+
+func TestNamedMap_ConstructorParameter(t *testing.T) {
+	container := New()
+	_ = container.Bind((*NotificationService)(nil), &EmailNotifier{})
+	_ = container.BindNamed((*NotificationService)(nil), &EmailNotifier{}, "email")
+	_ = container.BindNamed((*NotificationService)(nil), &SMSNotifier{}, "sms")
+	_ = container.BindWithTags((*NotificationService)(nil), &PushNotifier{}, []string{"mobile"})
+	_ = container.BindConstructor((*notifierRouter)(nil), func(byChannel map[string]NotificationService) *notifierRouter {
+		return &notifierRouter{byChannel: byChannel}
+	})
+
+	router := container.Make((*notifierRouter)(nil)).(*notifierRouter)
+	if len(router.byChannel) != 2 {
+		t.Fatalf("expected only the 2 named bindings, got %v", router.byChannel)
+	}
+	if _, ok := router.byChannel["sms"].(*SMSNotifier); !ok {
+		t.Error("sms should map to SMSNotifier")
+	}
+	if _, ok := router.byChannel["email"].(*EmailNotifier); !ok {
+		t.Error("email should map to EmailNotifier")
+	}
+}
+
+func TestNamedMap_AutoWireField(t *testing.T) {
+	container := New()
+	_ = container.BindNamed((*Logger)(nil), &ConsoleLogger{}, "console")
+	_ = container.BindNamed((*Logger)(nil), &FileLogger{}, "file")
+
+	consumer := &struct {
+		Loggers map[string]Logger `inject:"map"`
+	}{}
+	if err := container.AutoWire(consumer); err != nil {
+		t.Fatalf("AutoWire failed: %v", err)
+	}
+	if _, ok := consumer.Loggers["file"].(*FileLogger); !ok || len(consumer.Loggers) != 2 {
+		t.Errorf("unexpected loggers %v", consumer.Loggers)
+	}
+
+	invalid := &struct {
+		Logger Logger `inject:"map"`
+	}{}
+	if err := container.AutoWire(invalid); err == nil {
+		t.Error("expected error for inject:\"map\" on a non-map field")
+	}
+}
@@ -0,0 +1,110 @@
+package nasc
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// BindingInfo is a read-only description of a registered binding.
+type BindingInfo struct {
+	// Type is the abstract type the binding is registered for
+	Type reflect.Type
+
+	// Name is the binding name; empty for default bindings
+	Name string
+
+	// Lifetime is the binding's lifetime strategy
+	Lifetime Lifetime
+
+	// Concrete is the implementation type; nil for factory bindings
+	Concrete reflect.Type
+
+	// Tags are the binding's tags, if any
+	Tags []string
+
+	// Origin is the module that registered the binding; empty for the application
+	Origin string
+
+	// Eager reports whether the singleton is created at boot (see Eager)
+	Eager bool
+
+	// Meta is the binding's key/value metadata, if any (see WithMeta)
+	Meta Metadata
+}
+
+// Metadata is key/value metadata attached to a binding (see WithMeta).
+type Metadata = registry.Metadata
+
+// describeBinding converts a registry binding into a BindingInfo.
+func describeBinding(b *registry.Binding) BindingInfo {
+	info := BindingInfo{
+		Type:     b.AbstractType,
+		Name:     b.Name,
+		Lifetime: Lifetime(b.Lifetime),
+		Concrete: b.ConcreteType,
+		Origin:   b.Origin,
+		Eager:    b.Eager,
+	}
+	if len(b.Tags) > 0 {
+		info.Tags = append([]string(nil), b.Tags...)
+	}
+	if len(b.Meta) > 0 {
+		info.Meta = make(Metadata, len(b.Meta))
+		for key, value := range b.Meta {
+			info.Meta[key] = value
+		}
+	}
+	return info
+}
+
+// Bindings returns descriptions of all registered bindings,
+// ordered by type string, then name. Tagged bindings follow the other
+// bindings of their type in registration order.
+func (n *Nasc) Bindings() []BindingInfo {
+	bindings := n.sortedBindings()
+	infos := make([]BindingInfo, len(bindings))
+	for i, b := range bindings {
+		infos[i] = describeBinding(b)
+	}
+	return infos
+}
+
+// SingletonInfo describes a created singleton (see Singletons).
+type SingletonInfo struct {
+	// Type is the abstract type the singleton was resolved for
+	Type reflect.Type
+
+	// Name is the binding name; empty for default bindings
+	Name string
+
+	// Instance is the cached instance
+	Instance interface{}
+}
+
+// Singletons returns the singletons created so far, ordered by type, then
+// name. Each named singleton has its own entry. Singletons still being
+// created are skipped.
+//
+// Example:
+//
+//	for _, s := range container.Singletons() {
+//	    fmt.Printf("%v %q -> %T\n", s.Type, s.Name, s.Instance)
+//	}
+func (n *Nasc) Singletons() []SingletonInfo {
+	created := n.singletonCache.created()
+	keys := make([]singletonKey, 0, len(created))
+	for key := range created {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return singletonKeyLess(keys[i], keys[j])
+	})
+
+	infos := make([]SingletonInfo, len(keys))
+	for i, key := range keys {
+		infos[i] = SingletonInfo{Type: key.t, Name: key.name, Instance: created[key]}
+	}
+	return infos
+}
@@ -0,0 +1,68 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// BindInstanceAs registers an existing instance under each of the given
+// abstract types, so one concrete value such as an *os.File or a
+// *bytes.Buffer can satisfy io.Reader, io.Writer and io.Closer at once.
+// Every resolution returns the same instance.
+//
+// The instance must implement every type; a type listed twice is bound
+// once. Registration is all-or-nothing: if any type is already bound,
+// nothing is registered.
+//
+// Example:
+//
+//	logFile, _ := os.Create("app.log")
+//	container.BindInstanceAs(logFile, (*io.Writer)(nil), (*io.Closer)(nil))
+func (n *Nasc) BindInstanceAs(instance interface{}, abstractTypes ...interface{}) error {
+	if instance == nil {
+		return &InvalidBindingError{Reason: "instance cannot be nil"}
+	}
+	if len(abstractTypes) == 0 {
+		return &InvalidBindingError{Reason: "at least one abstract type is required"}
+	}
+
+	instanceT := reflect.TypeOf(instance)
+	var factory FactoryFunc = func(*Nasc) (interface{}, error) {
+		return instance, nil
+	}
+
+	bindings := make([]*registry.Binding, 0, len(abstractTypes))
+	seen := make(map[reflect.Type]bool, len(abstractTypes))
+	for _, abstractType := range abstractTypes {
+		if abstractType == nil {
+			return &InvalidBindingError{Reason: "abstract type cannot be nil"}
+		}
+		abstractT := typeOfToken(abstractType)
+		if seen[abstractT] {
+			continue
+		}
+		seen[abstractT] = true
+		if !instanceT.AssignableTo(abstractT) {
+			return &InvalidBindingError{Reason: fmt.Sprintf("%v does not implement %v", instanceT, abstractT)}
+		}
+		if n.registry.Has(abstractT) {
+			return &BindingAlreadyExistsError{Type: abstractT}
+		}
+		bindings = append(bindings, &registry.Binding{
+			AbstractType: abstractT,
+			ConcreteType: instanceT,
+			Lifetime:     string(LifetimeFactory),
+			Factory:      factory,
+		})
+	}
+
+	for _, binding := range bindings {
+		if err := n.register(binding); err != nil {
+			return err
+		}
+		n.decorators.markInstance(binding)
+	}
+	return nil
+}
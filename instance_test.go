@@ -0,0 +1,54 @@
+package nasc
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestBindInstanceAs(t *testing.T) {
+	container := New()
+	buf := &bytes.Buffer{}
+	if err := container.BindInstanceAs(buf, (*io.Reader)(nil), (*io.Writer)(nil)); err != nil {
+		t.Fatalf("BindInstanceAs failed: %v", err)
+	}
+
+	container.Make((*io.Writer)(nil)).(io.Writer).Write([]byte("hello"))
+	data, _ := io.ReadAll(container.Make((*io.Reader)(nil)).(io.Reader))
+	if string(data) != "hello" {
+		t.Errorf("reader and writer should share the buffer, read %q", data)
+	}
+}
+
+func TestBindInstanceAs_Errors(t *testing.T) {
+	container := New()
+	_ = container.BindInstanceAs(&bytes.Buffer{}, (*io.Writer)(nil))
+
+	var invalid *InvalidBindingError
+	if err := container.BindInstanceAs(&bytes.Buffer{}); !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidBindingError without types, got %v", err)
+	}
+	if err := container.BindInstanceAs(&bytes.Buffer{}, (*io.Closer)(nil)); !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidBindingError for unimplemented type, got %v", err)
+	}
+
+	var exists *BindingAlreadyExistsError
+	if err := container.BindInstanceAs(&bytes.Buffer{}, (*io.Reader)(nil), (*io.Writer)(nil)); !errors.As(err, &exists) {
+		t.Errorf("expected BindingAlreadyExistsError, got %v", err)
+	}
+	if _, err := container.MakeSafe((*io.Reader)(nil)); err == nil {
+		t.Error("failed registration should not bind any type")
+	}
+}
+
+func TestBindInstanceAs_RepeatedType(t *testing.T) {
+	container := New()
+	buf := &bytes.Buffer{}
+	if err := container.BindInstanceAs(buf, (*io.Writer)(nil), (*io.Reader)(nil), (*io.Writer)(nil)); err != nil {
+		t.Fatalf("BindInstanceAs failed: %v", err)
+	}
+	if container.Make((*io.Writer)(nil)) != buf || container.Make((*io.Reader)(nil)) != buf {
+		t.Error("every listed type should resolve to the instance")
+	}
+}
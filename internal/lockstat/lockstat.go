@@ -0,0 +1,89 @@
+// Package lockstat provides a read/write mutex with optional contention counters.
+package lockstat
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Stats is a point-in-time copy of a Counter.
+type Stats struct {
+	// Acquisitions is the number of times the lock was acquired
+	Acquisitions uint64
+
+	// Contended is the number of acquisitions that had to wait
+	Contended uint64
+
+	// Wait is the total time spent waiting for contended acquisitions
+	Wait time.Duration
+}
+
+// Counter accumulates lock statistics. It may be shared by several mutexes.
+type Counter struct {
+	acquisitions atomic.Uint64
+	contended    atomic.Uint64
+	waitNanos    atomic.Int64
+}
+
+// Stats returns the current counts.
+func (c *Counter) Stats() Stats {
+	return Stats{
+		Acquisitions: c.acquisitions.Load(),
+		Contended:    c.contended.Load(),
+		Wait:         time.Duration(c.waitNanos.Load()),
+	}
+}
+
+// record counts one acquisition.
+func (c *Counter) record(waited time.Duration, contended bool) {
+	c.acquisitions.Add(1)
+	if contended {
+		c.contended.Add(1)
+		c.waitNanos.Add(int64(waited))
+	}
+}
+
+// RWMutex is a sync.RWMutex that reports to a Counter once observed.
+// Unobserved, it costs one atomic load per acquisition.
+type RWMutex struct {
+	sync.RWMutex
+	counter atomic.Pointer[Counter]
+}
+
+// Observe starts recording acquisitions into c.
+func (m *RWMutex) Observe(c *Counter) {
+	m.counter.Store(c)
+}
+
+// Lock acquires the write lock.
+func (m *RWMutex) Lock() {
+	c := m.counter.Load()
+	if c == nil {
+		m.RWMutex.Lock()
+		return
+	}
+	if m.RWMutex.TryLock() {
+		c.record(0, false)
+		return
+	}
+	start := time.Now()
+	m.RWMutex.Lock()
+	c.record(time.Since(start), true)
+}
+
+// RLock acquires the read lock.
+func (m *RWMutex) RLock() {
+	c := m.counter.Load()
+	if c == nil {
+		m.RWMutex.RLock()
+		return
+	}
+	if m.RWMutex.TryRLock() {
+		c.record(0, false)
+		return
+	}
+	start := time.Now()
+	m.RWMutex.RLock()
+	c.record(time.Since(start), true)
+}
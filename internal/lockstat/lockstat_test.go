@@ -0,0 +1,45 @@
+package lockstat
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRWMutex_Unobserved(t *testing.T) {
+	var mu RWMutex
+	mu.Lock()
+	mu.Unlock()
+	mu.RLock()
+	mu.RUnlock()
+}
+
+func TestRWMutex_CountsContention(t *testing.T) {
+	var mu RWMutex
+	var c Counter
+	mu.Observe(&c)
+
+	mu.RLock()
+	mu.RUnlock()
+
+	mu.Lock()
+	done := make(chan struct{})
+	go func() {
+		mu.RLock()
+		mu.RUnlock()
+		close(done)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	mu.Unlock()
+	<-done
+
+	stats := c.Stats()
+	if stats.Acquisitions != 3 {
+		t.Errorf("Acquisitions = %d, want 3", stats.Acquisitions)
+	}
+	if stats.Contended != 1 {
+		t.Errorf("Contended = %d, want 1", stats.Contended)
+	}
+	if stats.Wait <= 0 {
+		t.Error("Wait should be recorded for contended acquisitions")
+	}
+}
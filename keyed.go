@@ -0,0 +1,109 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// keyedBindingPrefix starts the generated names of keyed bindings whose
+// key is not a string.
+const keyedBindingPrefix = "_key_"
+
+// bindingKeyName returns the binding name a key selects. String keys are
+// plain names, so BindKeyed("sms", ...) and BindNamed(..., "sms") are the
+// same binding; other keys get a name that includes their type, so
+// Region("eu") and Zone("eu") do not collide.
+func bindingKeyName(key interface{}) (string, error) {
+	if key == nil {
+		return "", fmt.Errorf("key cannot be nil")
+	}
+	if name, ok := key.(string); ok {
+		if name == "" {
+			return "", fmt.Errorf("key cannot be empty")
+		}
+		return name, nil
+	}
+	if t := reflect.TypeOf(key); !t.Comparable() {
+		return "", fmt.Errorf("key of type %v is not comparable", t)
+	}
+	return fmt.Sprintf("%s%T=%#v", keyedBindingPrefix, key, key), nil
+}
+
+// BindKeyed registers a binding selected by key, which may be any
+// comparable value: an enum, a tenant ID, or a struct such as a region
+// and tier pair. It generalizes BindNamed, avoiding stringly-typed names.
+// Options set the lifetime (transient by default); tags are not allowed.
+//
+// Example:
+//
+//	type Region string
+//
+//	container.BindKeyed(Region("eu"), (*Storage)(nil), &EUStorage{})
+//	container.BindKeyed(Region("us"), (*Storage)(nil), &USStorage{},
+//	    nasc.WithLifetime(nasc.LifetimeSingleton))
+//
+//	storage := container.MakeKeyed((*Storage)(nil), Region("eu")).(Storage)
+func (n *Nasc) BindKeyed(key interface{}, abstractType, concreteType interface{}, opts ...BindOption) error {
+	name, err := bindingKeyName(key)
+	if err != nil {
+		return &InvalidBindingError{Reason: err.Error()}
+	}
+
+	options := bindOptions{lifetime: LifetimeTransient}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if len(options.tags) > 0 || options.name != "" {
+		return &InvalidBindingError{Reason: "a keyed binding cannot have a name or tags"}
+	}
+	options.name = name
+
+	return n.bindTokens(abstractType, concreteType, options)
+}
+
+// MakeKeyed resolves the binding registered under key with BindKeyed.
+// It panics like MakeNamed if there is none.
+//
+// Example:
+//
+//	storage := container.MakeKeyed((*Storage)(nil), Region("eu")).(Storage)
+func (n *Nasc) MakeKeyed(abstractType interface{}, key interface{}) interface{} {
+	name, err := bindingKeyName(key)
+	if err != nil {
+		panic(err.Error())
+	}
+	return n.MakeNamed(abstractType, name)
+}
+
+// MakeKeyedSafe resolves a keyed binding like MakeNamedSafe, returning an
+// error instead of panicking.
+func (n *Nasc) MakeKeyedSafe(abstractType interface{}, key interface{}) (interface{}, error) {
+	name, err := bindingKeyName(key)
+	if err != nil {
+		return nil, &InvalidBindingError{Reason: err.Error()}
+	}
+	return n.MakeNamedSafe(abstractType, name)
+}
+
+// MakeKeyed resolves a keyed binding within this scope, like MakeNamed.
+//
+// Example:
+//
+//	storage := scope.MakeKeyed((*Storage)(nil), tenant.Region).(Storage)
+func (s *Scope) MakeKeyed(abstractType interface{}, key interface{}) interface{} {
+	name, err := bindingKeyName(key)
+	if err != nil {
+		panic(err.Error())
+	}
+	return s.MakeNamed(abstractType, name)
+}
+
+// ResolveKeyed resolves the binding of T registered under key without a
+// type assertion at the call site.
+//
+// Example:
+//
+//	storage := nasc.ResolveKeyed[Storage](container, Region("eu"))
+func ResolveKeyed[T any](c *Nasc, key interface{}) T {
+	return castTo[T](c.MakeKeyed(tokenOf[T](), key))
+}
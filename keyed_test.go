@@ -0,0 +1,88 @@
+package nasc
+
+import (
+	"errors"
+	"testing"
+)
+
+type region string
+
+type zone string
+
+type tenantKey struct {
+	ID   int
+	Tier string
+}
+
+func TestBindKeyed(t *testing.T) {
+	c := New()
+	if err := c.BindKeyed(region("eu"), (*Logger)(nil), &ConsoleLogger{}); err != nil {
+		t.Fatalf("BindKeyed() error = %v", err)
+	}
+	if err := c.BindKeyed(zone("eu"), (*Logger)(nil), &FileLogger{}); err != nil {
+		t.Fatalf("BindKeyed() with a different key type error = %v", err)
+	}
+	if err := c.BindKeyed(tenantKey{ID: 7, Tier: "gold"}, (*Database)(nil), &MockDB{}, WithLifetime(LifetimeSingleton)); err != nil {
+		t.Fatalf("BindKeyed() with a struct key error = %v", err)
+	}
+
+	if _, ok := c.MakeKeyed((*Logger)(nil), region("eu")).(*ConsoleLogger); !ok {
+		t.Error("region key should select the console logger")
+	}
+	if _, ok := ResolveKeyed[Logger](c, zone("eu")).(*FileLogger); !ok {
+		t.Error("zone key should select the file logger")
+	}
+	db := c.MakeKeyed((*Database)(nil), tenantKey{ID: 7, Tier: "gold"})
+	if db != c.MakeKeyed((*Database)(nil), tenantKey{ID: 7, Tier: "gold"}) {
+		t.Error("keyed singleton should be cached")
+	}
+	if _, err := c.MakeKeyedSafe((*Database)(nil), tenantKey{ID: 8, Tier: "gold"}); err == nil {
+		t.Error("an unbound key should fail")
+	}
+}
+
+func TestBindKeyed_StringKeysAreNames(t *testing.T) {
+	c := New()
+	_ = c.BindKeyed("file", (*Logger)(nil), &FileLogger{})
+
+	if _, ok := c.MakeNamed((*Logger)(nil), "file").(*FileLogger); !ok {
+		t.Error("string keys should be binding names")
+	}
+}
+
+func TestBindKeyed_Scope(t *testing.T) {
+	c := New()
+	_ = c.BindKeyed(region("us"), (*Logger)(nil), &ConsoleLogger{}, WithLifetime(LifetimeScoped))
+
+	scope := c.CreateScope()
+	defer scope.Dispose()
+	first := scope.MakeKeyed((*Logger)(nil), region("us"))
+	if first != scope.MakeKeyed((*Logger)(nil), region("us")) {
+		t.Error("scoped keyed binding should be cached per scope")
+	}
+}
+
+func TestBindKeyed_Invalid(t *testing.T) {
+	c := New()
+	var invalid *InvalidBindingError
+
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{"nil key", c.BindKeyed(nil, (*Logger)(nil), &ConsoleLogger{})},
+		{"empty key", c.BindKeyed("", (*Logger)(nil), &ConsoleLogger{})},
+		{"uncomparable key", c.BindKeyed([]string{"eu"}, (*Logger)(nil), &ConsoleLogger{})},
+		{"tags", c.BindKeyed(region("eu"), (*Logger)(nil), &ConsoleLogger{}, WithTags("x"))},
+		{"not implemented", c.BindKeyed(region("eu"), (*Logger)(nil), &MockDB{})},
+		{"nil concrete", c.BindKeyed(region("eu"), (*Logger)(nil), nil)},
+	}
+	for _, tt := range tests {
+		if !errors.As(tt.err, &invalid) {
+			t.Errorf("%s: error = %v, want *InvalidBindingError", tt.name, tt.err)
+		}
+	}
+	if _, err := c.MakeKeyedSafe((*Logger)(nil), []int{1}); !errors.As(err, &invalid) {
+		t.Errorf("MakeKeyedSafe() with an uncomparable key error = %v", err)
+	}
+}
@@ -0,0 +1,83 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// Lazy defers resolving a dependency until Get is first called.
+// Declare it as *Lazy[T] in a constructor parameter or an auto-wired field:
+// the container injects an unresolved handle, which breaks many practical
+// circular dependencies and avoids building services that are never used.
+//
+// The value is resolved once; later calls return the same instance.
+//
+// Example:
+//
+//	func NewReportService(db *nasc.Lazy[Database]) *ReportService {
+//	    return &ReportService{db: db}
+//	}
+//
+//	func (s *ReportService) Run() {
+//	    s.db.Get().Query("...")
+//	}
+type Lazy[T any] struct {
+	once    sync.Once
+	resolve func(interface{}) interface{}
+	value   T
+	err     error
+}
+
+// Get resolves the dependency on first use and returns it.
+// Panics if resolution fails, like Make.
+func (l *Lazy[T]) Get() T {
+	value, err := l.GetSafe()
+	if err != nil {
+		panic(err.Error())
+	}
+	return value
+}
+
+// GetSafe resolves the dependency on first use and returns it,
+// or the resolution error.
+func (l *Lazy[T]) GetSafe() (T, error) {
+	l.once.Do(func() {
+		if l.resolve == nil {
+			l.err = fmt.Errorf("lazy %v was not injected by a container", l.target())
+			return
+		}
+
+		defer func() {
+			if r := recover(); r != nil {
+				l.err = fmt.Errorf("lazy resolution of %v failed: %v", l.target(), r)
+			}
+		}()
+
+		resolved := l.resolve(reflect.Zero(reflect.PointerTo(l.target())).Interface())
+		value, ok := resolved.(T)
+		if !ok {
+			l.err = fmt.Errorf("lazy resolution of %v returned %T", l.target(), resolved)
+			return
+		}
+		l.value = value
+	})
+	return l.value, l.err
+}
+
+// target returns T.
+func (l *Lazy[T]) target() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// bind sets the function that resolves the dependency.
+func (l *Lazy[T]) bind(resolve func(interface{}) interface{}) {
+	l.resolve = resolve
+}
+
+// lazyInjectable is implemented by *Lazy[T] for every T.
+type lazyInjectable interface {
+	bind(resolve func(interface{}) interface{})
+}
+
+var lazyInjectableType = reflect.TypeOf((*lazyInjectable)(nil)).Elem()
@@ -0,0 +1,86 @@
+package nasc
+
+import "testing"
+
+type lazyConsumer struct {
+	Logger *Lazy[Logger] `inject:""`
+}
+
+type lazyService struct {
+	db *Lazy[Database]
+}
+
+func newLazyService(db *Lazy[Database]) *lazyService {
+	return &lazyService{db: db}
+}
+
+// Mutually dependent services, one side broken up by Lazy
+type chickenService interface{ Egg() eggService }
+type eggService interface{ Chicken() chickenService }
+
+type chicken struct{ egg *Lazy[eggService] }
+
+func (c *chicken) Egg() eggService { return c.egg.Get() }
+
+type egg struct{ chicken chickenService }
+
+func (e *egg) Chicken() chickenService { return e.chicken }
+
+func TestLazy_ConstructorParameter(t *testing.T) {
+	container := New()
+	_ = container.BindConstructor((*lazyService)(nil), newLazyService)
+
+	// Database is not bound yet; the lazy handle is still injected
+	svc := container.Make((*lazyService)(nil)).(*lazyService)
+
+	if _, err := svc.db.GetSafe(); err == nil {
+		t.Error("expected error resolving unbound dependency")
+	}
+
+	_ = container.Singleton((*Database)(nil), &MockDB{})
+	svc = container.Make((*lazyService)(nil)).(*lazyService)
+	if svc.db.Get() != container.Make((*Database)(nil)) {
+		t.Error("Get should resolve the bound singleton")
+	}
+	if svc.db.Get() != svc.db.Get() {
+		t.Error("Get should return the same value on every call")
+	}
+}
+
+func TestLazy_AutoWireField(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	consumer := &lazyConsumer{}
+	if err := container.AutoWire(consumer); err != nil {
+		t.Fatalf("AutoWire failed: %v", err)
+	}
+	if _, ok := consumer.Logger.Get().(*ConsoleLogger); !ok {
+		t.Error("lazy field should resolve the bound logger")
+	}
+}
+
+func TestLazy_BreaksCycle(t *testing.T) {
+	container := New()
+	_ = container.SingletonConstructor((*chickenService)(nil), func(e *Lazy[eggService]) *chicken {
+		return &chicken{egg: e}
+	})
+	_ = container.SingletonConstructor((*eggService)(nil), func(c chickenService) *egg {
+		return &egg{chicken: c}
+	})
+
+	c, err := container.MakeSafe((*chickenService)(nil))
+	if err != nil {
+		t.Fatalf("MakeSafe failed: %v", err)
+	}
+	if c.(chickenService).Egg().Chicken() != c {
+		t.Error("lazy dependency should resolve back to the same singleton")
+	}
+}
+
+func TestLazy_NotInjected(t *testing.T) {
+	var lazy Lazy[Logger]
+	if _, err := lazy.GetSafe(); err == nil {
+		t.Error("expected error from a lazy value not created by a container")
+	}
+}
@@ -0,0 +1,220 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// LazyProvider is an optional interface for providers whose registration
+// should wait until it is needed. Provides lists the abstract types the
+// provider binds, as nil pointers like those passed to Bind. RegisterProvider
+// records them without calling Register; Register runs the first time one
+// of them is resolved, so an unused subsystem costs nothing at startup.
+//
+// A provider that also implements DeferredProvider is still checked with
+// ShouldRegister when it is registered. A lazy provider registered after
+// BootProviders has run is booted as soon as it is registered. Register must
+// not resolve the types the provider itself provides. Freeze registers the
+// lazy providers still waiting, since a frozen container accepts no new
+// bindings.
+//
+// Example:
+//
+//	type ReportingProvider struct{}
+//
+//	func (p *ReportingProvider) Provides() []interface{} {
+//	    return []interface{}{(*ReportService)(nil)}
+//	}
+//
+//	func (p *ReportingProvider) Register(container *Nasc) error {
+//	    return container.Singleton((*ReportService)(nil), &PDFReportService{})
+//	}
+type LazyProvider interface {
+	ServiceProvider
+	Provides() []interface{}
+}
+
+// lazyEntry is a lazy provider waiting for one of its types to be resolved.
+// It keeps the quotas and origin it was registered under, so it registers
+// under them whichever goroutine first resolves its types.
+type lazyEntry struct {
+	provider ServiceProvider
+	types    []reflect.Type
+	quotas   []*quotaState
+	origin   string
+	once     sync.Once
+	err      error
+}
+
+// lazyProviderSet indexes the lazy providers not registered yet by the types
+// they provide.
+type lazyProviderSet struct {
+	mu     sync.Mutex
+	byType map[reflect.Type]*lazyEntry
+
+	// waiting counts the indexed types, so resolution skips the lock when
+	// there are no lazy providers
+	waiting atomic.Int32
+}
+
+// deferLazyProvider records a lazy provider's types instead of registering
+// it. A type can only be provided by one lazy provider.
+func (n *Nasc) deferLazyProvider(provider LazyProvider) error {
+	entry := &lazyEntry{provider: provider, quotas: n.quotas, origin: n.origin}
+	for _, token := range provider.Provides() {
+		if token == nil {
+			return fmt.Errorf("provider %T provides a nil type", provider)
+		}
+		entry.types = append(entry.types, typeOfToken(token))
+	}
+	if len(entry.types) == 0 {
+		return fmt.Errorf("provider %T provides no types", provider)
+	}
+
+	n.lazy.mu.Lock()
+	defer n.lazy.mu.Unlock()
+
+	for _, t := range entry.types {
+		if other, exists := n.lazy.byType[t]; exists {
+			return fmt.Errorf("type %v is already provided by lazy provider %T", t, other.provider)
+		}
+	}
+	if n.lazy.byType == nil {
+		n.lazy.byType = make(map[reflect.Type]*lazyEntry)
+	}
+	for _, t := range entry.types {
+		n.lazy.byType[t] = entry
+	}
+	n.lazy.waiting.Add(int32(len(entry.types)))
+	return nil
+}
+
+// snapshot copies the index for Clone. Each waiting provider gets a fresh
+// entry, so it registers separately in the copy.
+func (s *lazyProviderSet) snapshot() map[reflect.Type]*lazyEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.byType) == 0 {
+		return nil
+	}
+	copies := make(map[*lazyEntry]*lazyEntry)
+	byType := make(map[reflect.Type]*lazyEntry, len(s.byType))
+	for t, entry := range s.byType {
+		if copies[entry] == nil {
+			copies[entry] = &lazyEntry{
+				provider: entry.provider,
+				types:    entry.types,
+				quotas:   entry.quotas,
+				origin:   entry.origin,
+			}
+		}
+		byType[t] = copies[entry]
+	}
+	return byType
+}
+
+// hasLazyProvider reports whether a lazy provider that is the same as
+// provider (see sameProvider) is waiting to be registered.
+func (n *Nasc) hasLazyProvider(provider ServiceProvider) bool {
+	if n.lazy.waiting.Load() == 0 {
+		return false
+	}
+
+	n.lazy.mu.Lock()
+	defer n.lazy.mu.Unlock()
+	for _, entry := range n.lazy.byType {
+		if sameProvider(entry.provider, provider) {
+			return true
+		}
+	}
+	return false
+}
+
+// loadLazyProvider registers the lazy provider of abstractT, if any, before
+// abstractT is looked up. The provider registers once; a failure is
+// returned to every resolution of its types.
+func (n *Nasc) loadLazyProvider(abstractT reflect.Type) error {
+	if n.lazy.waiting.Load() == 0 {
+		return nil
+	}
+
+	n.lazy.mu.Lock()
+	entry := n.lazy.byType[abstractT]
+	n.lazy.mu.Unlock()
+	if entry == nil {
+		return nil
+	}
+
+	entry.once.Do(func() {
+		entry.err = n.handle(entry.quotas).withOrigin(entry.origin).registerLazyProvider(entry.provider)
+		if entry.err != nil {
+			entry.err = fmt.Errorf("lazy provider %T: %w", entry.provider, entry.err)
+			return
+		}
+
+		n.lazy.mu.Lock()
+		for _, t := range entry.types {
+			delete(n.lazy.byType, t)
+		}
+		n.lazy.waiting.Add(-int32(len(entry.types)))
+		n.lazy.mu.Unlock()
+	})
+	return entry.err
+}
+
+// registerLazyProvider registers a lazy provider on demand, booting it
+// right away if the container's providers have already booted.
+func (n *Nasc) registerLazyProvider(provider ServiceProvider) error {
+	n.providerMu.Lock()
+	missing := n.missingRequirements(provider)
+	n.providerMu.Unlock()
+	if len(missing) > 0 {
+		return fmt.Errorf("requires %v, which is not registered", missing)
+	}
+	if err := n.registerProviderNow(provider); err != nil {
+		return err
+	}
+	if err := n.registerReadyProviders(); err != nil {
+		return err
+	}
+
+	n.providerMu.Lock()
+	booted := n.providersBooted
+	n.providerMu.Unlock()
+	if !booted {
+		return nil
+	}
+	for _, entry := range n.snapshotProviders() {
+		if entry.provider != provider {
+			continue
+		}
+		if err := n.bootProvider(entry); err != nil {
+			return fmt.Errorf("provider boot failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// loadLazyProviders registers every lazy provider still waiting, for
+// Freeze, reporting failures through the container's error sink.
+func (n *Nasc) loadLazyProviders() {
+	n.lazy.mu.Lock()
+	var entries []*lazyEntry
+	seen := make(map[*lazyEntry]bool)
+	for _, entry := range n.lazy.byType {
+		if !seen[entry] {
+			seen[entry] = true
+			entries = append(entries, entry)
+		}
+	}
+	n.lazy.mu.Unlock()
+
+	for _, entry := range entries {
+		if err := n.loadLazyProvider(entry.types[0]); err != nil {
+			n.reportError("lazy provider", entry.types[0], err)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package nasc
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type lazyLoggingProvider struct {
+	registered int
+	booted     int
+	fail       bool
+}
+
+func (p *lazyLoggingProvider) Provides() []interface{} {
+	return []interface{}{(*Logger)(nil)}
+}
+
+func (p *lazyLoggingProvider) Register(container *Nasc) error {
+	p.registered++
+	if p.fail {
+		return errors.New("logging backend unavailable")
+	}
+	return container.Singleton((*Logger)(nil), &ConsoleLogger{})
+}
+
+func (p *lazyLoggingProvider) Boot(container *Nasc) error {
+	p.booted++
+	return nil
+}
+
+func TestLazyProvider_RegistersOnFirstResolution(t *testing.T) {
+	container := New()
+	provider := &lazyLoggingProvider{}
+	if err := container.RegisterProvider(provider); err != nil {
+		t.Fatalf("RegisterProvider() error = %v", err)
+	}
+	if err := container.RegisterProvider(&lazyLoggingProvider{}); err != nil {
+		t.Errorf("registering a duplicate lazy provider error = %v", err)
+	}
+	if provider.registered != 0 || len(container.GetProviders()) != 0 {
+		t.Fatal("a lazy provider should not register before its types are resolved")
+	}
+
+	if _, ok := container.Make((*Logger)(nil)).(*ConsoleLogger); !ok {
+		t.Error("resolving a provided type should register the lazy provider")
+	}
+	container.Make((*Logger)(nil))
+	if provider.registered != 1 {
+		t.Errorf("Register called %d times, want 1", provider.registered)
+	}
+	if len(container.GetProviders()) != 1 {
+		t.Error("a loaded lazy provider should be listed with the registered providers")
+	}
+}
+
+func TestLazyProvider_ResolvedAsDependency(t *testing.T) {
+	container := New()
+	_ = container.RegisterProvider(&lazyLoggingProvider{})
+	_ = container.BindConstructor((*Consumer)(nil), func(l Logger) *recordingConsumer {
+		return &recordingConsumer{}
+	})
+
+	if _, err := container.MakeSafe((*Consumer)(nil)); err != nil {
+		t.Errorf("MakeSafe() error = %v, want the dependency registered lazily", err)
+	}
+}
+
+func TestLazyProvider_Boot(t *testing.T) {
+	container := New()
+	early := &lazyLoggingProvider{}
+	_ = container.RegisterProvider(early)
+	container.Make((*Logger)(nil))
+	if err := container.BootProviders(); err != nil {
+		t.Fatalf("BootProviders() error = %v", err)
+	}
+	if early.booted != 1 {
+		t.Errorf("a lazy provider loaded before boot booted %d times, want 1", early.booted)
+	}
+
+	late := New()
+	provider := &lazyLoggingProvider{}
+	_ = late.RegisterProvider(provider)
+	if err := late.BootProviders(); err != nil {
+		t.Fatalf("BootProviders() error = %v", err)
+	}
+	if provider.booted != 0 {
+		t.Error("BootProviders should not boot a lazy provider that has not registered")
+	}
+	late.Make((*Logger)(nil))
+	if provider.booted != 1 {
+		t.Errorf("a lazy provider loaded after boot booted %d times, want 1", provider.booted)
+	}
+}
+
+func TestLazyProvider_RegisterFailure(t *testing.T) {
+	container := New()
+	provider := &lazyLoggingProvider{fail: true}
+	_ = container.RegisterProvider(provider)
+
+	for i := 0; i < 2; i++ {
+		_, err := container.MakeSafe((*Logger)(nil))
+		if err == nil || !strings.Contains(err.Error(), "logging backend unavailable") {
+			t.Errorf("MakeSafe() error = %v, want the provider's registration error", err)
+		}
+	}
+	if provider.registered != 1 {
+		t.Errorf("Register called %d times, want 1", provider.registered)
+	}
+}
+
+func TestLazyProvider_Freeze(t *testing.T) {
+	container := New()
+	provider := &lazyLoggingProvider{}
+	_ = container.RegisterProvider(provider)
+
+	container.Freeze()
+	if provider.registered != 1 {
+		t.Fatal("Freeze should register waiting lazy providers")
+	}
+	if _, ok := container.Make((*Logger)(nil)).(*ConsoleLogger); !ok {
+		t.Error("a frozen container should resolve types from lazy providers")
+	}
+}
+
+func TestLazyProvider_Quota(t *testing.T) {
+	container := New()
+	err := container.RegisterProviderWithQuota(&lazyLoggingProvider{}, ProviderQuota{
+		ForbiddenTypes: []interface{}{(*Logger)(nil)},
+	})
+	if err != nil {
+		t.Fatalf("RegisterProviderWithQuota() error = %v", err)
+	}
+
+	_, err = container.MakeSafe((*Logger)(nil))
+	var violation *QuotaViolationError
+	if !errors.As(err, &violation) || violation.Rule != QuotaForbiddenType {
+		t.Errorf("MakeSafe() error = %v, want the quota enforced when the provider loads", err)
+	}
+}
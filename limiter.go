@@ -0,0 +1,177 @@
+package nasc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// FactoryLimiter bounds how many instances of an expensive service are
+// created concurrently. Demand beyond the limit is queued instead of
+// overwhelming downstream resources, and queueing is reported by Stats.
+//
+// One limiter can wrap several factories to share a budget.
+type FactoryLimiter struct {
+	sem     chan struct{}
+	maxWait time.Duration
+
+	waiting   atomic.Int64
+	acquired  atomic.Uint64
+	timedOut  atomic.Uint64
+	waitNanos atomic.Int64
+}
+
+// LimiterStats is a point-in-time view of a FactoryLimiter.
+type LimiterStats struct {
+	// Limit is the maximum number of concurrent creations
+	Limit int
+
+	// InFlight is the number of creations currently running
+	InFlight int
+
+	// Waiting is the number of callers currently queued
+	Waiting int
+
+	// Acquired is the total number of creations admitted
+	Acquired uint64
+
+	// TimedOut is the number of callers that gave up waiting
+	TimedOut uint64
+
+	// TotalWait is the time admitted and timed-out callers spent queued
+	TotalWait time.Duration
+}
+
+// NewFactoryLimiter creates a limiter admitting up to limit concurrent
+// creations. Callers queue for at most maxWait; zero waits indefinitely.
+// Panics if limit is less than 1.
+//
+// Example:
+//
+//	limiter := nasc.NewFactoryLimiter(4, 5*time.Second)
+//	container.Factory((*PDFRenderer)(nil), limiter.Wrap(func(c *nasc.Nasc) (interface{}, error) {
+//	    return NewChromiumRenderer()
+//	}))
+func NewFactoryLimiter(limit int, maxWait time.Duration) *FactoryLimiter {
+	if limit < 1 {
+		panic(fmt.Sprintf("factory limiter limit must be at least 1, got %d", limit))
+	}
+	return &FactoryLimiter{
+		sem:     make(chan struct{}, limit),
+		maxWait: maxWait,
+	}
+}
+
+// Wrap returns a factory that waits for a free slot before calling factory.
+// If no slot frees up within the limiter's maximum wait, resolution fails
+// with an error wrapping context.DeadlineExceeded. Factories have no
+// caller context to honor; use LimitConstructor to also stop waiting when
+// the resolving scope's context is done.
+func (l *FactoryLimiter) Wrap(factory FactoryFunc) FactoryFunc {
+	return func(c *Nasc) (interface{}, error) {
+		release, err := l.acquireWithin(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+
+		return factory(c)
+	}
+}
+
+// LimitConstructor returns a constructor, for BindConstructor and its
+// variants, that waits for a slot of l before calling constructor. The wait
+// ends when the limiter's maximum wait passes or the resolution context is
+// done: the context of the resolving scope (see CreateScopeWithContext), or
+// context.Background() outside a scope.
+//
+// Example:
+//
+//	container.ScopedConstructor((*PDFRenderer)(nil), nasc.LimitConstructor(limiter,
+//	    func(ctx context.Context, c *nasc.Nasc) (PDFRenderer, error) {
+//	        return NewChromiumRenderer(ctx)
+//	    }))
+func LimitConstructor[T any](l *FactoryLimiter, constructor func(ctx context.Context, c *Nasc) (T, error)) func(context.Context, *Nasc) (T, error) {
+	return func(ctx context.Context, c *Nasc) (T, error) {
+		release, err := l.acquireWithin(ctx)
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+		defer release()
+
+		return constructor(ctx, c)
+	}
+}
+
+// acquireWithin acquires a slot, waiting until ctx is done or the
+// limiter's maximum wait passes.
+func (l *FactoryLimiter) acquireWithin(ctx context.Context) (func(), error) {
+	if l.maxWait > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, l.maxWait)
+		defer cancel()
+	}
+	return l.Acquire(ctx)
+}
+
+// Acquire waits for a free slot until ctx is done and returns the function
+// that releases it. Use it to guard creation paths that have a request
+// context, such as a factory resolved inside a request handler. Calling
+// the release function more than once releases the slot only once.
+//
+// Example:
+//
+//	release, err := limiter.Acquire(r.Context())
+//	if err != nil {
+//	    http.Error(w, "busy", http.StatusServiceUnavailable)
+//	    return
+//	}
+//	defer release()
+func (l *FactoryLimiter) Acquire(ctx context.Context) (func(), error) {
+	// Fast path: a slot is free
+	select {
+	case l.sem <- struct{}{}:
+		l.acquired.Add(1)
+		return l.releaser(), nil
+	default:
+	}
+
+	l.waiting.Add(1)
+	start := time.Now()
+	defer func() {
+		l.waiting.Add(-1)
+		l.waitNanos.Add(int64(time.Since(start)))
+	}()
+
+	select {
+	case l.sem <- struct{}{}:
+		l.acquired.Add(1)
+		return l.releaser(), nil
+	case <-ctx.Done():
+		l.timedOut.Add(1)
+		return nil, fmt.Errorf("factory limiter: no slot available: %w", ctx.Err())
+	}
+}
+
+// releaser returns a function freeing one acquired slot, at most once.
+func (l *FactoryLimiter) releaser() func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() { <-l.sem })
+	}
+}
+
+// Stats returns the limiter's current queue metrics.
+func (l *FactoryLimiter) Stats() LimiterStats {
+	return LimiterStats{
+		Limit:     cap(l.sem),
+		InFlight:  len(l.sem),
+		Waiting:   int(l.waiting.Load()),
+		Acquired:  l.acquired.Load(),
+		TimedOut:  l.timedOut.Load(),
+		TotalWait: time.Duration(l.waitNanos.Load()),
+	}
+}
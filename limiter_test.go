@@ -0,0 +1,120 @@
+package nasc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFactoryLimiter_BoundsConcurrency(t *testing.T) {
+	limiter := NewFactoryLimiter(2, 0)
+
+	var current, peak atomic.Int64
+	container := New()
+	_ = container.Factory((*Logger)(nil), limiter.Wrap(func(c *Nasc) (interface{}, error) {
+		n := current.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		current.Add(-1)
+		return &ConsoleLogger{}, nil
+	}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			container.Make((*Logger)(nil))
+		}()
+	}
+	wg.Wait()
+
+	if peak.Load() > 2 {
+		t.Errorf("peak concurrency = %d, want <= 2", peak.Load())
+	}
+	stats := limiter.Stats()
+	if stats.Acquired != 10 || stats.InFlight != 0 || stats.Waiting != 0 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestFactoryLimiter_MaxWait(t *testing.T) {
+	limiter := NewFactoryLimiter(1, 10*time.Millisecond)
+	release, _ := limiter.Acquire(context.Background())
+	defer release()
+
+	container := New()
+	_ = container.Factory((*Logger)(nil), limiter.Wrap(func(c *Nasc) (interface{}, error) {
+		return &ConsoleLogger{}, nil
+	}))
+
+	_, err := container.MakeSafe((*Logger)(nil))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline error, got %v", err)
+	}
+	if limiter.Stats().TimedOut != 1 {
+		t.Errorf("TimedOut = %d, want 1", limiter.Stats().TimedOut)
+	}
+}
+
+func TestFactoryLimiter_AcquireCanceled(t *testing.T) {
+	limiter := NewFactoryLimiter(1, 0)
+	release, _ := limiter.Acquire(context.Background())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := limiter.Acquire(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected canceled error, got %v", err)
+	}
+
+	release()
+	if _, err := limiter.Acquire(ctx); err != nil {
+		t.Errorf("a free slot should be acquired even with a done context: %v", err)
+	}
+}
+
+func TestFactoryLimiter_ReleaseOnce(t *testing.T) {
+	limiter := NewFactoryLimiter(2, 0)
+	release, _ := limiter.Acquire(context.Background())
+	_, _ = limiter.Acquire(context.Background())
+
+	release()
+	release()
+	if got := limiter.Stats().InFlight; got != 1 {
+		t.Errorf("InFlight = %d after releasing one slot twice, want 1", got)
+	}
+}
+
+func TestLimitConstructor_ScopeContext(t *testing.T) {
+	limiter := NewFactoryLimiter(1, 0)
+	release, _ := limiter.Acquire(context.Background())
+	defer release()
+
+	container := New()
+	_ = container.ScopedConstructor((*Logger)(nil), LimitConstructor(limiter,
+		func(ctx context.Context, c *Nasc) (Logger, error) {
+			return &ConsoleLogger{}, nil
+		}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	scope := container.CreateScopeWithContext(ctx)
+	defer scope.Dispose()
+
+	defer func() {
+		if r := recover(); r == nil || !strings.Contains(fmt.Sprint(r), context.DeadlineExceeded.Error()) {
+			t.Errorf("expected the scope's deadline to end the wait, got %v", r)
+		}
+	}()
+	scope.Make((*Logger)(nil))
+}
@@ -0,0 +1,82 @@
+package nasc
+
+import (
+	"time"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/internal/lockstat"
+)
+
+// LockMetric reports acquisitions and contention for one group of internal locks.
+type LockMetric struct {
+	// Name is the lock group: "registry", "singletons", "reflection" or "scopes"
+	Name string
+
+	// Acquisitions is the number of times a lock in the group was acquired
+	Acquisitions uint64
+
+	// Contended is the number of acquisitions that had to wait
+	Contended uint64
+
+	// Wait is the total time spent waiting for contended acquisitions
+	Wait time.Duration
+}
+
+// lockMetrics holds the counters shared by a container's instrumented locks.
+type lockMetrics struct {
+	singletons lockstat.Counter
+	reflection lockstat.Counter
+	scopes     lockstat.Counter
+}
+
+// WithLockMetrics counts acquisitions and contention of the container's
+// internal locks (registry, singleton cache, reflection cache and scopes).
+// Read the counters with LockMetrics to check whether the container is a
+// bottleneck before tuning it.
+//
+// Counting adds a small cost to every lock acquisition, so it is disabled
+// by default. Reads of a frozen container take no registry lock.
+//
+// Example:
+//
+//	container := nasc.New(nasc.WithLockMetrics())
+//	// ... run load ...
+//	for _, m := range container.LockMetrics() {
+//	    fmt.Printf("%s: %d/%d contended, waited %v\n", m.Name, m.Contended, m.Acquisitions, m.Wait)
+//	}
+func WithLockMetrics() Option {
+	return func(n *Nasc) error {
+		if n.lockMetrics != nil {
+			return nil
+		}
+		n.lockMetrics = &lockMetrics{}
+		n.registry.EnableLockStats()
+		n.singletonCache.mu.Observe(&n.lockMetrics.singletons)
+		n.reflectionCache.mu.Observe(&n.lockMetrics.reflection)
+		return nil
+	}
+}
+
+// LockMetrics returns the lock counters recorded since the container was
+// created, or nil unless WithLockMetrics is used.
+func (n *Nasc) LockMetrics() []LockMetric {
+	if n.lockMetrics == nil {
+		return nil
+	}
+
+	return []LockMetric{
+		lockMetric("registry", n.registry.LockStats()),
+		lockMetric("singletons", n.lockMetrics.singletons.Stats()),
+		lockMetric("reflection", n.lockMetrics.reflection.Stats()),
+		lockMetric("scopes", n.lockMetrics.scopes.Stats()),
+	}
+}
+
+// lockMetric converts counter stats into a LockMetric.
+func lockMetric(name string, stats lockstat.Stats) LockMetric {
+	return LockMetric{
+		Name:         name,
+		Acquisitions: stats.Acquisitions,
+		Contended:    stats.Contended,
+		Wait:         stats.Wait,
+	}
+}
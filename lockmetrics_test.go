@@ -0,0 +1,50 @@
+package nasc
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestLockMetrics_Disabled(t *testing.T) {
+	if New().LockMetrics() != nil {
+		t.Error("LockMetrics should be nil unless enabled")
+	}
+}
+
+func TestLockMetrics_CountsAcquisitions(t *testing.T) {
+	container := New(WithLockMetrics())
+	_ = container.Singleton((*Logger)(nil), &ConsoleLogger{})
+	_ = container.Scoped((*Database)(nil), &MockDB{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			container.Make((*Logger)(nil))
+			scope := container.CreateScope()
+			scope.Make((*Database)(nil))
+			_ = scope.Dispose()
+		}()
+	}
+	wg.Wait()
+
+	metrics := container.LockMetrics()
+	names := []string{"registry", "singletons", "reflection", "scopes"}
+	if len(metrics) != len(names) {
+		t.Fatalf("expected %d metrics, got %d", len(names), len(metrics))
+	}
+	for i, m := range metrics {
+		if m.Name != names[i] {
+			t.Errorf("metric %d = %q, want %q", i, m.Name, names[i])
+		}
+		if m.Contended > m.Acquisitions {
+			t.Errorf("%s: contended %d exceeds acquisitions %d", m.Name, m.Contended, m.Acquisitions)
+		}
+	}
+	for _, i := range []int{0, 1, 3} {
+		if metrics[i].Acquisitions == 0 {
+			t.Errorf("%s: expected acquisitions to be counted", metrics[i].Name)
+		}
+	}
+}
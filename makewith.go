@@ -0,0 +1,119 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// MakeWith resolves a transient constructor binding, passing explicit
+// runtime values for some constructor parameters and resolving the rest
+// from the container. Each argument fills the first remaining parameter
+// its type is assignable to, so parameters need not be interfaces; use it
+// for request-specific data such as user IDs.
+//
+// Panics if the binding is not a transient constructor binding, or if an
+// argument matches no parameter.
+//
+// Example:
+//
+//	// Where: func NewAuditLog(db Database, userID UserID) *AuditLog
+//	container.BindConstructor((*AuditLog)(nil), NewAuditLog)
+//
+//	audit := container.MakeWith((*AuditLog)(nil), UserID(42)).(*AuditLog)
+func (n *Nasc) MakeWith(abstractType interface{}, args ...interface{}) interface{} {
+	if abstractType == nil {
+		panic("cannot resolve nil type")
+	}
+
+	abstractT := typeOfToken(abstractType)
+	if err := n.loadLazyProvider(abstractT); err != nil {
+		panic(fmt.Sprintf("failed to resolve type %v: %v", abstractT, err))
+	}
+	binding, err := n.registry.Get(abstractT)
+	if err != nil {
+		panic(fmt.Sprintf("binding not found for type %v: %v", abstractT, err))
+	}
+	if Lifetime(binding.Lifetime) != LifetimeTransient || binding.Constructor == nil {
+		panic(fmt.Sprintf("MakeWith requires a transient constructor binding for type %v, got %s", abstractT, binding.Lifetime))
+	}
+
+	info, err := bindArgs(binding.Constructor.(*constructorInfo), args)
+	if err != nil {
+		panic(fmt.Sprintf("invalid arguments for type %v: %v", abstractT, err))
+	}
+
+	instance, err := n.invokeConstructor(info, abstractT)
+	if err != nil {
+		panic(fmt.Sprintf("failed to invoke constructor for type %v: %v", abstractT, err))
+	}
+	n.mustInitialize(instance, abstractT)
+	instance = n.mustDecorate(binding, instance)
+	n.stamp(instance, binding, nil, nil)
+	return instance
+}
+
+// bindArgs partially applies args to a constructor, returning a constructor
+// that takes only the parameters left to resolve.
+func bindArgs(info *constructorInfo, args []interface{}) (*constructorInfo, error) {
+	fixed := make([]reflect.Value, info.numParams)
+	for _, arg := range args {
+		if arg == nil {
+			return nil, fmt.Errorf("argument cannot be nil")
+		}
+		argV := reflect.ValueOf(arg)
+
+		matched := false
+		for i, paramType := range info.paramTypes {
+			if !fixed[i].IsValid() && argV.Type().AssignableTo(paramType) {
+				fixed[i] = argV
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return nil, fmt.Errorf("argument of type %T matches no constructor parameter", arg)
+		}
+	}
+
+	var remaining []reflect.Type
+	var names []string
+	for i, paramType := range info.paramTypes {
+		if !fixed[i].IsValid() {
+			remaining = append(remaining, paramType)
+			names = append(names, info.paramName(i))
+		}
+	}
+	if info.paramNames == nil {
+		names = nil
+	}
+
+	outs := make([]reflect.Type, info.fnType.NumOut())
+	for i := range outs {
+		outs[i] = info.fnType.Out(i)
+	}
+	fnType := reflect.FuncOf(remaining, outs, false)
+
+	fn := reflect.MakeFunc(fnType, func(in []reflect.Value) []reflect.Value {
+		params := make([]reflect.Value, info.numParams)
+		next := 0
+		for i := range params {
+			if fixed[i].IsValid() {
+				params[i] = fixed[i]
+			} else {
+				params[i] = in[next]
+				next++
+			}
+		}
+		return info.fn.Call(params)
+	})
+
+	return &constructorInfo{
+		fn:           fn,
+		fnType:       fnType,
+		paramTypes:   remaining,
+		returnsError: info.returnsError,
+		returnType:   info.returnType,
+		numParams:    len(remaining),
+		paramNames:   names,
+	}, nil
+}
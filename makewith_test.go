@@ -0,0 +1,72 @@
+package nasc
+
+import (
+	"strings"
+	"testing"
+)
+
+type userID int
+
+type actionLog struct {
+	logger Logger
+	user   userID
+	action string
+}
+
+func newActionLog(logger Logger, user userID, action string) *actionLog {
+	return &actionLog{logger: logger, user: user, action: action}
+}
+
+func TestMakeWith_MixesArgsAndDependencies(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+	_ = container.BindConstructor((*actionLog)(nil), newActionLog)
+
+	audit := container.MakeWith((*actionLog)(nil), "login", userID(42)).(*actionLog)
+	if audit.logger == nil {
+		t.Error("Logger should be resolved from the container")
+	}
+	if audit.user != 42 || audit.action != "login" {
+		t.Errorf("runtime arguments not applied: %+v", audit)
+	}
+}
+
+func TestMakeWith_OverridesDependency(t *testing.T) {
+	container := New()
+	_ = container.BindConstructor((*ConstructorService)(nil), NewServiceWithLogger)
+
+	logger := &ConsoleLogger{}
+	svc := container.MakeWith((*ConstructorService)(nil), logger).(*ConstructorServiceImpl)
+	if svc.Logger != logger {
+		t.Error("explicit argument should be used instead of resolving")
+	}
+}
+
+func TestMakeWith_Panics(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+	_ = container.BindConstructor((*actionLog)(nil), newActionLog)
+	_ = container.Singleton((*Database)(nil), &MockDB{})
+
+	tests := []struct {
+		name  string
+		make  func()
+		wants string
+	}{
+		{"unmatched argument", func() { container.MakeWith((*actionLog)(nil), 3.14) }, "matches no constructor parameter"},
+		{"missing argument", func() { container.MakeWith((*actionLog)(nil), "login") }, "failed to invoke constructor"},
+		{"not a constructor binding", func() { container.MakeWith((*Database)(nil)) }, "transient constructor binding"},
+		{"unbound", func() { container.MakeWith((*NotificationService)(nil)) }, "binding not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				if r == nil || !strings.Contains(r.(string), tt.wants) {
+					t.Errorf("expected panic containing %q, got %v", tt.wants, r)
+				}
+			}()
+			tt.make()
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package nasc
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// ConflictStrategy decides what Merge does when both containers bind the
+// same type (and name).
+type ConflictStrategy int
+
+const (
+	// ConflictError fails the merge without changing the container.
+	ConflictError ConflictStrategy = iota
+
+	// ConflictKeepExisting keeps the receiving container's binding.
+	ConflictKeepExisting
+
+	// ConflictReplace replaces the receiving container's binding.
+	ConflictReplace
+)
+
+// String returns the strategy name.
+func (s ConflictStrategy) String() string {
+	switch s {
+	case ConflictError:
+		return "error"
+	case ConflictKeepExisting:
+		return "keep-existing"
+	case ConflictReplace:
+		return "replace"
+	default:
+		return fmt.Sprintf("ConflictStrategy(%d)", int(s))
+	}
+}
+
+// Merge copies the bindings of other into this container, resolving
+// duplicate bindings with strategy. Bindings keep their origin. Singleton
+// instances and providers of other are not copied, and other is unchanged.
+//
+// With ConflictError every conflict is reported and no binding is merged.
+//
+// Example:
+//
+//	app := nasc.New()
+//	app.Merge(billing.Container(), nasc.ConflictError)
+//	app.Merge(search.Container(), nasc.ConflictKeepExisting)
+func (n *Nasc) Merge(other *Nasc, strategy ConflictStrategy) error {
+	if other == nil {
+		return fmt.Errorf("cannot merge nil container")
+	}
+	if other.containerState == n.containerState {
+		return nil
+	}
+	if n.IsFrozen() {
+		return ErrContainerFrozen
+	}
+
+	var additions []*registry.Binding
+	var conflicts []error
+	for _, binding := range other.sortedBindings() {
+		existing, exists := n.lookupBinding(binding)
+		if !exists {
+			additions = append(additions, binding)
+			continue
+		}
+
+		switch strategy {
+		case ConflictError:
+			conflicts = append(conflicts, mergeConflict(binding, existing))
+		case ConflictKeepExisting:
+			// Skip
+		case ConflictReplace:
+			additions = append(additions, binding)
+		default:
+			return fmt.Errorf("unknown conflict strategy: %v", strategy)
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return fmt.Errorf("merge failed: %w", errors.Join(conflicts...))
+	}
+
+	var rebound []reflect.Type
+	for _, binding := range additions {
+		existing, replaced := n.lookupBinding(binding)
+		err := n.storeBinding(binding, existing, func() error {
+			_, err := n.registry.Replace(binding)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		n.singletonCache.remove(keyFor(binding.AbstractType, binding.Name))
+		n.emitRegistered(binding)
+		if replaced && binding.Name == "" {
+			rebound = append(rebound, binding.AbstractType)
+		}
+	}
+	for _, t := range rebound {
+		n.rebound(t)
+	}
+	return nil
+}
+
+// lookupBinding returns this container's binding with the same type and name.
+func (n *Nasc) lookupBinding(binding *registry.Binding) (*registry.Binding, bool) {
+	var existing *registry.Binding
+	var err error
+	if binding.Name != "" {
+		existing, err = n.registry.GetNamed(binding.AbstractType, binding.Name)
+	} else {
+		existing, err = n.registry.Get(binding.AbstractType)
+	}
+	return existing, err == nil
+}
+
+// mergeConflict describes a binding present in both merged containers.
+func mergeConflict(incoming, existing *registry.Binding) error {
+	label := incoming.AbstractType.String()
+	if incoming.Name != "" {
+		label = fmt.Sprintf("%s[%s]", label, incoming.Name)
+	}
+
+	msg := fmt.Sprintf("binding %s exists in both containers", label)
+	if existing.Origin != "" || incoming.Origin != "" {
+		msg += fmt.Sprintf(" (existing from %q, incoming from %q)", existing.Origin, incoming.Origin)
+	}
+	return errors.New(msg)
+}
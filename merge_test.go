@@ -0,0 +1,84 @@
+package nasc
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMerge_AddsBindings(t *testing.T) {
+	app := New()
+	_ = app.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	subsystem := New()
+	_ = subsystem.Bind((*Database)(nil), &MockDB{})
+	_ = subsystem.BindNamed((*NotificationService)(nil), &EmailNotifier{}, "email")
+
+	if err := app.Merge(subsystem, ConflictError); err != nil {
+		t.Fatalf("Merge failed: %v", err)
+	}
+	if _, err := app.MakeSafe((*Database)(nil)); err != nil {
+		t.Errorf("merged binding should resolve: %v", err)
+	}
+	if _, ok := app.MakeNamed((*NotificationService)(nil), "email").(*EmailNotifier); !ok {
+		t.Error("merged named binding should resolve")
+	}
+}
+
+func TestMerge_ConflictStrategies(t *testing.T) {
+	newApp := func() *Nasc {
+		app := New()
+		_ = app.Bind((*Logger)(nil), &ConsoleLogger{})
+		return app
+	}
+	other := New()
+	_ = other.Bind((*Logger)(nil), &FileLogger{})
+	_ = other.Bind((*Database)(nil), &MockDB{})
+
+	t.Run("error", func(t *testing.T) {
+		app := newApp()
+		err := app.Merge(other, ConflictError)
+		if err == nil || !strings.Contains(err.Error(), "nasc.Logger exists in both containers") {
+			t.Fatalf("expected conflict error, got %v", err)
+		}
+		if app.registry.Has(typeOfToken((*Database)(nil))) {
+			t.Error("failed merge must not change the container")
+		}
+	})
+
+	t.Run("keep-existing", func(t *testing.T) {
+		app := newApp()
+		if err := app.Merge(other, ConflictKeepExisting); err != nil {
+			t.Fatalf("Merge failed: %v", err)
+		}
+		if _, ok := app.Make((*Logger)(nil)).(*ConsoleLogger); !ok {
+			t.Error("existing binding should be kept")
+		}
+		if !app.registry.Has(typeOfToken((*Database)(nil))) {
+			t.Error("non-conflicting bindings should be merged")
+		}
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		app := newApp()
+		if err := app.Merge(other, ConflictReplace); err != nil {
+			t.Fatalf("Merge failed: %v", err)
+		}
+		if _, ok := app.Make((*Logger)(nil)).(*FileLogger); !ok {
+			t.Error("incoming binding should replace the existing one")
+		}
+	})
+}
+
+func TestMerge_ReplaceEvictsSingleton(t *testing.T) {
+	app := New()
+	_ = app.Singleton((*Logger)(nil), &ConsoleLogger{})
+	app.Make((*Logger)(nil))
+
+	other := New()
+	_ = other.Singleton((*Logger)(nil), &FileLogger{})
+
+	_ = app.Merge(other, ConflictReplace)
+	if _, ok := app.Make((*Logger)(nil)).(*FileLogger); !ok {
+		t.Error("cached singleton should be evicted on replace")
+	}
+}
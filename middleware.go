@@ -0,0 +1,150 @@
+package nasc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// Resolution describes one resolution passing through resolution
+// middleware.
+type Resolution struct {
+	// Type is the requested type, e.g. Logger for Make((*Logger)(nil))
+	Type reflect.Type
+
+	// Name is the binding name for named resolutions, or ""
+	Name string
+
+	// Scope is the scope resolving, or nil for the container
+	Scope *Scope
+
+	// Context is the scope's context, or context.Background()
+	Context context.Context
+}
+
+// Resolver resolves a Resolution. The Resolver passed to middleware
+// performs the actual resolution for whatever Resolution it is given.
+type Resolver func(r Resolution) (interface{}, error)
+
+// UseResolutionMiddleware wraps every resolution by Make, MakeNamed,
+// MakeSafe, MakeNamedSafe and MakeType on the container and its scopes,
+// including the resolution of each dependency those trigger. Middleware
+// may inspect the request, short-circuit it with its own instance or
+// error, or call next and wrap the result, for per-type metrics, access
+// control or lazy proxying. The first middleware added is outermost.
+//
+// Validation does not run middleware. Errors from middleware surface as
+// errors from the Safe variants and as panics from the others.
+//
+// Example:
+//
+//	container.UseResolutionMiddleware(func(next nasc.Resolver) nasc.Resolver {
+//	    return func(r nasc.Resolution) (interface{}, error) {
+//	        start := time.Now()
+//	        instance, err := next(r)
+//	        metrics.Observe(r.Type.String(), time.Since(start))
+//	        return instance, err
+//	    }
+//	})
+func (n *Nasc) UseResolutionMiddleware(middleware func(next Resolver) Resolver) {
+	if middleware == nil {
+		panic("resolution middleware cannot be nil")
+	}
+
+	n.middleware.mu.Lock()
+	defer n.middleware.mu.Unlock()
+
+	var chain []func(Resolver) Resolver
+	if current := n.middleware.chain.Load(); current != nil {
+		chain = append(chain, *current...)
+	}
+	chain = append(chain, middleware)
+	n.middleware.chain.Store(&chain)
+}
+
+// middlewareChain holds the resolution middleware. The chain is replaced
+// rather than modified, so resolutions read it without locking.
+type middlewareChain struct {
+	mu    sync.Mutex
+	chain atomic.Pointer[[]func(Resolver) Resolver]
+}
+
+// snapshot returns the registered middleware, for Clone.
+func (mc *middlewareChain) snapshot() []func(Resolver) Resolver {
+	if current := mc.chain.Load(); current != nil {
+		return append([]func(Resolver) Resolver(nil), *current...)
+	}
+	return nil
+}
+
+// hasMiddleware reports whether any resolution middleware is registered.
+func (n *Nasc) hasMiddleware() bool {
+	return n.middleware.chain.Load() != nil
+}
+
+// intercept runs r through the middleware chain, ending in terminal.
+func (n *Nasc) intercept(r Resolution, terminal Resolver) (interface{}, error) {
+	if r.Context == nil {
+		r.Context = context.Background()
+	}
+
+	resolver := terminal
+	if current := n.middleware.chain.Load(); current != nil {
+		chain := *current
+		for i := len(chain) - 1; i >= 0; i-- {
+			resolver = chain[i](resolver)
+		}
+	}
+	return resolver(r)
+}
+
+// resolutionPanic carries a panic raised by the panicking resolution
+// methods through middleware, so it can be re-raised unchanged.
+type resolutionPanic struct {
+	value interface{}
+}
+
+func (p *resolutionPanic) Error() string {
+	return fmt.Sprint(p.value)
+}
+
+// mustIntercept resolves r through the middleware with the panicking
+// resolution methods, re-raising their panics and panicking on middleware
+// errors as Make does.
+func (n *Nasc) mustIntercept(r Resolution) interface{} {
+	instance, err := n.intercept(r, n.resolveDirect)
+	if err != nil {
+		var p *resolutionPanic
+		if errors.As(err, &p) {
+			panic(p.value)
+		}
+		panic(fmt.Sprintf("failed to resolve type %v: %v", r.Type, err))
+	}
+	return instance
+}
+
+// resolveDirect is the terminal Resolver of the panicking resolution
+// methods. It resolves r in its scope, or the container, converting a
+// panic into a *resolutionPanic error.
+func (n *Nasc) resolveDirect(r Resolution) (instance interface{}, err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = &resolutionPanic{value: v}
+		}
+	}()
+
+	token := reflect.Zero(reflect.PointerTo(r.Type)).Interface()
+	switch {
+	case r.Scope != nil && r.Name != "":
+		return r.Scope.makeNamedDirect(token, r.Name), nil
+	case r.Scope != nil:
+		return r.Scope.makeDirect(token), nil
+	case r.Name != "":
+		return n.makeNamedDirect(token, r.Name), nil
+	default:
+		return n.makeDirect(token), nil
+	}
+}
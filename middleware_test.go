@@ -0,0 +1,138 @@
+package nasc
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestUseResolutionMiddleware(t *testing.T) {
+	c := New()
+	_ = c.Bind((*Logger)(nil), &ConsoleLogger{})
+	_ = c.BindConstructor((*Consumer)(nil), func(l Logger) *recordingConsumer {
+		return &recordingConsumer{}
+	})
+
+	var mu sync.Mutex
+	var order []string
+	record := func(label string) func(Resolver) Resolver {
+		return func(next Resolver) Resolver {
+			return func(r Resolution) (interface{}, error) {
+				mu.Lock()
+				order = append(order, label+":"+r.Type.Name())
+				mu.Unlock()
+				return next(r)
+			}
+		}
+	}
+	c.UseResolutionMiddleware(record("outer"))
+	c.UseResolutionMiddleware(record("inner"))
+
+	c.Make((*Consumer)(nil))
+
+	want := []string{"outer:Consumer", "inner:Consumer", "outer:Logger", "inner:Logger"}
+	if !reflect.DeepEqual(order, want) {
+		t.Errorf("middleware order = %v, want %v", order, want)
+	}
+}
+
+func TestUseResolutionMiddleware_Request(t *testing.T) {
+	c := New()
+	_ = c.BindNamed((*Logger)(nil), &FileLogger{}, "file")
+	_ = c.Scoped((*Database)(nil), &MockDB{})
+
+	var seen []Resolution
+	c.UseResolutionMiddleware(func(next Resolver) Resolver {
+		return func(r Resolution) (interface{}, error) {
+			seen = append(seen, r)
+			return next(r)
+		}
+	})
+
+	c.MakeNamed((*Logger)(nil), "file")
+	scope := c.CreateScope()
+	defer scope.Dispose()
+	scope.Make((*Database)(nil))
+
+	if len(seen) != 2 {
+		t.Fatalf("middleware saw %d resolutions, want 2", len(seen))
+	}
+	if seen[0].Name != "file" || seen[0].Scope != nil || seen[0].Context == nil {
+		t.Errorf("named resolution = %+v", seen[0])
+	}
+	if seen[1].Scope != scope || seen[1].Context != scope.Context() {
+		t.Errorf("scoped resolution = %+v", seen[1])
+	}
+}
+
+func TestUseResolutionMiddleware_ShortCircuit(t *testing.T) {
+	c := New()
+	_ = c.Bind((*Logger)(nil), &ConsoleLogger{})
+	_ = c.Bind((*Database)(nil), &MockDB{})
+
+	denied := errors.New("access denied")
+	proxy := &FileLogger{}
+	c.UseResolutionMiddleware(func(next Resolver) Resolver {
+		return func(r Resolution) (interface{}, error) {
+			switch r.Type {
+			case reflect.TypeOf((*Database)(nil)).Elem():
+				return nil, denied
+			case reflect.TypeOf((*Logger)(nil)).Elem():
+				return proxy, nil
+			}
+			return next(r)
+		}
+	})
+
+	if c.Make((*Logger)(nil)) != proxy {
+		t.Error("middleware result was not returned")
+	}
+	if _, err := c.MakeSafe((*Database)(nil)); !errors.Is(err, denied) {
+		t.Errorf("MakeSafe() error = %v, want %v", err, denied)
+	}
+
+	defer func() {
+		if r := recover(); r == nil || !strings.Contains(r.(string), "access denied") {
+			t.Errorf("Make() panic = %v, want access denied", r)
+		}
+	}()
+	c.Make((*Database)(nil))
+}
+
+func TestUseResolutionMiddleware_PreservesPanics(t *testing.T) {
+	c := New()
+	c.UseResolutionMiddleware(func(next Resolver) Resolver { return next })
+
+	defer func() {
+		r := recover()
+		if msg, ok := r.(string); !ok || !strings.HasPrefix(msg, "binding not found") {
+			t.Errorf("Make() panic = %v, want the original panic", r)
+		}
+	}()
+	c.Make((*Logger)(nil))
+}
+
+func TestUseResolutionMiddleware_SkippedByValidate(t *testing.T) {
+	c := New()
+	_ = c.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	calls := 0
+	c.UseResolutionMiddleware(func(next Resolver) Resolver {
+		return func(r Resolution) (interface{}, error) {
+			calls++
+			return next(r)
+		}
+	})
+
+	if err := c.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if calls != 0 {
+		t.Errorf("Validate() ran middleware %d times", calls)
+	}
+	if _, err := c.Clone().MakeSafe((*Logger)(nil)); err != nil || calls != 1 {
+		t.Errorf("Clone() did not keep the middleware: err = %v, calls = %d", err, calls)
+	}
+}
@@ -0,0 +1,405 @@
+package nasc
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// ModuleItem is one registration bundled into a module: a binding,
+// constructor, decorator, custom registration or nested module.
+type ModuleItem interface {
+	apply(c *Nasc) error
+}
+
+// moduleFunc is a ModuleItem backed by a registration function.
+type moduleFunc func(c *Nasc) error
+
+func (f moduleFunc) apply(c *Nasc) error {
+	return f(c)
+}
+
+// ModuleDef is a named group of registrations created by Module. Its
+// bindings record the module name as their origin, so they can be
+// inspected with FromOrigin, validated with ValidateModule and swapped as
+// a whole with ReplaceModule.
+type ModuleDef struct {
+	name  string
+	items []ModuleItem
+}
+
+// Module bundles bindings, constructors, decorators and nested modules
+// under a name. Register it with RegisterModule.
+//
+// Example:
+//
+//	var Database = nasc.Module("database",
+//	    nasc.Binding((*Database)(nil), &PostgresDB{}, nasc.WithLifetime(nasc.LifetimeSingleton)),
+//	    nasc.Constructor((*UserRepository)(nil), NewUserRepository, nasc.LifetimeTransient),
+//	    nasc.Decorator((*Database)(nil), WithQueryLogging),
+//	)
+//
+//	var App = nasc.Module("app", Database, Cache, HTTP)
+func Module(name string, items ...ModuleItem) *ModuleDef {
+	return &ModuleDef{name: name, items: items}
+}
+
+// Name returns the module's name.
+func (m *ModuleDef) Name() string {
+	return m.name
+}
+
+// apply registers the module's items with its name as their origin.
+func (m *ModuleDef) apply(c *Nasc) error {
+	c = c.withOrigin(m.name)
+
+	for _, item := range m.items {
+		if item == nil {
+			return fmt.Errorf("module %q: item cannot be nil", m.name)
+		}
+		if err := item.apply(c); err != nil {
+			if _, nested := item.(*ModuleDef); nested {
+				return err
+			}
+			return fmt.Errorf("module %q: %w", m.name, err)
+		}
+	}
+	return nil
+}
+
+// names returns the names of the module and every module nested in it.
+func (m *ModuleDef) names() []string {
+	names := []string{m.name}
+	for _, item := range m.items {
+		if nested, ok := item.(*ModuleDef); ok {
+			names = append(names, nested.names()...)
+		}
+	}
+	return names
+}
+
+// Binding is a module item binding concreteType to abstractType with the
+// given options, like As. The default lifetime is transient.
+func Binding(abstractType, concreteType interface{}, opts ...BindOption) ModuleItem {
+	return moduleFunc(func(c *Nasc) error {
+		options := bindOptions{lifetime: LifetimeTransient}
+		for _, opt := range opts {
+			opt(&options)
+		}
+		return c.bindTokens(abstractType, concreteType, options)
+	})
+}
+
+// Constructor is a module item registering constructor for abstractType
+// with the given lifetime, like BindConstructor and its variants.
+func Constructor(abstractType interface{}, constructor ConstructorFunc, lifetime Lifetime, opts ...ConstructorOption) ModuleItem {
+	return moduleFunc(func(c *Nasc) error {
+		if ttl, ok := cachedTTL(lifetime); ok {
+			return c.CachedConstructor(abstractType, constructor, ttl, opts...)
+		}
+		switch lifetime {
+		case LifetimeTransient:
+			return c.BindConstructor(abstractType, constructor, opts...)
+		case LifetimeSingleton:
+			return c.SingletonConstructor(abstractType, constructor, opts...)
+		case LifetimeScoped:
+			return c.ScopedConstructor(abstractType, constructor, opts...)
+		default:
+			return &InvalidBindingError{Reason: fmt.Sprintf("the %s lifetime is not supported for constructors", lifetime)}
+		}
+	})
+}
+
+// Decorator is a module item wrapping abstractType's instances with
+// decoratorFunc, like Decorate.
+func Decorator(abstractType interface{}, decoratorFunc interface{}) ModuleItem {
+	return moduleFunc(func(c *Nasc) error {
+		return c.Decorate(abstractType, decoratorFunc)
+	})
+}
+
+// Register is a module item running a custom registration function, for
+// anything the other items do not cover.
+func Register(fn func(c *Nasc) error) ModuleItem {
+	return moduleFunc(fn)
+}
+
+// moduleSet tracks the modules registered with a container by name.
+type moduleSet struct {
+	mu     sync.Mutex
+	byName map[string]*ModuleDef
+}
+
+// RegisterModule registers each module's items, recording the module's
+// name as the origin of its bindings. Module names, including those of
+// nested modules, must be unique within the container.
+//
+// Example:
+//
+//	if err := container.RegisterModule(Database, Cache); err != nil {
+//	    log.Fatal(err)
+//	}
+func (n *Nasc) RegisterModule(modules ...*ModuleDef) error {
+	if n.IsFrozen() {
+		return ErrContainerFrozen
+	}
+
+	for _, m := range modules {
+		if m == nil {
+			return fmt.Errorf("module cannot be nil")
+		}
+		if err := n.claimModuleNames(m); err != nil {
+			return err
+		}
+		end := n.trace(TimelineProviderRegister, "module "+m.name)
+		err := m.apply(n)
+		end(err)
+		if err != nil {
+			// Drop what was registered so the module can be registered again
+			n.disposeModule(n.unregisterModule(m.names()))
+			return fmt.Errorf("module registration failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// claimModuleNames records a module and its nested modules, failing if a
+// name is empty or already registered.
+func (n *Nasc) claimModuleNames(m *ModuleDef) error {
+	n.modules.mu.Lock()
+	defer n.modules.mu.Unlock()
+
+	names := m.names()
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if name == "" {
+			return fmt.Errorf("module name cannot be empty")
+		}
+		if _, exists := n.modules.byName[name]; exists || seen[name] {
+			return fmt.Errorf("module %q is already registered", name)
+		}
+		seen[name] = true
+	}
+
+	if n.modules.byName == nil {
+		n.modules.byName = make(map[string]*ModuleDef)
+	}
+	var claim func(m *ModuleDef)
+	claim = func(m *ModuleDef) {
+		n.modules.byName[m.name] = m
+		for _, item := range m.items {
+			if nested, ok := item.(*ModuleDef); ok {
+				claim(nested)
+			}
+		}
+	}
+	claim(m)
+	return nil
+}
+
+// snapshot copies the registered modules, for Clone.
+func (ms *moduleSet) snapshot() map[string]*ModuleDef {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	byName := make(map[string]*ModuleDef, len(ms.byName))
+	for name, m := range ms.byName {
+		byName[name] = m
+	}
+	return byName
+}
+
+// module returns the registered module with the given name.
+func (n *Nasc) module(name string) (*ModuleDef, error) {
+	n.modules.mu.Lock()
+	defer n.modules.mu.Unlock()
+
+	m, ok := n.modules.byName[name]
+	if !ok {
+		return nil, fmt.Errorf("module %q is not registered", name)
+	}
+	return m, nil
+}
+
+// moduleBindings returns the bindings registered by the named modules.
+func (n *Nasc) moduleBindings(names []string) []*registry.Binding {
+	origins := make(map[string]bool, len(names))
+	for _, name := range names {
+		origins[name] = true
+	}
+
+	var bindings []*registry.Binding
+	for _, b := range n.sortedBindings() {
+		if origins[b.Origin] {
+			bindings = append(bindings, b)
+		}
+	}
+	return bindings
+}
+
+// ValidateModule resolves every binding registered by a module and its
+// nested modules, like Validate, so a module can be checked in isolation.
+// Dependencies outside the module must still be bound.
+//
+// Example:
+//
+//	if err := container.ValidateModule("database"); err != nil {
+//	    t.Fatal(err)
+//	}
+func (n *Nasc) ValidateModule(name string) error {
+	m, err := n.module(name)
+	if err != nil {
+		return err
+	}
+
+	if errs := n.validateRoots(n.moduleBindings(m.names())); len(errs) > 0 {
+		return &ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// ReplaceModule swaps a registered module, with its nested modules, for
+// replacement, which must have the same name. The old module's bindings,
+// decorators and created singletons are removed before the replacement is
+// registered, so tests can substitute a whole subsystem at once. Once the
+// replacement is registered, the old singletons are stopped and disposed;
+// if it fails, the old module is restored instead.
+//
+// Example:
+//
+//	container.ReplaceModule(nasc.Module("database",
+//	    nasc.Binding((*Database)(nil), &InMemoryDB{}, nasc.WithLifetime(nasc.LifetimeSingleton)),
+//	))
+func (n *Nasc) ReplaceModule(replacement *ModuleDef) error {
+	if replacement == nil {
+		return fmt.Errorf("module cannot be nil")
+	}
+	if n.IsFrozen() {
+		return ErrContainerFrozen
+	}
+	old, err := n.module(replacement.name)
+	if err != nil {
+		return err
+	}
+
+	removed := n.unregisterModule(old.names())
+	if err := n.RegisterModule(replacement); err != nil {
+		n.restoreModule(removed)
+		return err
+	}
+	n.disposeModule(removed)
+
+	for _, b := range removed.bindings {
+		if b.Name == "" && n.registry.Has(b.AbstractType) {
+			n.rebound(b.AbstractType)
+		}
+	}
+	return nil
+}
+
+// removedModule holds what unregisterModule removed, so it can be restored
+// or disposed.
+type removedModule struct {
+	modules    map[string]*ModuleDef
+	bindings   []*registry.Binding
+	decorators map[reflect.Type][]*decorator
+	singletons map[singletonKey]*singletonInstance
+}
+
+// unregisterModule removes the bindings and decorators registered by the
+// named modules, evicts the singletons created for those bindings and
+// releases the names.
+func (n *Nasc) unregisterModule(names []string) *removedModule {
+	origins := make(map[string]bool, len(names))
+	for _, name := range names {
+		origins[name] = true
+	}
+
+	removed := &removedModule{
+		modules:    make(map[string]*ModuleDef, len(names)),
+		bindings:   n.moduleBindings(names),
+		singletons: make(map[singletonKey]*singletonInstance),
+	}
+	for _, b := range removed.bindings {
+		if b.Name == "" {
+			n.registry.Remove(b.AbstractType)
+		} else {
+			n.registry.RemoveNamed(b.AbstractType, b.Name)
+		}
+		key := keyFor(b.AbstractType, b.Name)
+		if instance := n.singletonCache.take(key); instance != nil {
+			removed.singletons[key] = instance
+		}
+	}
+	removed.decorators = n.decorators.removeOrigins(origins)
+
+	n.modules.mu.Lock()
+	for _, name := range names {
+		if m, ok := n.modules.byName[name]; ok {
+			removed.modules[name] = m
+			delete(n.modules.byName, name)
+		}
+	}
+	n.modules.mu.Unlock()
+	return removed
+}
+
+// restoreModule registers again what unregisterModule removed.
+func (n *Nasc) restoreModule(removed *removedModule) {
+	for _, b := range removed.bindings {
+		var err error
+		if b.Name == "" {
+			err = n.registry.Register(b)
+		} else {
+			err = n.registry.RegisterNamed(b)
+		}
+		if err == nil {
+			n.emitRegistered(b)
+		}
+	}
+	for key, instance := range removed.singletons {
+		n.singletonCache.put(key, instance)
+	}
+	n.decorators.restore(removed.decorators)
+
+	n.modules.mu.Lock()
+	if n.modules.byName == nil {
+		n.modules.byName = make(map[string]*ModuleDef)
+	}
+	for name, m := range removed.modules {
+		n.modules.byName[name] = m
+	}
+	n.modules.mu.Unlock()
+}
+
+// disposeModule stops, then disposes, the singletons evicted by
+// unregisterModule, newest first, as Shutdown does. Failures are reported
+// since the caller is not responsible for them.
+func (n *Nasc) disposeModule(removed *removedModule) {
+	var created []*singletonInstance
+	for _, instance := range removed.singletons {
+		if instance.created.Load() && instance.err == nil {
+			created = append(created, instance)
+		}
+	}
+	sort.Slice(created, func(i, j int) bool { return created[i].seq > created[j].seq })
+
+	ctx := context.Background()
+	for _, instance := range created {
+		if stoppable, ok := instance.value.(Stoppable); ok {
+			if err := stoppable.Stop(ctx); err != nil {
+				n.reportError("stop", reflect.TypeOf(instance.value), err)
+			}
+		}
+	}
+	resolver := newTeardownResolver(n, nil)
+	for _, instance := range created {
+		if err := disposeInstance(ctx, instance.value, resolver); err != nil {
+			n.reportError("dispose", reflect.TypeOf(instance.value), err)
+		}
+	}
+}
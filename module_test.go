@@ -0,0 +1,183 @@
+package nasc
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func loggingModule() *ModuleDef {
+	return Module("logging",
+		Binding((*Logger)(nil), &ConsoleLogger{}, WithLifetime(LifetimeSingleton)),
+		Decorator((*Logger)(nil), func(inner Logger) Logger {
+			return &prefixLogger{inner: inner, prefix: "log:"}
+		}),
+	)
+}
+
+func TestRegisterModule(t *testing.T) {
+	c := New()
+	app := Module("app",
+		loggingModule(),
+		Constructor((*Consumer)(nil), func(l Logger) *recordingConsumer {
+			return &recordingConsumer{}
+		}, LifetimeTransient),
+		Register(func(c *Nasc) error {
+			return c.BindNamed((*Database)(nil), &MockDB{}, "primary")
+		}),
+	)
+
+	if err := c.RegisterModule(app); err != nil {
+		t.Fatalf("RegisterModule() error = %v", err)
+	}
+
+	if _, ok := c.Make((*Logger)(nil)).(*prefixLogger); !ok {
+		t.Error("module decorator should wrap the logger")
+	}
+	if _, ok := c.Make((*Consumer)(nil)).(*recordingConsumer); !ok {
+		t.Error("module constructor should be registered")
+	}
+	if !c.FromOrigin("logging").Has((*Logger)(nil)) {
+		t.Error("nested module bindings should record the nested module as origin")
+	}
+	if !c.FromOrigin("app").Has((*Consumer)(nil)) {
+		t.Error("module bindings should record the module as origin")
+	}
+	if len(c.FromOrigin("app").Bindings()) != 2 {
+		t.Errorf("app origin has %d bindings, want 2", len(c.FromOrigin("app").Bindings()))
+	}
+}
+
+func TestRegisterModule_Errors(t *testing.T) {
+	c := New()
+	if err := c.RegisterModule(loggingModule()); err != nil {
+		t.Fatalf("RegisterModule() error = %v", err)
+	}
+	if err := c.RegisterModule(Module("other", loggingModule())); err == nil || !strings.Contains(err.Error(), `"logging"`) {
+		t.Errorf("duplicate nested module name error = %v", err)
+	}
+	if err := c.RegisterModule(Module("")); err == nil {
+		t.Error("an empty module name should fail")
+	}
+
+	err := c.RegisterModule(Module("broken", Binding((*Database)(nil), &ConsoleLogger{})))
+	var invalid *InvalidBindingError
+	if !errors.As(err, &invalid) || !strings.Contains(err.Error(), `module "broken"`) {
+		t.Errorf("RegisterModule() error = %v, want the module's InvalidBindingError", err)
+	}
+}
+
+func TestValidateModule(t *testing.T) {
+	c := New()
+	_ = c.RegisterModule(loggingModule())
+	_ = c.RegisterModule(Module("storage",
+		Constructor((*Consumer)(nil), func(db Database) *recordingConsumer {
+			return &recordingConsumer{}
+		}, LifetimeTransient),
+	))
+
+	if err := c.ValidateModule("logging"); err != nil {
+		t.Errorf("ValidateModule(logging) error = %v", err)
+	}
+	var validation *ValidationError
+	if err := c.ValidateModule("storage"); !errors.As(err, &validation) || len(validation.Errors) != 1 {
+		t.Errorf("ValidateModule(storage) error = %v, want one validation error", err)
+	}
+	if err := c.ValidateModule("missing"); err == nil {
+		t.Error("validating an unknown module should fail")
+	}
+}
+
+func TestReplaceModule(t *testing.T) {
+	base := New()
+	_ = base.RegisterModule(Module("app", loggingModule()))
+	base.Make((*Logger)(nil))
+
+	c := base.Clone()
+	var rebound interface{}
+	_ = c.OnRebind((*Logger)(nil), func(instance interface{}, _ *Nasc) { rebound = instance })
+
+	fake := Module("logging", Binding((*Logger)(nil), &FileLogger{}))
+	if err := c.ReplaceModule(fake); err != nil {
+		t.Fatalf("ReplaceModule() error = %v", err)
+	}
+
+	logger := c.Make((*Logger)(nil))
+	if _, ok := logger.(*FileLogger); !ok {
+		t.Errorf("Make() = %T, want the replacement without the old decorator", logger)
+	}
+	if _, ok := rebound.(*FileLogger); !ok {
+		t.Errorf("OnRebind saw %T, want *FileLogger", rebound)
+	}
+	if _, ok := base.Make((*Logger)(nil)).(*prefixLogger); !ok {
+		t.Error("replacing a module in a clone should not affect the original")
+	}
+
+	if err := c.ReplaceModule(Module("missing")); err == nil {
+		t.Error("replacing an unknown module should fail")
+	}
+}
+
+func TestRegisterModule_RetryAfterFailure(t *testing.T) {
+	c := New()
+	fail := true
+	storage := func() *ModuleDef {
+		return Module("storage",
+			Binding((*Database)(nil), &MockDB{}),
+			Register(func(*Nasc) error {
+				if fail {
+					return errors.New("boom")
+				}
+				return nil
+			}),
+		)
+	}
+
+	if err := c.RegisterModule(storage()); err == nil {
+		t.Fatal("expected the failing module to be rejected")
+	}
+	if c.registry.Has(typeOfToken((*Database)(nil))) {
+		t.Error("a failed module should leave no bindings behind")
+	}
+
+	fail = false
+	if err := c.RegisterModule(storage()); err != nil {
+		t.Errorf("retrying a failed module should succeed, got %v", err)
+	}
+}
+
+func TestReplaceModule_FailureRestoresOld(t *testing.T) {
+	c := New()
+	_ = c.RegisterModule(loggingModule())
+	logger := c.Make((*Logger)(nil))
+
+	broken := Module("logging",
+		Binding((*Logger)(nil), &FileLogger{}),
+		Register(func(*Nasc) error { return errors.New("boom") }),
+	)
+	if err := c.ReplaceModule(broken); err == nil {
+		t.Fatal("expected the failing replacement to be rejected")
+	}
+
+	if c.Make((*Logger)(nil)) != logger {
+		t.Error("the old module and its singleton should be restored")
+	}
+	if err := c.ReplaceModule(Module("logging", Binding((*Logger)(nil), &FileLogger{}))); err != nil {
+		t.Errorf("the restored module should still be replaceable, got %v", err)
+	}
+}
+
+func TestReplaceModule_DisposesOldSingletons(t *testing.T) {
+	c := New()
+	_ = c.RegisterModule(Module("logging",
+		Binding((*Logger)(nil), &traceLogger{}, WithLifetime(LifetimeSingleton)),
+	))
+	old := c.Make((*Logger)(nil)).(*traceLogger)
+
+	if err := c.ReplaceModule(Module("logging", Binding((*Logger)(nil), &FileLogger{}))); err != nil {
+		t.Fatalf("ReplaceModule() error = %v", err)
+	}
+	if !old.disposed {
+		t.Error("the replaced module's singleton should be disposed")
+	}
+}
@@ -0,0 +1,134 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// BindMulti registers each value returned by constructor under its own
+// type, so outputs that are created together do not need an aggregate
+// struct. Pointer results are registered like BindConstructor registers
+// them, under the type they point to; interface results under the
+// interface. A trailing error result is optional. Parameters are resolved
+// as for BindConstructor.
+//
+// The constructor runs once, on the first resolution of any of its
+// results, and all results are served from that call, so they behave as
+// singletons. The bindings themselves are factory bindings.
+//
+// Registration is all-or-nothing: if any result's binding already exists,
+// nothing is registered.
+//
+// Example:
+//
+//	// Where: func NewServer(cfg Config) (*Server, *HealthHandler, error)
+//	container.BindMulti(NewServer)
+//
+//	server := container.Make((*Server)(nil)).(*Server)
+//	health := container.Make((*HealthHandler)(nil)).(*HealthHandler)
+func (n *Nasc) BindMulti(constructor ConstructorFunc) error {
+	info, err := parseMultiConstructor(constructor)
+	if err != nil {
+		return &InvalidBindingError{Reason: fmt.Sprintf("invalid constructor: %v", err)}
+	}
+
+	result := &sharedResult{info: info}
+	numResults := info.fnType.NumOut()
+	if info.returnsError {
+		numResults--
+	}
+
+	bindings := make([]*registry.Binding, numResults)
+	seen := make(map[reflect.Type]bool, numResults)
+	for i := range bindings {
+		resultT := info.fnType.Out(i)
+		abstractT := resultT
+		if resultT.Kind() == reflect.Ptr {
+			abstractT = resultT.Elem()
+		}
+
+		if seen[abstractT] {
+			return &InvalidBindingError{
+				Reason: fmt.Sprintf("constructor returns %v more than once", abstractT),
+			}
+		}
+		seen[abstractT] = true
+
+		bindings[i] = &registry.Binding{
+			AbstractType: abstractT,
+			Lifetime:     string(LifetimeFactory),
+			Factory:      multiResultFactory(result, i),
+		}
+		if _, exists := n.lookupBinding(bindings[i]); exists {
+			return &InvalidBindingError{
+				Reason: fmt.Sprintf("binding already exists for %v", abstractT),
+			}
+		}
+	}
+
+	for _, binding := range bindings {
+		if err := n.register(binding); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// multiResultFactory returns a factory serving result i of a shared constructor call.
+func multiResultFactory(result *sharedResult, i int) FactoryFunc {
+	return func(c *Nasc) (interface{}, error) {
+		values, err := result.get(c)
+		if err != nil {
+			return nil, err
+		}
+		return values[i].Interface(), nil
+	}
+}
+
+// parseMultiConstructor analyzes a constructor returning several values.
+func parseMultiConstructor(constructor ConstructorFunc) (*constructorInfo, error) {
+	if constructor == nil {
+		return nil, fmt.Errorf("constructor cannot be nil")
+	}
+
+	fnValue := reflect.ValueOf(constructor)
+	fnType := fnValue.Type()
+	if fnType.Kind() != reflect.Func {
+		return nil, fmt.Errorf("constructor must be a function, got %v", fnType.Kind())
+	}
+
+	numOut := fnType.NumOut()
+	returnsError := numOut > 0 && fnType.Out(numOut-1) == errorType
+	numResults := numOut
+	if returnsError {
+		numResults--
+	}
+	if numResults == 0 {
+		return nil, fmt.Errorf("constructor must return at least one value besides error")
+	}
+	for i := 0; i < numResults; i++ {
+		resultT := fnType.Out(i)
+		if resultT == errorType {
+			return nil, fmt.Errorf("error must be the last return value")
+		}
+		if resultT.Kind() != reflect.Ptr && resultT.Kind() != reflect.Interface {
+			return nil, fmt.Errorf("return value %d must be a pointer or interface, got %v", i, resultT)
+		}
+	}
+
+	paramTypes := make([]reflect.Type, fnType.NumIn())
+	for i := range paramTypes {
+		paramTypes[i] = fnType.In(i)
+	}
+
+	return &constructorInfo{
+		fn:           fnValue,
+		fnType:       fnType,
+		paramTypes:   paramTypes,
+		returnsError: returnsError,
+		returnType:   fnType.Out(0),
+		numParams:    len(paramTypes),
+	}, nil
+}
@@ -0,0 +1,75 @@
+package nasc
+
+import (
+	"errors"
+	"testing"
+)
+
+type multiServer struct{ logger Logger }
+type multiHealth struct{ server *multiServer }
+
+func newMultiServer(logger Logger) (*multiServer, *multiHealth, Database, error) {
+	server := &multiServer{logger: logger}
+	return server, &multiHealth{server: server}, &MockDB{}, nil
+}
+
+func TestBindMulti_RegistersEachResult(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+	if err := container.BindMulti(newMultiServer); err != nil {
+		t.Fatalf("BindMulti failed: %v", err)
+	}
+
+	server := container.Make((*multiServer)(nil)).(*multiServer)
+	health := container.Make((*multiHealth)(nil)).(*multiHealth)
+	if server.logger == nil {
+		t.Error("constructor parameters should be resolved")
+	}
+	if health.server != server {
+		t.Error("results should come from the same constructor call")
+	}
+	if _, ok := container.Make((*Database)(nil)).(*MockDB); !ok {
+		t.Error("interface result should be registered under the interface")
+	}
+}
+
+func TestBindMulti_Error(t *testing.T) {
+	container := New()
+	_ = container.BindMulti(func() (*multiServer, *multiHealth, error) {
+		return nil, nil, errors.New("boom")
+	})
+
+	if _, err := container.MakeSafe((*multiHealth)(nil)); err == nil {
+		t.Error("expected constructor error")
+	}
+}
+
+func TestBindMulti_Invalid(t *testing.T) {
+	container := New()
+	_ = container.Singleton((*Database)(nil), &MockDB{})
+
+	tests := []struct {
+		name        string
+		constructor interface{}
+	}{
+		{"nil", nil},
+		{"not a function", 42},
+		{"only error", func() error { return nil }},
+		{"error not last", func() (error, *multiServer) { return nil, nil }},
+		{"value result", func() (multiServer, *multiHealth) { return multiServer{}, nil }},
+		{"duplicate type", func() (*multiServer, *multiServer) { return nil, nil }},
+		{"existing binding", newMultiServer},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var invalid *InvalidBindingError
+			if err := container.BindMulti(tt.constructor); !errors.As(err, &invalid) {
+				t.Errorf("expected InvalidBindingError, got %v", err)
+			}
+		})
+	}
+
+	if container.registry.Has(typeOfToken((*multiServer)(nil))) {
+		t.Error("failed BindMulti should not register any result")
+	}
+}
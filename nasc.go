@@ -20,17 +20,157 @@ package nasc
 import (
 	"fmt"
 	"reflect"
+	"sync"
+	"time"
 
 	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
 )
 
 // Nasc is the main dependency injection container.
 // It manages bindings and resolves dependencies in a thread-safe manner.
+//
+// Providers receive a handle to the container in Register and Boot: a
+// *Nasc sharing the container's state that also carries what applies to
+// the provider's own registrations, such as its quotas.
 type Nasc struct {
+	*containerState
+
+	// quotas are enforced on bindings registered through this handle (see
+	// RegisterProviderWithQuota)
+	quotas []*quotaState
+
+	// origin is recorded on bindings and decorators registered through this
+	// handle (see FromOrigin)
+	origin string
+}
+
+// containerState is the state shared by a container and its handles.
+type containerState struct {
 	registry        *registry.Registry
 	singletonCache  *singletonCache
+	cached          *cachedStore
 	reflectionCache *reflectionCache
 	providers       []*providerEntry
+
+	// pendingProviders wait for the providers they require (see DependentProvider)
+	pendingProviders []pendingProvider
+
+	// registering holds providers whose Register method is running
+	registering []ServiceProvider
+
+	// providersBooted records that BootProviders has completed
+	providersBooted bool
+
+	// providerMu guards providers, pendingProviders, registering and providersBooted
+	providerMu sync.Mutex
+
+	// lazy holds providers waiting for their types to be resolved (see LazyProvider)
+	lazy lazyProviderSet
+
+	// hosted tracks background services (see AddHostedService)
+	hosted hostedServices
+
+	// shutdownTimeout bounds RunUntilSignal's shutdown (0 = DefaultStopTimeout)
+	shutdownTimeout time.Duration
+
+	// health holds the services checked by CheckLiveness and CheckReadiness
+	health healthChecks
+
+	// useGlobalProviders registers global providers in New (see WithGlobalProviders)
+	useGlobalProviders bool
+
+	contextual *contextualRegistry
+	overrides  *overrideStack
+
+	conditionals  []*conditionalRegistration
+	conditionalMu sync.Mutex
+
+	// timeline records lifecycle steps (nil unless WithTimeline is used)
+	timeline *timeline
+
+	// validationWorkers bounds concurrency in Validate (0 = GOMAXPROCS)
+	validationWorkers int
+
+	// warmUpWorkers bounds concurrency in WarmUp (0 = one at a time)
+	warmUpWorkers int
+
+	// bootWorkers bounds concurrency in BootProviders (0 = one at a time)
+	bootWorkers int
+
+	// profile names the environment the container runs in (see WithProfile)
+	profile string
+
+	// stubs holds permissive stubs for unbound interfaces (see WithStubFallback)
+	stubs *stubRegistry
+
+	// pendingStates holds loaded snapshot state for singletons not yet created
+	pendingStates  map[string][]byte
+	pendingStateMu sync.Mutex
+
+	// errors delivers background lifecycle failures (see Errors and WithOnError)
+	errors *errorSink
+
+	// lockMetrics counts lock contention (nil unless WithLockMetrics is used)
+	lockMetrics *lockMetrics
+
+	// scopeValues extract values from the context of new scopes (see WithScopeValue)
+	scopeValues map[string]ScopeValueExtractor
+
+	// openScopes tracks scopes created by CreateScope until they are disposed
+	openScopes map[*Scope]struct{}
+	scopeSeq   uint64
+	scopesMu   sync.Mutex
+
+	// instances records creation metadata (nil unless WithInstanceTracking is used)
+	instances *instanceTable
+
+	// fallbacks satisfy types without a binding (see WithFallbackResolver)
+	fallbacks []FallbackResolver
+
+	// implicitBinding resolves unbound interfaces from their one registered
+	// implementation (see WithImplicitBinding)
+	implicitBinding bool
+
+	// scopeBudget is applied to new scopes (see WithScopeBudget)
+	scopeBudget ScopeBudget
+
+	// sealingReport receives registration statistics on Freeze (see WithSealingReport)
+	sealingReport func(RegistrationStats)
+
+	// quotaMu makes checking a binding against quotas, storing it and
+	// charging it to them atomic
+	quotaMu sync.Mutex
+
+	// scopeTagCache selects the tag groups scopes memoize (see WithScopeTagCache)
+	scopeTagCache *tagCachePolicy
+
+	// unexportedInjection lets AutoWire set unexported fields (see WithUnexportedInjection)
+	unexportedInjection bool
+
+	// immutability fingerprints singletons (nil unless WithImmutabilityChecks is used)
+	immutability *immutabilityChecker
+
+	// tenants caches the long-lived scope of each tenant (see TenantScope)
+	tenants tenantScopes
+
+	// decorators wrap newly created instances (see Decorate)
+	decorators decoratorSet
+
+	// middleware wraps resolutions (see UseResolutionMiddleware)
+	middleware middlewareChain
+
+	// events delivers lifecycle events to listeners (see Subscribe)
+	events eventBus
+
+	// rebinds run when a default binding is swapped (see OnRebind)
+	rebinds rebindHooks
+
+	// modules are the modules registered by name (see RegisterModule)
+	modules moduleSet
+
+	// scopeHooks run for every new scope (see OnScopeCreated)
+	scopeHooks  []func(*Scope)
+	scopeHookMu sync.Mutex
 }
 
 // New creates a new Nasc container instance.
@@ -42,12 +182,17 @@ type Nasc struct {
 //	// or with options:
 //	container := nasc.New(nasc.WithDebug())
 func New(options ...Option) *Nasc {
-	n := &Nasc{
+	n := &Nasc{containerState: &containerState{
 		registry:        registry.New(),
 		singletonCache:  newSingletonCache(),
+		cached:          newCachedStore(),
 		reflectionCache: newReflectionCache(),
 		providers:       make([]*providerEntry, 0),
-	}
+		contextual:      newContextualRegistry(),
+		overrides:       newOverrideStack(),
+		errors:          newErrorSink(),
+	}}
+	n.singletonCache.onHit = n.singletonHit
 
 	// Apply options
 	for _, opt := range options {
@@ -56,6 +201,12 @@ func New(options ...Option) *Nasc {
 		}
 	}
 
+	if n.useGlobalProviders {
+		if err := n.registerGlobalProviders(); err != nil {
+			panic(fmt.Sprintf("failed to register global providers: %v", err))
+		}
+	}
+
 	return n
 }
 
@@ -103,13 +254,36 @@ func (n *Nasc) Bind(abstractType, concreteType interface{}) error {
 	}
 
 	// Register binding
-	if err := n.registry.Register(binding); err != nil {
+	if err := n.register(binding); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// register stores a default binding, stamping it with the handle's origin.
+// All binding APIs register through here.
+func (n *Nasc) register(binding *registry.Binding) error {
+	binding.Origin = n.origin
+	binding.TTL, _ = cachedTTL(Lifetime(binding.Lifetime))
+	if err := n.storeBinding(binding, nil, func() error { return n.registry.Register(binding) }); err != nil {
+		return err
+	}
+	n.emitRegistered(binding)
+	return nil
+}
+
+// registerNamed stores a named binding, stamping it with the handle's origin.
+func (n *Nasc) registerNamed(binding *registry.Binding) error {
+	binding.Origin = n.origin
+	binding.TTL, _ = cachedTTL(Lifetime(binding.Lifetime))
+	if err := n.storeBinding(binding, nil, func() error { return n.registry.RegisterNamed(binding) }); err != nil {
+		return err
+	}
+	n.emitRegistered(binding)
+	return nil
+}
+
 // Make resolves and returns an instance of the registered type.
 // The abstractType should be an interface pointer like (*Logger)(nil).
 //
@@ -129,6 +303,17 @@ func (n *Nasc) Make(abstractType interface{}) interface{} {
 	if abstractType == nil {
 		panic("cannot resolve nil type")
 	}
+	if !n.hasMiddleware() {
+		return n.makeDirect(abstractType)
+	}
+	return n.mustIntercept(Resolution{Type: typeOfToken(abstractType)})
+}
+
+// makeDirect is Make without resolution middleware.
+func (n *Nasc) makeDirect(abstractType interface{}) interface{} {
+	if abstractType == nil {
+		panic("cannot resolve nil type")
+	}
 
 	// Extract reflect.Type
 	abstractT := reflect.TypeOf(abstractType)
@@ -136,40 +321,100 @@ func (n *Nasc) Make(abstractType interface{}) interface{} {
 		abstractT = abstractT.Elem()
 	}
 
+	if err := n.loadLazyProvider(abstractT); err != nil {
+		panic(fmt.Sprintf("failed to resolve type %v: %v", abstractT, err))
+	}
+
 	// Get binding
 	binding, err := n.registry.Get(abstractT)
 	if err != nil {
+		if instance, ok, aerr := n.ambient(abstractT); ok {
+			if aerr != nil {
+				panic(fmt.Sprintf("failed to resolve type %v: %v", abstractT, aerr))
+			}
+			return instance
+		}
+		if implicit, ok, ierr := n.implicitBindingFor(abstractT); ok {
+			if ierr != nil {
+				panic(fmt.Sprintf("failed to resolve type %v: %v", abstractT, ierr))
+			}
+			return makeImplicit(implicit, n.Make, n.MakeNamed)
+		}
+		if instance, ok, ferr := n.resolveUnbound(abstractT, ""); ok {
+			if ferr != nil {
+				panic(fmt.Sprintf("failed to resolve type %v: %v", abstractT, ferr))
+			}
+			return instance
+		}
 		panic(fmt.Sprintf("binding not found for type %v: %v", abstractT, err))
 	}
 
+	if binding.TTL > 0 {
+		return n.makeCached(binding, abstractT)
+	}
+
 	// Resolve based on lifetime
 	switch Lifetime(binding.Lifetime) {
 	case LifetimeTransient:
 		// Check if this is a constructor binding
 		if binding.Constructor != nil {
 			info := binding.Constructor.(*constructorInfo)
-			instance, err := n.invokeConstructor(info)
+			instance, err := n.invokeConstructor(info, abstractT)
 			if err != nil {
 				panic(fmt.Sprintf("failed to invoke constructor for type %v: %v", abstractT, err))
 			}
+			n.mustInitialize(instance, abstractT)
+			instance = n.mustDecorate(binding, instance)
+			n.stamp(instance, binding, nil, nil)
 			return instance
 		}
 		// Create new instance using reflection
-		instance := reflect.New(binding.ConcreteType.Elem())
-		return instance.Interface()
+		instance := reflect.New(binding.ConcreteType.Elem()).Interface()
+		if binding.AutoWireEnabled {
+			if err := n.autoWire(instance, abstractT); err != nil {
+				panic(fmt.Sprintf("failed to auto-wire instance for type %v: %v", abstractT, err))
+			}
+		}
+		n.mustInitialize(instance, abstractT)
+		instance = n.mustDecorate(binding, instance)
+		n.stamp(instance, binding, nil, nil)
+		return instance
 
 	case LifetimeSingleton:
 		// Get or create singleton
-		instance, err := n.singletonCache.getOrCreate(abstractT, func() (interface{}, error) {
+		instance, err := n.singletonCache.getOrCreate(keyFor(abstractT, ""), n.traceFactory(TimelineSingleton, abstractT.String(), n.restoreFactory(keyFor(abstractT, ""), func() (interface{}, error) {
 			// Check if this is a constructor binding
 			if binding.Constructor != nil {
 				info := binding.Constructor.(*constructorInfo)
-				return n.invokeConstructor(info)
+				instance, err := n.invokeConstructor(info, abstractT)
+				if err == nil {
+					err = n.initialize(instance)
+				}
+				if err == nil {
+					instance, err = n.decorate(binding, instance)
+				}
+				if err == nil {
+					n.stamp(instance, binding, nil, nil)
+				}
+				return instance, err
 			}
 			// Use reflection
-			newInstance := reflect.New(binding.ConcreteType.Elem())
-			return newInstance.Interface(), nil
-		})
+			newInstance := reflect.New(binding.ConcreteType.Elem()).Interface()
+			if binding.AutoWireEnabled {
+				if err := n.autoWire(newInstance, abstractT); err != nil {
+					return nil, err
+				}
+			}
+			if err := n.initialize(newInstance); err != nil {
+				return nil, err
+			}
+			decorated, err := n.decorate(binding, newInstance)
+			if err != nil {
+				return nil, err
+			}
+			n.stamp(decorated, binding, nil, nil)
+			return decorated, nil
+		})))
 		if err != nil {
 			panic(fmt.Sprintf("failed to create singleton for type %v: %v", abstractT, err))
 		}
@@ -185,6 +430,8 @@ func (n *Nasc) Make(abstractType interface{}) interface{} {
 		if err != nil {
 			panic(fmt.Sprintf("factory function failed for type %v: %v", abstractT, err))
 		}
+		instance = n.mustDecorate(binding, instance)
+		n.stamp(instance, binding, nil, nil)
 		return instance
 
 	case LifetimeScoped:
@@ -234,7 +481,7 @@ func (n *Nasc) Singleton(abstractType, concreteType interface{}) error {
 		Lifetime:     string(LifetimeSingleton),
 	}
 
-	return n.registry.Register(binding)
+	return n.register(binding)
 }
 
 // Scoped registers a scoped binding.
@@ -273,7 +520,7 @@ func (n *Nasc) Scoped(abstractType, concreteType interface{}) error {
 		Lifetime:     string(LifetimeScoped),
 	}
 
-	return n.registry.Register(binding)
+	return n.register(binding)
 }
 
 // Factory registers a factory binding.
@@ -305,7 +552,7 @@ func (n *Nasc) Factory(abstractType interface{}, factory FactoryFunc) error {
 		Factory:      factory,
 	}
 
-	return n.registry.Register(binding)
+	return n.register(binding)
 }
 
 // CreateScope creates a new dependency resolution scope.
@@ -316,8 +563,16 @@ func (n *Nasc) Factory(abstractType interface{}, factory FactoryFunc) error {
 // scope := container.CreateScope()
 // defer scope.Dispose()
 // uow := scope.Make((*UnitOfWork)(nil)).(UnitOfWork)
-func (n *Nasc) CreateScope() *Scope {
-	return newScope(n)
+//
+// request := container.CreateScope(nasc.ScopeName("request-42"))
+func (n *Nasc) CreateScope(options ...ScopeOption) *Scope {
+	scope := newScope(n)
+	for _, option := range options {
+		option(scope)
+	}
+	n.trackScope(scope)
+	n.scopeCreated(scope)
+	return scope
 }
 
 // BindNamed registers a named binding.
@@ -361,7 +616,7 @@ func (n *Nasc) BindNamed(abstractType, concreteType interface{}, name string) er
 		Name:         name,
 	}
 
-	return n.registry.RegisterNamed(binding)
+	return n.registerNamed(binding)
 }
 
 // MakeNamed resolves and returns a named instance.
@@ -376,14 +631,38 @@ func (n *Nasc) MakeNamed(abstractType interface{}, name string) interface{} {
 	if name == "" {
 		panic("name cannot be empty")
 	}
+	if !n.hasMiddleware() {
+		return n.makeNamedDirect(abstractType, name)
+	}
+	return n.mustIntercept(Resolution{Type: typeOfToken(abstractType), Name: name})
+}
+
+// makeNamedDirect is MakeNamed without resolution middleware.
+func (n *Nasc) makeNamedDirect(abstractType interface{}, name string) interface{} {
+	if abstractType == nil {
+		panic("cannot resolve nil type")
+	}
+	if name == "" {
+		panic("name cannot be empty")
+	}
 
 	abstractT := reflect.TypeOf(abstractType)
 	if abstractT.Kind() == reflect.Ptr {
 		abstractT = abstractT.Elem()
 	}
 
+	if err := n.loadLazyProvider(abstractT); err != nil {
+		panic(fmt.Sprintf("failed to resolve named binding '%s' for type %v: %v", name, abstractT, err))
+	}
+
 	binding, err := n.registry.GetNamed(abstractT, name)
 	if err != nil {
+		if instance, ok, ferr := n.resolveUnbound(abstractT, name); ok {
+			if ferr != nil {
+				panic(fmt.Sprintf("failed to resolve named binding '%s' for type %v: %v", name, abstractT, ferr))
+			}
+			return instance
+		}
 		panic(fmt.Sprintf("named binding '%s' not found for type %v: %v", name, abstractT, err))
 	}
 
@@ -392,7 +671,9 @@ func (n *Nasc) MakeNamed(abstractType interface{}, name string) interface{} {
 }
 
 // MakeAll resolves and returns all implementations of an interface.
-// This includes both named and unnamed bindings.
+// This includes both named and unnamed bindings, ordered by priority (see
+// WithPriority). Within a priority the default binding comes first, then
+// named and tagged bindings in registration order.
 //
 // Example:
 //
@@ -411,6 +692,10 @@ func (n *Nasc) MakeAll(abstractType interface{}) []interface{} {
 		abstractT = abstractT.Elem()
 	}
 
+	if err := n.loadLazyProvider(abstractT); err != nil {
+		panic(fmt.Sprintf("failed to resolve type %v: %v", abstractT, err))
+	}
+
 	bindings := n.registry.GetAll(abstractT)
 	instances := make([]interface{}, 0, len(bindings))
 
@@ -461,11 +746,12 @@ func (n *Nasc) BindWithTags(abstractType, concreteType interface{}, tags []strin
 	}
 
 	// Tagged bindings need unique names to avoid conflicts
-	binding.Name = fmt.Sprintf("_tag_%s_%p", tags[0], concreteType)
-	return n.registry.RegisterNamed(binding)
+	binding.Name = n.tagBindingName(abstractT, tags[0], concreteT.String())
+	return n.registerNamed(binding)
 }
 
-// MakeWithTag resolves all instances with the specified tag.
+// MakeWithTag resolves all instances with the specified tag, ordered by
+// priority (see WithPriority), then registration order.
 //
 // Example:
 //
@@ -486,9 +772,57 @@ func (n *Nasc) MakeWithTag(tag string) []interface{} {
 	return instances
 }
 
+// MakeWithTagExpr resolves every binding whose tags satisfy a tag
+// expression, ordered like MakeWithTag. Tags combine with && (and), ||
+// (or), ! (not) and parentheses; && binds tighter than ||.
+//
+// Panics if the expression is invalid.
+//
+// Example:
+//
+//	plugins := container.MakeWithTagExpr("plugin && enabled && !deprecated")
+func (n *Nasc) MakeWithTagExpr(expr string) []interface{} {
+	parsed, err := registry.ParseTagExpr(expr)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	bindings := n.registry.GetByTagExpr(parsed)
+	instances := make([]interface{}, 0, len(bindings))
+	for _, binding := range bindings {
+		instances = append(instances, n.createInstanceFromBinding(binding, binding.AbstractType))
+	}
+	return instances
+}
+
+// MakeWhere resolves every binding whose metadata satisfies match (see
+// WithMeta), ordered like MakeWithTag.
+//
+// Example:
+//
+//	handlers := container.MakeWhere(func(meta nasc.Metadata) bool {
+//	    return meta["version"] == "2"
+//	})
+func (n *Nasc) MakeWhere(match func(meta Metadata) bool) []interface{} {
+	if match == nil {
+		panic("match function cannot be nil")
+	}
+
+	bindings := n.registry.Query(match)
+	instances := make([]interface{}, 0, len(bindings))
+	for _, binding := range bindings {
+		instances = append(instances, n.createInstanceFromBinding(binding, binding.AbstractType))
+	}
+	return instances
+}
+
 // createInstanceFromBinding creates an instance from a binding.
 // This centralizes instance creation logic for reuse.
 func (n *Nasc) createInstanceFromBinding(binding *registry.Binding, abstractT reflect.Type) interface{} {
+	if binding.TTL > 0 {
+		return n.makeCached(binding, abstractT)
+	}
+
 	switch Lifetime(binding.Lifetime) {
 	case LifetimeTransient:
 		return n.createTransientInstance(binding, abstractT)
@@ -507,38 +841,42 @@ func (n *Nasc) createTransientInstance(binding *registry.Binding, abstractT refl
 
 	// Auto-wire if enabled
 	if binding.AutoWireEnabled {
-		if err := n.AutoWire(instance); err != nil {
+		if err := n.autoWire(instance, abstractT); err != nil {
 			panic(fmt.Sprintf("failed to auto-wire instance for type %v: %v", abstractT, err))
 		}
 	}
 
+	n.mustInitialize(instance, abstractT)
+	instance = n.mustDecorate(binding, instance)
+	n.stamp(instance, binding, nil, nil)
 	return instance
 }
 
 // createSingletonInstance creates or retrieves a singleton instance
 func (n *Nasc) createSingletonInstance(binding *registry.Binding, abstractT reflect.Type) interface{} {
-	// For named/tagged singletons, use name as cache key
-	cacheKey := abstractT
-	if binding.Name != "" {
-		// Create unique key combining type and name
-		cacheKey = reflect.TypeOf(struct {
-			t reflect.Type
-			n string
-		}{abstractT, binding.Name})
-	}
+	// Named and tagged singletons are cached per name
+	cacheKey := keyFor(abstractT, binding.Name)
 
-	instance, err := n.singletonCache.getOrCreate(cacheKey, func() (interface{}, error) {
+	instance, err := n.singletonCache.getOrCreate(cacheKey, n.traceFactory(TimelineSingleton, abstractT.String(), n.restoreFactory(cacheKey, func() (interface{}, error) {
 		inst := n.createRawInstance(binding)
 
 		// Auto-wire if enabled
 		if binding.AutoWireEnabled {
-			if err := n.AutoWire(inst); err != nil {
+			if err := n.autoWire(inst, abstractT); err != nil {
 				return nil, err
 			}
 		}
 
-		return inst, nil
-	})
+		if err := n.initialize(inst); err != nil {
+			return nil, err
+		}
+		decorated, err := n.decorate(binding, inst)
+		if err != nil {
+			return nil, err
+		}
+		n.stamp(decorated, binding, nil, nil)
+		return decorated, nil
+	})))
 	if err != nil {
 		panic(fmt.Sprintf("failed to create singleton for type %v: %v", abstractT, err))
 	}
@@ -555,6 +893,8 @@ func (n *Nasc) createFactoryInstance(binding *registry.Binding, abstractT reflec
 	if err != nil {
 		panic(fmt.Sprintf("factory function failed for type %v: %v", abstractT, err))
 	}
+	instance = n.mustDecorate(binding, instance)
+	n.stamp(instance, binding, nil, nil)
 	return instance
 }
 
@@ -562,7 +902,7 @@ func (n *Nasc) createFactoryInstance(binding *registry.Binding, abstractT reflec
 func (n *Nasc) createRawInstance(binding *registry.Binding) interface{} {
 	if binding.Constructor != nil {
 		info := binding.Constructor.(*constructorInfo)
-		inst, err := n.invokeConstructor(info)
+		inst, err := n.invokeConstructor(info, binding.AbstractType)
 		if err != nil {
 			panic(fmt.Sprintf("failed to invoke constructor: %v", err))
 		}
@@ -575,6 +915,9 @@ func (n *Nasc) createRawInstance(binding *registry.Binding) interface{} {
 type resolutionContext struct {
 	stack []string
 	seen  map[string]bool
+
+	// memo is set during Validate to share verified subtrees between roots.
+	memo *validationMemo
 }
 
 // newResolutionContext creates a new resolution context.
@@ -597,6 +940,15 @@ func (rc *resolutionContext) push(typeName string) error {
 	return nil
 }
 
+// resolutionKey identifies a type, or a named binding of it, on a
+// resolution stack.
+func resolutionKey(t reflect.Type, name string) string {
+	if name != "" {
+		return fmt.Sprintf("%s[%s]", t, name)
+	}
+	return t.String()
+}
+
 // pop removes the last type from the resolution stack.
 func (rc *resolutionContext) pop() {
 	if len(rc.stack) > 0 {
@@ -650,19 +1002,42 @@ func (n *Nasc) MakeNamedSafe(abstractType interface{}, name string) (interface{}
 
 // makeSafeWithContext performs safe resolution with circular dependency detection.
 func (n *Nasc) makeSafeWithContext(abstractT reflect.Type, name string, ctx *resolutionContext) (interface{}, error) {
-	// Build type key for tracking
-	typeKey := abstractT.String()
-	if name != "" {
-		typeKey = fmt.Sprintf("%s[%s]", typeKey, name)
+	if ctx.memo == nil && n.hasMiddleware() {
+		return n.intercept(Resolution{Type: abstractT, Name: name}, func(r Resolution) (interface{}, error) {
+			return n.makeSafeDirect(r.Type, r.Name, ctx)
+		})
 	}
+	return n.makeSafeDirect(abstractT, name, ctx)
+}
 
+// makeSafeDirect is makeSafeWithContext without resolution middleware.
+func (n *Nasc) makeSafeDirect(abstractT reflect.Type, name string, ctx *resolutionContext) (interface{}, error) {
 	// Check for circular dependency
-	if err := ctx.push(typeKey); err != nil {
+	if err := ctx.push(resolutionKey(abstractT, name)); err != nil {
 		return nil, err
 	}
 	defer ctx.pop()
 
-	// Get binding
+	// Reuse subtrees already verified by this validation run
+	if ctx.memo != nil {
+		key := validationKey{t: abstractT, name: name}
+		if result, ok := ctx.memo.get(key); ok {
+			return result.instance, result.err
+		}
+		instance, err := n.resolveBindingSafe(abstractT, name, ctx)
+		ctx.memo.put(key, validationResult{instance: instance, err: err})
+		return instance, err
+	}
+
+	return n.resolveBindingSafe(abstractT, name, ctx)
+}
+
+// resolveBindingSafe looks up a binding and creates an instance from it.
+func (n *Nasc) resolveBindingSafe(abstractT reflect.Type, name string, ctx *resolutionContext) (interface{}, error) {
+	if err := n.loadLazyProvider(abstractT); err != nil {
+		return nil, &ResolutionError{Type: abstractT, Cause: err}
+	}
+
 	var binding *registry.Binding
 	var err error
 
@@ -673,6 +1048,30 @@ func (n *Nasc) makeSafeWithContext(abstractT reflect.Type, name string, ctx *res
 	}
 
 	if err != nil {
+		if name == "" {
+			// Validation stands in for the scope a scoped binding would get
+			if abstractT == scopeType && ctx.memo != nil {
+				return (*Scope)(nil), nil
+			}
+			if instance, ok, aerr := n.ambient(abstractT); ok {
+				if aerr != nil {
+					return nil, &ResolutionError{Type: abstractT, Cause: aerr}
+				}
+				return instance, nil
+			}
+			if implicit, ok, ierr := n.implicitBindingFor(abstractT); ok {
+				if ierr != nil {
+					return nil, &ResolutionError{Type: abstractT, Cause: ierr}
+				}
+				return n.makeSafeWithContext(implicit.AbstractType, implicit.Name, ctx)
+			}
+		}
+		if instance, ok, ferr := n.resolveUnbound(abstractT, name); ok {
+			if ferr != nil {
+				return nil, &ResolutionError{Type: abstractT, Name: name, Cause: ferr}
+			}
+			return instance, nil
+		}
 		return nil, &ResolutionError{
 			Type:  abstractT,
 			Name:  name,
@@ -686,33 +1085,76 @@ func (n *Nasc) makeSafeWithContext(abstractT reflect.Type, name string, ctx *res
 
 // createInstanceSafe creates an instance safely with context.
 func (n *Nasc) createInstanceSafe(binding *registry.Binding, abstractT reflect.Type, ctx *resolutionContext) (interface{}, error) {
-	switch Lifetime(binding.Lifetime) {
+	lifetime := Lifetime(binding.Lifetime)
+
+	// During validation, singleton and scoped bindings are built in isolation
+	// so that validation does not populate caches.
+	if ctx.memo != nil && (lifetime == LifetimeSingleton || lifetime == LifetimeScoped) {
+		lifetime = LifetimeTransient
+	}
+
+	// Validation builds throwaway instances that are not worth decorating
+	// or describing
+	stamp := func(instance interface{}, err error) (interface{}, error) {
+		if err == nil && ctx.memo == nil {
+			if instance, err = n.decorate(binding, instance); err != nil {
+				return nil, &ResolutionError{Type: abstractT, Context: "decoration failed", Cause: err}
+			}
+			n.stamp(instance, binding, nil, ctx.stack)
+		}
+		return instance, err
+	}
+
+	// build finishes a constructed instance. Validation skips auto-wiring,
+	// which would populate caches, and Initialize, which may connect to
+	// external systems.
+	build := func(instance interface{}, err error) (interface{}, error) {
+		if err == nil && ctx.memo == nil {
+			if binding.AutoWireEnabled && binding.Constructor == nil {
+				if wireErr := n.autoWire(instance, abstractT); wireErr != nil {
+					return nil, &ResolutionError{Type: abstractT, Context: "auto-wiring failed", Cause: wireErr}
+				}
+			}
+			if initErr := n.initialize(instance); initErr != nil {
+				return nil, &ResolutionError{Type: abstractT, Context: "initialization failed", Cause: initErr}
+			}
+		}
+		return stamp(instance, err)
+	}
+
+	if binding.TTL > 0 {
+		if ctx.memo != nil {
+			lifetime = LifetimeTransient
+		} else {
+			return n.resolveCached(binding, func() (interface{}, error) {
+				if binding.Constructor != nil {
+					info := binding.Constructor.(*constructorInfo)
+					return build(n.invokeConstructorSafe(info, abstractT, ctx))
+				}
+				return build(reflect.New(binding.ConcreteType.Elem()).Interface(), nil)
+			})
+		}
+	}
+
+	switch lifetime {
 	case LifetimeTransient:
 		if binding.Constructor != nil {
 			info := binding.Constructor.(*constructorInfo)
-			return n.invokeConstructorSafe(info, ctx)
+			return build(n.invokeConstructorSafe(info, abstractT, ctx))
 		}
-		instance := reflect.New(binding.ConcreteType.Elem())
-		return instance.Interface(), nil
+		return build(reflect.New(binding.ConcreteType.Elem()).Interface(), nil)
 
 	case LifetimeSingleton:
-		cacheKey := abstractT
-		if binding.Name != "" {
-			cacheKey = reflect.TypeOf(struct {
-				t reflect.Type
-				n string
-			}{abstractT, binding.Name})
-		}
+		cacheKey := keyFor(abstractT, binding.Name)
 
 		// For singletons, we need to handle potential circular deps in factory
-		instance, err := n.singletonCache.getOrCreate(cacheKey, func() (interface{}, error) {
+		instance, err := n.singletonCache.getOrCreate(cacheKey, n.traceFactory(TimelineSingleton, abstractT.String(), n.restoreFactory(cacheKey, func() (interface{}, error) {
 			if binding.Constructor != nil {
 				info := binding.Constructor.(*constructorInfo)
-				return n.invokeConstructorSafe(info, ctx)
+				return build(n.invokeConstructorSafe(info, abstractT, ctx))
 			}
-			newInstance := reflect.New(binding.ConcreteType.Elem())
-			return newInstance.Interface(), nil
-		})
+			return build(reflect.New(binding.ConcreteType.Elem()).Interface(), nil)
+		})))
 		return instance, err
 
 	case LifetimeFactory:
@@ -723,7 +1165,7 @@ func (n *Nasc) createInstanceSafe(binding *registry.Binding, abstractT reflect.T
 				Context: "invalid factory function",
 			}
 		}
-		return factory(n)
+		return stamp(factory(n))
 
 	default:
 		return nil, &ResolutionError{
@@ -734,12 +1176,30 @@ func (n *Nasc) createInstanceSafe(binding *registry.Binding, abstractT reflect.T
 }
 
 // invokeConstructorSafe invokes a constructor safely with circular detection.
-func (n *Nasc) invokeConstructorSafe(info *constructorInfo, ctx *resolutionContext) (interface{}, error) {
+func (n *Nasc) invokeConstructorSafe(info *constructorInfo, consumer reflect.Type, ctx *resolutionContext) (interface{}, error) {
+	consumers := consumerTypes(consumer, info.returnType)
 	params := make([]reflect.Value, len(info.paramTypes))
 
 	for i, paramType := range info.paramTypes {
-		// Resolve parameter with context
-		param, err := n.makeSafeWithContext(paramType, "", ctx)
+		// Named annotations come first, then contextual bindings, then
+		// wrapper types such as *Lazy[T], as in resolveConstructorParams
+		var value reflect.Value
+		var err error
+		if name := info.paramName(i); name != "" {
+			var param interface{}
+			if param, err = n.makeSafeWithContext(paramType, name, ctx); err == nil {
+				value, err = paramValue(param, paramType)
+			}
+		} else if contextual, ok := n.contextual.lookup(paramType, consumers...); ok {
+			value, err = n.contextualValue(contextual, paramType, n, ctx)
+		} else if injected, ok, injectErr := n.resolveInjectable(paramType, n); ok {
+			value, err = injected, injectErr
+		} else {
+			var param interface{}
+			if param, err = n.makeSafeWithContext(paramType, "", ctx); err == nil {
+				value, err = paramValue(param, paramType)
+			}
+		}
 		if err != nil {
 			return nil, &ResolutionError{
 				Type:    info.returnType,
@@ -747,7 +1207,7 @@ func (n *Nasc) invokeConstructorSafe(info *constructorInfo, ctx *resolutionConte
 				Cause:   err,
 			}
 		}
-		params[i] = reflect.ValueOf(param)
+		params[i] = value
 	}
 
 	// Call constructor
@@ -768,51 +1228,10 @@ func (n *Nasc) invokeConstructorSafe(info *constructorInfo, ctx *resolutionConte
 	return results[0].Interface(), nil
 }
 
-// Validate checks the container's bindings for potential issues.
-// Returns nil if validation passes, or ValidationError with all found issues.
-//
-// Example:
-//
-//	if err := container.Validate(); err != nil {
-//	   log.Fatalf("Container validation failed: %v", err)
-//	}
-func (n *Nasc) Validate() error {
-	var validationErrors []error
-
-	// Get all types
-	allTypes := n.registry.GetAllTypes()
-
-	// Try to resolve each type
-	for _, abstractType := range allTypes {
-		// Try unnamed binding if exists
-		if n.registry.HasUnnamedBinding(abstractType) {
-			ctx := newResolutionContext()
-			_, err := n.makeSafeWithContext(abstractType, "", ctx)
-			if err != nil {
-				validationErrors = append(validationErrors, fmt.Errorf("binding %v: %w", abstractType, err))
-			}
-		}
-
-		// Try all named bindings for this type
-		names := n.registry.GetAllNamedFor(abstractType)
-		for _, name := range names {
-			ctx := newResolutionContext()
-			_, err := n.makeSafeWithContext(abstractType, name, ctx)
-			if err != nil {
-				validationErrors = append(validationErrors, fmt.Errorf("binding %v[%s]: %w", abstractType, name, err))
-			}
-		}
-	}
-
-	if len(validationErrors) > 0 {
-		return &ValidationError{Errors: validationErrors}
-	}
-
-	return nil
-}
-
 // BindAutoWire registers a binding with automatic dependency injection enabled.
-// The instance will have its fields with `inject` tags automatically resolved.
+// Each instance it creates has its fields with `inject` tags resolved
+// before it is returned. The binding is transient unless options choose
+// another lifetime, name or tags (see WithLifetime, WithName and WithTags).
 //
 // Example:
 //
@@ -820,7 +1239,8 @@ func (n *Nasc) Validate() error {
 //	    Logger Logger `inject:""`
 //	}
 //	container.BindAutoWire((*ServiceInterface)(nil), &Service{})
-func (n *Nasc) BindAutoWire(abstractType, concreteType interface{}) error {
+//	container.BindAutoWire((*Cache)(nil), &RedisCache{}, nasc.WithLifetime(nasc.LifetimeSingleton))
+func (n *Nasc) BindAutoWire(abstractType, concreteType interface{}, opts ...BindOption) error {
 	if abstractType == nil {
 		return &InvalidBindingError{Reason: "abstract type cannot be nil"}
 	}
@@ -842,14 +1262,11 @@ func (n *Nasc) BindAutoWire(abstractType, concreteType interface{}) error {
 		}
 	}
 
-	binding := &registry.Binding{
-		AbstractType:    abstractT,
-		ConcreteType:    concreteT,
-		Lifetime:        string(LifetimeTransient),
-		AutoWireEnabled: true,
+	options := bindOptions{lifetime: LifetimeTransient, autoWire: true}
+	for _, opt := range opts {
+		opt(&options)
 	}
-
-	return n.registry.Register(binding)
+	return n.bindType(abstractT, concreteT, options)
 }
 
 // MustMake is an explicit panic version of Make for cases where panic is desired.
@@ -0,0 +1,128 @@
+// Package nasccli injects dependencies into CLI command handlers.
+//
+// The package is written for cobra but does not import it: the command type
+// is a type parameter, so the core module stays free of dependencies and
+// any framework with a func(cmd, args) error handler shape works.
+package nasccli
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	nasc "github.com/toutaio/toutago-nasc-dependency-injector"
+)
+
+// contextCommand is implemented by commands that carry a context, such as
+// *cobra.Command.
+type contextCommand interface {
+	Context() context.Context
+	SetContext(ctx context.Context)
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// RunE adapts fn to a command handler. fn takes the command and its
+// arguments followed by any number of interface or function parameters,
+// which are resolved from a scope opened for the invocation and disposed
+// when fn returns. fn must return an error.
+//
+// If the command carries a context, the scope is attached to it, so code
+// further down can use nasc.ScopeFromContext or Nasc.MakeFromContext.
+//
+// RunE panics if fn does not have the expected signature, so mistakes
+// surface when the command tree is built rather than when it runs.
+//
+// Example:
+//
+//	migrate := &cobra.Command{
+//	    Use: "migrate",
+//	    RunE: nasccli.RunE[*cobra.Command](container,
+//	        func(cmd *cobra.Command, args []string, m Migrator) error {
+//	            return m.Up(cmd.Context())
+//	        }),
+//	}
+func RunE[C any](container *nasc.Nasc, fn interface{}) func(cmd C, args []string) error {
+	fnV := reflect.ValueOf(fn)
+	services, err := checkHandler(fnV, reflect.TypeOf((*C)(nil)).Elem())
+	if err != nil {
+		panic(err)
+	}
+
+	return func(cmd C, args []string) (err error) {
+		ctx := context.Background()
+		cc, hasContext := any(cmd).(contextCommand)
+		if hasContext && cc.Context() != nil {
+			ctx = cc.Context()
+		}
+
+		scope := container.CreateScopeWithContext(ctx)
+		defer func() {
+			if disposeErr := scope.Dispose(); disposeErr != nil && err == nil {
+				err = disposeErr
+			}
+		}()
+		if hasContext {
+			cc.SetContext(nasc.WithScope(ctx, scope))
+		}
+
+		in := []reflect.Value{reflect.ValueOf(&cmd).Elem(), reflect.ValueOf(args)}
+		for i, t := range services {
+			value, resolveErr := resolve(scope, t)
+			if resolveErr != nil {
+				return fmt.Errorf("nasccli: failed to resolve parameter %d (%v): %w", i+2, t, resolveErr)
+			}
+			in = append(in, value)
+		}
+
+		out := fnV.Call(in)
+		if out[0].IsNil() {
+			return nil
+		}
+		return out[0].Interface().(error)
+	}
+}
+
+// checkHandler validates fn's signature and returns its service parameter types.
+func checkHandler(fnV reflect.Value, cmdT reflect.Type) ([]reflect.Type, error) {
+	if fnV.Kind() != reflect.Func || fnV.IsNil() {
+		return nil, fmt.Errorf("nasccli: handler must be a non-nil function, got %v", fnV.Type())
+	}
+
+	fnT := fnV.Type()
+	if fnT.NumIn() < 2 || fnT.In(0) != cmdT || fnT.In(1) != reflect.TypeOf([]string(nil)) {
+		return nil, fmt.Errorf("nasccli: handler must start with (%v, []string), got %v", cmdT, fnT)
+	}
+	if fnT.NumOut() != 1 || fnT.Out(0) != errorType {
+		return nil, fmt.Errorf("nasccli: handler must return error, got %v", fnT)
+	}
+
+	services := make([]reflect.Type, 0, fnT.NumIn()-2)
+	for i := 2; i < fnT.NumIn(); i++ {
+		t := fnT.In(i)
+		if t.Kind() != reflect.Interface && t.Kind() != reflect.Func {
+			return nil, fmt.Errorf("nasccli: handler parameter %d must be an interface or function, got %v", i, t)
+		}
+		services = append(services, t)
+	}
+	return services, nil
+}
+
+// resolve makes a service from the scope, turning resolution panics into errors.
+func resolve(scope *nasc.Scope, t reflect.Type) (value reflect.Value, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("%v", r)
+			}
+		}
+	}()
+
+	instance := scope.Make(reflect.Zero(reflect.PointerTo(t)).Interface())
+	if instance == nil {
+		return reflect.Zero(t), nil
+	}
+	return reflect.ValueOf(instance), nil
+}
@@ -0,0 +1,103 @@
+package nasccli
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	nasc "github.com/toutaio/toutago-nasc-dependency-injector"
+)
+
+// command mimics the parts of *cobra.Command the adapter uses.
+type command struct {
+	ctx context.Context
+}
+
+func (c *command) Context() context.Context       { return c.ctx }
+func (c *command) SetContext(ctx context.Context) { c.ctx = ctx }
+
+type Migrator interface {
+	Up() error
+}
+
+type recordingMigrator struct {
+	runs     int
+	disposed bool
+}
+
+func (m *recordingMigrator) Up() error { m.runs++; return nil }
+func (m *recordingMigrator) Dispose() error {
+	m.disposed = true
+	return nil
+}
+
+func TestRunE_InjectsScopedServices(t *testing.T) {
+	container := nasc.New()
+	if err := container.Scoped((*Migrator)(nil), &recordingMigrator{}); err != nil {
+		t.Fatalf("Scoped failed: %v", err)
+	}
+
+	var got *recordingMigrator
+	run := RunE[*command](container, func(cmd *command, args []string, m Migrator) error {
+		if _, ok := nasc.ScopeFromContext(cmd.Context()); !ok {
+			t.Error("command context should carry the invocation scope")
+		}
+		if len(args) != 1 || args[0] != "up" {
+			t.Errorf("args = %v, want [up]", args)
+		}
+		got = m.(*recordingMigrator)
+		return m.Up()
+	})
+
+	if err := run(&command{ctx: context.Background()}, []string{"up"}); err != nil {
+		t.Fatalf("run failed: %v", err)
+	}
+	if got.runs != 1 {
+		t.Errorf("Up ran %d times, want 1", got.runs)
+	}
+	if !got.disposed {
+		t.Error("scoped service should be disposed after the command")
+	}
+}
+
+func TestRunE_Errors(t *testing.T) {
+	container := nasc.New()
+
+	errBoom := errors.New("boom")
+	run := RunE[*command](container, func(cmd *command, args []string) error {
+		return errBoom
+	})
+	if err := run(&command{}, nil); !errors.Is(err, errBoom) {
+		t.Errorf("handler error should be returned, got %v", err)
+	}
+
+	run = RunE[*command](container, func(cmd *command, args []string, m Migrator) error {
+		t.Error("handler should not run when a dependency is missing")
+		return nil
+	})
+	if err := run(&command{}, nil); err == nil || !strings.Contains(err.Error(), "parameter 2") {
+		t.Errorf("expected resolution error, got %v", err)
+	}
+}
+
+func TestRunE_InvalidHandler(t *testing.T) {
+	container := nasc.New()
+
+	tests := map[string]interface{}{
+		"not a function":   "run",
+		"missing args":     func(cmd *command) error { return nil },
+		"no error result":  func(cmd *command, args []string) {},
+		"concrete service": func(cmd *command, args []string, m *recordingMigrator) error { return nil },
+	}
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected panic for invalid handler")
+				}
+			}()
+			RunE[*command](container, fn)
+		})
+	}
+}
@@ -0,0 +1,353 @@
+// Package nascconfig provides nasc.ConfigSource implementations that load
+// settings from files and environment variables into structs, for
+// Nasc.BindConfig and configurable providers.
+//
+// Struct fields are matched by their `config:"name"` tag, or by field name
+// when untagged, ignoring case; a tag of "-" skips the field. Nested
+// structs read nested settings. Strings are parsed into numbers, booleans,
+// durations ("5s") and comma-separated slices, so every source fills the
+// same structs:
+//
+//	type DBConfig struct {
+//	    DSN      string        `config:"dsn"`
+//	    MaxConns int           `config:"max_conns"`
+//	    Timeout  time.Duration `config:"timeout"`
+//	}
+//
+// JSON files are read with the standard library. The package does not
+// import a YAML library, so the core module stays free of dependencies;
+// pass one's Unmarshal to File instead:
+//
+//	source := nascconfig.Layered(
+//	    nascconfig.File("config.yaml", yaml.Unmarshal),
+//	    nascconfig.Env("APP"),
+//	)
+//	container.BindInstanceAs(source, (*nasc.ConfigSource)(nil))
+//	container.BindConfig(&DBConfig{MaxConns: 10}, "database")
+package nascconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	nasc "github.com/toutaio/toutago-nasc-dependency-injector"
+)
+
+// lookupFunc returns the raw setting at a path of keys.
+type lookupFunc func(path []string) (interface{}, bool)
+
+// Map returns a source reading settings from a tree of maps, such as a
+// decoded JSON or YAML document. A section may name a nested map with
+// dots, e.g. "services.billing".
+//
+// Example:
+//
+//	source := nascconfig.Map(map[string]interface{}{
+//	    "database": map[string]interface{}{"dsn": "postgres://localhost/app"},
+//	})
+func Map(settings map[string]interface{}) nasc.ConfigSource {
+	return nasc.ConfigSourceFunc(func(section string, target interface{}) error {
+		return populate(target, sectionPath(section), func(path []string) (interface{}, bool) {
+			return lookupTree(settings, path)
+		})
+	})
+}
+
+// File returns a source reading the file at path with decode, which must
+// be able to decode into a map[string]interface{}, like json.Unmarshal or
+// yaml.Unmarshal. The file is read on every Load, so a missing file is
+// reported when configuration is bound.
+func File(path string, decode func(data []byte, v interface{}) error) nasc.ConfigSource {
+	return nasc.ConfigSourceFunc(func(section string, target interface{}) error {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		var settings map[string]interface{}
+		if err := decode(data, &settings); err != nil {
+			return fmt.Errorf("decoding %s: %w", path, err)
+		}
+		return Map(settings).Load(section, target)
+	})
+}
+
+// JSONFile returns a source reading the JSON file at path.
+//
+// Example:
+//
+//	container.BindInstanceAs(nascconfig.JSONFile("config.json"), (*nasc.ConfigSource)(nil))
+func JSONFile(path string) nasc.ConfigSource {
+	return File(path, json.Unmarshal)
+}
+
+// Env returns a source reading environment variables named after the
+// prefix, section and field, upper-cased and joined with underscores:
+// with prefix "APP", the MaxConns field tagged "max_conns" in section
+// "database" is read from APP_DATABASE_MAX_CONNS.
+//
+// Example:
+//
+//	container.BindInstanceAs(nascconfig.Env("APP"), (*nasc.ConfigSource)(nil))
+func Env(prefix string) nasc.ConfigSource {
+	return nasc.ConfigSourceFunc(func(section string, target interface{}) error {
+		return populate(target, sectionPath(section), func(path []string) (interface{}, bool) {
+			if prefix != "" {
+				path = append([]string{prefix}, path...)
+			}
+			name := strings.ToUpper(strings.Join(path, "_"))
+			name = strings.NewReplacer(".", "_", "-", "_").Replace(name)
+			return os.LookupEnv(name)
+		})
+	})
+}
+
+// Layered returns a source loading from each source in turn, so later
+// sources override earlier ones, e.g. a file overridden by the environment.
+func Layered(sources ...nasc.ConfigSource) nasc.ConfigSource {
+	return nasc.ConfigSourceFunc(func(section string, target interface{}) error {
+		for _, source := range sources {
+			if err := source.Load(section, target); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// sectionPath splits a dotted section into keys.
+func sectionPath(section string) []string {
+	if section == "" {
+		return nil
+	}
+	return strings.Split(section, ".")
+}
+
+// lookupTree walks a tree of maps, matching keys without regard to case.
+func lookupTree(tree interface{}, path []string) (interface{}, bool) {
+	node := tree
+	for _, key := range path {
+		next, ok := lookupKey(node, key)
+		if !ok {
+			return nil, false
+		}
+		node = next
+	}
+	return node, true
+}
+
+// lookupKey returns a map's entry for key, preferring an exact match.
+func lookupKey(node interface{}, key string) (interface{}, bool) {
+	m := reflect.ValueOf(node)
+	if m.Kind() != reflect.Map {
+		return nil, false
+	}
+	var folded interface{}
+	found := false
+	for _, k := range m.MapKeys() {
+		name := fmt.Sprint(k.Interface())
+		if name == key {
+			return m.MapIndex(k).Interface(), true
+		}
+		if !found && strings.EqualFold(name, key) {
+			folded, found = m.MapIndex(k).Interface(), true
+		}
+	}
+	return folded, found
+}
+
+// populate fills the struct target points to from lookup, starting at path.
+func populate(target interface{}, path []string, lookup lookupFunc) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("config target must be a non-nil pointer to a struct, got %T", target)
+	}
+	return populateStruct(v.Elem(), path, lookup)
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// populateStruct fills a struct's exported fields, recursing into nested
+// structs.
+func populateStruct(v reflect.Value, path []string, lookup lookupFunc) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		key := field.Name
+		if tag, ok := field.Tag.Lookup("config"); ok {
+			if tag == "-" {
+				continue
+			}
+			key = tag
+		}
+
+		fieldPath := append(append([]string(nil), path...), key)
+		if field.Type.Kind() == reflect.Struct {
+			if err := populateStruct(v.Field(i), fieldPath, lookup); err != nil {
+				return err
+			}
+			continue
+		}
+
+		raw, ok := lookup(fieldPath)
+		if !ok {
+			continue
+		}
+		if err := setValue(v.Field(i), raw); err != nil {
+			return fmt.Errorf("config %s: %w", strings.Join(fieldPath, "."), err)
+		}
+	}
+	return nil
+}
+
+// setValue converts a raw setting to the field's type and stores it.
+func setValue(field reflect.Value, raw interface{}) error {
+	if s, ok := raw.(string); ok {
+		return setString(field, s)
+	}
+
+	value := reflect.ValueOf(raw)
+	switch field.Kind() {
+	case reflect.Slice:
+		if value.Kind() != reflect.Slice {
+			break
+		}
+		slice := reflect.MakeSlice(field.Type(), value.Len(), value.Len())
+		for i := 0; i < value.Len(); i++ {
+			if err := setValue(slice.Index(i), value.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		field.Set(slice)
+		return nil
+	case reflect.Map:
+		if value.Kind() != reflect.Map || field.Type().Key().Kind() != reflect.String {
+			break
+		}
+		m := reflect.MakeMapWithSize(field.Type(), value.Len())
+		for _, k := range value.MapKeys() {
+			elem := reflect.New(field.Type().Elem()).Elem()
+			if err := setValue(elem, value.MapIndex(k).Interface()); err != nil {
+				return err
+			}
+			m.SetMapIndex(reflect.ValueOf(fmt.Sprint(k.Interface())).Convert(field.Type().Key()), elem)
+		}
+		field.Set(m)
+		return nil
+	case reflect.Bool:
+		if b, ok := raw.(bool); ok {
+			field.SetBool(b)
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		if isNumber(value) {
+			return setNumber(field, value)
+		}
+	}
+	return fmt.Errorf("cannot use %T as %v", raw, field.Type())
+}
+
+// isNumber reports whether a raw setting is numeric.
+func isNumber(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// setNumber stores a numeric setting, rejecting fractions for integer
+// fields and values the field cannot hold. JSON decodes every number as a
+// float64, so whole floats are accepted for integers.
+func setNumber(field reflect.Value, value reflect.Value) error {
+	f := value.Convert(reflect.TypeOf(float64(0))).Float()
+	switch field.Kind() {
+	case reflect.Float32, reflect.Float64:
+		if field.OverflowFloat(f) {
+			return fmt.Errorf("%v overflows %v", f, field.Type())
+		}
+		field.SetFloat(f)
+		return nil
+	}
+
+	if f != float64(int64(f)) {
+		return fmt.Errorf("%v is not an integer", value.Interface())
+	}
+	switch field.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if f < 0 || field.OverflowUint(uint64(f)) {
+			return fmt.Errorf("%v overflows %v", value.Interface(), field.Type())
+		}
+		field.SetUint(uint64(f))
+	default:
+		if field.OverflowInt(int64(f)) {
+			return fmt.Errorf("%v overflows %v", value.Interface(), field.Type())
+		}
+		field.SetInt(int64(f))
+	}
+	return nil
+}
+
+// setString parses a string setting into the field's type.
+func setString(field reflect.Value, s string) error {
+	if field.Type() == durationType {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(d))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	case reflect.Slice:
+		parts := strings.Split(s, ",")
+		slice := reflect.MakeSlice(field.Type(), len(parts), len(parts))
+		for i, part := range parts {
+			if err := setString(slice.Index(i), strings.TrimSpace(part)); err != nil {
+				return err
+			}
+		}
+		field.Set(slice)
+	default:
+		return fmt.Errorf("cannot use a string as %v", field.Type())
+	}
+	return nil
+}
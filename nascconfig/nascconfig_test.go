@@ -0,0 +1,141 @@
+package nascconfig
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	nasc "github.com/toutaio/toutago-nasc-dependency-injector"
+)
+
+type poolConfig struct {
+	Size int `config:"size"`
+}
+
+type dbConfig struct {
+	DSN      string            `config:"dsn"`
+	MaxConns int               `config:"max_conns"`
+	Timeout  time.Duration     `config:"timeout"`
+	Replicas []string          `config:"replicas"`
+	Labels   map[string]string `config:"labels"`
+	Pool     poolConfig        `config:"pool"`
+	Debug    bool
+	Secret   string `config:"-"`
+}
+
+func TestJSONFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	_ = os.WriteFile(path, []byte(`{
+		"database": {
+			"dsn": "postgres://db/app",
+			"max_conns": 20,
+			"timeout": "5s",
+			"replicas": ["a", "b"],
+			"labels": {"team": "billing"},
+			"pool": {"size": 4},
+			"DEBUG": true,
+			"Secret": "ignored"
+		}
+	}`), 0o600)
+
+	cfg := &dbConfig{MaxConns: 10, Secret: "kept"}
+	if err := JSONFile(path).Load("database", cfg); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	want := &dbConfig{
+		DSN:      "postgres://db/app",
+		MaxConns: 20,
+		Timeout:  5 * time.Second,
+		Replicas: []string{"a", "b"},
+		Labels:   map[string]string{"team": "billing"},
+		Pool:     poolConfig{Size: 4},
+		Debug:    true,
+		Secret:   "kept",
+	}
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("config = %+v, want %+v", cfg, want)
+	}
+
+	if err := JSONFile(filepath.Join(t.TempDir(), "missing.json")).Load("database", &dbConfig{}); err == nil {
+		t.Error("a missing file should fail")
+	}
+}
+
+func TestEnv(t *testing.T) {
+	t.Setenv("APP_DATABASE_MAX_CONNS", "30")
+	t.Setenv("APP_DATABASE_REPLICAS", "a, b")
+	t.Setenv("APP_DATABASE_POOL_SIZE", "8")
+	t.Setenv("APP_DATABASE_TIMEOUT", "1m")
+
+	cfg := &dbConfig{DSN: "default"}
+	if err := Env("APP").Load("database", cfg); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.DSN != "default" || cfg.MaxConns != 30 || cfg.Pool.Size != 8 || cfg.Timeout != time.Minute {
+		t.Errorf("config = %+v", cfg)
+	}
+	if !reflect.DeepEqual(cfg.Replicas, []string{"a", "b"}) {
+		t.Errorf("replicas = %q, want a comma-separated list", cfg.Replicas)
+	}
+
+	t.Setenv("APP_DATABASE_MAX_CONNS", "many")
+	err := Env("APP").Load("database", &dbConfig{})
+	if err == nil || !strings.Contains(err.Error(), "database.max_conns") {
+		t.Errorf("Load() error = %v, want the setting's path", err)
+	}
+}
+
+func TestLayered(t *testing.T) {
+	t.Setenv("APP_DATABASE_DSN", "postgres://env/app")
+	source := Layered(
+		Map(map[string]interface{}{
+			"database": map[string]interface{}{"dsn": "postgres://file/app", "max_conns": 5},
+		}),
+		Env("APP"),
+	)
+
+	cfg := &dbConfig{}
+	if err := source.Load("database", cfg); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.DSN != "postgres://env/app" || cfg.MaxConns != 5 {
+		t.Errorf("config = %+v, want the environment over the map", cfg)
+	}
+}
+
+func TestMap_Errors(t *testing.T) {
+	for name, value := range map[string]interface{}{
+		"fraction": 1.5,
+		"negative": -1,
+		"overflow": 1 << 40,
+		"type":     true,
+	} {
+		var cfg struct {
+			Small uint16 `config:"value"`
+		}
+		err := Map(map[string]interface{}{"value": value}).Load("", &cfg)
+		if err == nil {
+			t.Errorf("%s: Load() should fail", name)
+		}
+	}
+}
+
+func TestBindConfig(t *testing.T) {
+	container := nasc.New()
+	_ = container.BindInstanceAs(Map(map[string]interface{}{
+		"services": map[string]interface{}{
+			"database": map[string]interface{}{"dsn": "postgres://db/app"},
+		},
+	}), (*nasc.ConfigSource)(nil))
+
+	if err := container.BindConfig(&dbConfig{MaxConns: 10}, "services.database"); err != nil {
+		t.Fatalf("BindConfig() error = %v", err)
+	}
+	cfg := container.Make((**dbConfig)(nil)).(*dbConfig)
+	if cfg.DSN != "postgres://db/app" || cfg.MaxConns != 10 {
+		t.Errorf("config = %+v", cfg)
+	}
+}
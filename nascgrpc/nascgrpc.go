@@ -0,0 +1,83 @@
+// Package nascgrpc opens a container scope per gRPC call.
+//
+// Like nasccli, the package does not import its framework: the gRPC types
+// are type parameters, so the core module stays free of dependencies. The
+// interceptors it returns are assignable to grpc.UnaryServerInterceptor and
+// grpc.StreamServerInterceptor.
+package nascgrpc
+
+import (
+	"context"
+
+	nasc "github.com/toutaio/toutago-nasc-dependency-injector"
+)
+
+// ServerStream is the part of grpc.ServerStream the stream interceptor uses.
+type ServerStream interface {
+	Context() context.Context
+}
+
+// UnaryServerInterceptor returns a unary interceptor that creates a scope
+// for each call, attaches it to the call context and disposes it when the
+// handler returns. Handlers retrieve scoped services with
+// Nasc.MakeFromContext or nasc.ScopeFromContext.
+//
+// A disposal failure is returned as the call error if the handler itself
+// succeeded.
+//
+// Example:
+//
+//	server := grpc.NewServer(grpc.UnaryInterceptor(
+//	    nascgrpc.UnaryServerInterceptor[*grpc.UnaryServerInfo, grpc.UnaryHandler](container),
+//	))
+//
+//	func (s *OrderService) Place(ctx context.Context, req *pb.PlaceRequest) (*pb.Order, error) {
+//	    uow := s.container.MakeFromContext(ctx, (*UnitOfWork)(nil)).(UnitOfWork)
+//	    // ...
+//	}
+func UnaryServerInterceptor[I any, H ~func(context.Context, any) (any, error)](container *nasc.Nasc) func(ctx context.Context, req any, info I, handler H) (any, error) {
+	return func(ctx context.Context, req any, info I, handler H) (resp any, err error) {
+		scope := container.CreateScopeWithContext(ctx)
+		defer func() {
+			if disposeErr := scope.Dispose(); disposeErr != nil && err == nil {
+				resp, err = nil, disposeErr
+			}
+		}()
+
+		return handler(nasc.WithScope(ctx, scope), req)
+	}
+}
+
+// StreamServerInterceptor returns a stream interceptor that creates a scope
+// for each call and disposes it when the handler returns. A server stream's
+// context cannot be replaced in place, so wrap must return a stream whose
+// Context method reports the given context.
+//
+// Example:
+//
+//	type scopedStream struct {
+//	    grpc.ServerStream
+//	    ctx context.Context
+//	}
+//
+//	func (s scopedStream) Context() context.Context { return s.ctx }
+//
+//	server := grpc.NewServer(grpc.StreamInterceptor(
+//	    nascgrpc.StreamServerInterceptor[grpc.ServerStream, *grpc.StreamServerInfo, grpc.StreamHandler](container,
+//	        func(ss grpc.ServerStream, ctx context.Context) grpc.ServerStream {
+//	            return scopedStream{ss, ctx}
+//	        }),
+//	))
+func StreamServerInterceptor[S ServerStream, I any, H ~func(any, S) error](container *nasc.Nasc, wrap func(stream S, ctx context.Context) S) func(srv any, stream S, info I, handler H) error {
+	return func(srv any, stream S, info I, handler H) (err error) {
+		ctx := stream.Context()
+		scope := container.CreateScopeWithContext(ctx)
+		defer func() {
+			if disposeErr := scope.Dispose(); disposeErr != nil && err == nil {
+				err = disposeErr
+			}
+		}()
+
+		return handler(srv, wrap(stream, nasc.WithScope(ctx, scope)))
+	}
+}
@@ -0,0 +1,115 @@
+package nascgrpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	nasc "github.com/toutaio/toutago-nasc-dependency-injector"
+)
+
+// The types below mirror the grpc declarations the interceptors are
+// instantiated with.
+type (
+	unaryInfo    struct{ method string }
+	unaryHandler func(ctx context.Context, req any) (any, error)
+	streamInfo   struct{ method string }
+	stream       interface{ Context() context.Context }
+	streamFunc   func(srv any, ss stream) error
+)
+
+type fakeStream struct{ ctx context.Context }
+
+func (s fakeStream) Context() context.Context { return s.ctx }
+
+type UnitOfWork interface {
+	Commit() error
+}
+
+type trackedUnit struct {
+	committed bool
+	disposed  bool
+}
+
+func (u *trackedUnit) Commit() error { u.committed = true; return nil }
+func (u *trackedUnit) Dispose() error {
+	u.disposed = true
+	return nil
+}
+
+func newContainer(t *testing.T) *nasc.Nasc {
+	t.Helper()
+	container := nasc.New()
+	if err := container.Scoped((*UnitOfWork)(nil), &trackedUnit{}); err != nil {
+		t.Fatalf("Scoped failed: %v", err)
+	}
+	return container
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	container := newContainer(t)
+	intercept := UnaryServerInterceptor[*unaryInfo, unaryHandler](container)
+
+	var units []*trackedUnit
+	handler := func(ctx context.Context, req any) (any, error) {
+		uow := container.MakeFromContext(ctx, (*UnitOfWork)(nil)).(UnitOfWork)
+		if again := container.MakeFromContext(ctx, (*UnitOfWork)(nil)); again != uow {
+			t.Error("a call should share one scoped instance")
+		}
+		units = append(units, uow.(*trackedUnit))
+		return req, uow.Commit()
+	}
+
+	for i := 0; i < 2; i++ {
+		resp, err := intercept(context.Background(), "req", &unaryInfo{method: "/orders.Place"}, handler)
+		if err != nil || resp != "req" {
+			t.Fatalf("call %d = %v, %v", i, resp, err)
+		}
+	}
+
+	if units[0] == units[1] {
+		t.Error("each call should get its own scope")
+	}
+	for i, u := range units {
+		if !u.committed || !u.disposed {
+			t.Errorf("unit %d: committed=%v disposed=%v", i, u.committed, u.disposed)
+		}
+	}
+}
+
+func TestUnaryServerInterceptor_HandlerError(t *testing.T) {
+	container := newContainer(t)
+	intercept := UnaryServerInterceptor[*unaryInfo, unaryHandler](container)
+
+	errFailed := errors.New("failed")
+	_, err := intercept(context.Background(), nil, nil, func(ctx context.Context, req any) (any, error) {
+		return nil, errFailed
+	})
+	if !errors.Is(err, errFailed) {
+		t.Errorf("expected handler error, got %v", err)
+	}
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	container := newContainer(t)
+	intercept := StreamServerInterceptor[stream, *streamInfo, streamFunc](container,
+		func(ss stream, ctx context.Context) stream {
+			return fakeStream{ctx: ctx}
+		})
+
+	var unit *trackedUnit
+	err := intercept(nil, fakeStream{ctx: context.Background()}, &streamInfo{method: "/orders.Watch"},
+		func(srv any, ss stream) error {
+			if _, ok := nasc.ScopeFromContext(ss.Context()); !ok {
+				t.Error("stream context should carry the call scope")
+			}
+			unit = container.MakeFromContext(ss.Context(), (*UnitOfWork)(nil)).(*trackedUnit)
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("stream call failed: %v", err)
+	}
+	if !unit.disposed {
+		t.Error("scoped service should be disposed after the stream ends")
+	}
+}
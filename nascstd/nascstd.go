@@ -0,0 +1,79 @@
+// Package nascstd provides context-free defaults for services most small
+// programs need: a clock, a random source and the host name. Registering
+// them behind interfaces lets tests substitute fixed values.
+package nascstd
+
+import (
+	"math/rand"
+	"os"
+	"time"
+
+	nasc "github.com/toutaio/toutago-nasc-dependency-injector"
+)
+
+// Clock tells the current time.
+type Clock interface {
+	Now() time.Time
+}
+
+// Rand is a source of pseudo-random numbers, safe for concurrent use.
+type Rand interface {
+	Int63() int64
+	Intn(n int) int
+	Float64() float64
+}
+
+// Hostname is the host name reported by the kernel.
+type Hostname string
+
+// SystemClock is the Clock backed by time.Now.
+type SystemClock struct{}
+
+// Now returns the current local time.
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// globalRand is the Rand backed by the math/rand top-level functions,
+// which are safe for concurrent use.
+type globalRand struct{}
+
+func (globalRand) Int63() int64     { return rand.Int63() }
+func (globalRand) Intn(n int) int   { return rand.Intn(n) }
+func (globalRand) Float64() float64 { return rand.Float64() }
+
+// Provider registers the defaults: Clock, Rand and Hostname. Each is only
+// bound if the application has not bound the type itself, so register
+// test doubles or custom implementations freely. Like other conditional
+// registrations, the defaults activate on BootProviders.
+//
+// Example:
+//
+//	container := nasc.New()
+//	container.RegisterProvider(nascstd.Provider{})
+//	container.BootProviders()
+//
+//	clock := container.Make((*nascstd.Clock)(nil)).(nascstd.Clock)
+type Provider struct{}
+
+// Register adds the default bindings.
+func (Provider) Register(c *nasc.Nasc) error {
+	if err := c.RegisterIf(nasc.IfNotBound((*Clock)(nil)), func(c *nasc.Nasc) error {
+		return c.BindInstanceAs(SystemClock{}, (*Clock)(nil))
+	}); err != nil {
+		return err
+	}
+
+	if err := c.RegisterIf(nasc.IfNotBound((*Rand)(nil)), func(c *nasc.Nasc) error {
+		return c.BindInstanceAs(globalRand{}, (*Rand)(nil))
+	}); err != nil {
+		return err
+	}
+
+	return c.RegisterIf(nasc.IfNotBound((*Hostname)(nil)), func(c *nasc.Nasc) error {
+		return c.Factory((*Hostname)(nil), func(*nasc.Nasc) (interface{}, error) {
+			name, err := os.Hostname()
+			return Hostname(name), err
+		})
+	})
+}
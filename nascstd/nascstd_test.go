@@ -0,0 +1,50 @@
+package nascstd
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	nasc "github.com/toutaio/toutago-nasc-dependency-injector"
+)
+
+type fixedClock struct{ at time.Time }
+
+func (c fixedClock) Now() time.Time { return c.at }
+
+func TestProvider_Defaults(t *testing.T) {
+	container := nasc.New()
+	if err := container.RegisterProvider(Provider{}); err != nil {
+		t.Fatalf("RegisterProvider failed: %v", err)
+	}
+	if err := container.BootProviders(); err != nil {
+		t.Fatalf("BootProviders failed: %v", err)
+	}
+
+	if _, ok := container.Make((*Clock)(nil)).(SystemClock); !ok {
+		t.Error("Clock should default to SystemClock")
+	}
+	if n := container.Make((*Rand)(nil)).(Rand).Intn(10); n < 0 || n >= 10 {
+		t.Errorf("Intn(10) = %d, out of range", n)
+	}
+
+	want, _ := os.Hostname()
+	if got := container.Make((*Hostname)(nil)).(Hostname); string(got) != want {
+		t.Errorf("Hostname = %q, want %q", got, want)
+	}
+}
+
+func TestProvider_KeepsApplicationBindings(t *testing.T) {
+	fixed := fixedClock{at: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	container := nasc.New()
+	_ = container.BindInstanceAs(fixed, (*Clock)(nil))
+	_ = container.RegisterProvider(Provider{})
+	if err := container.BootProviders(); err != nil {
+		t.Fatalf("BootProviders failed: %v", err)
+	}
+
+	if got := container.Make((*Clock)(nil)).(Clock).Now(); !got.Equal(fixed.at) {
+		t.Errorf("application clock should be kept, got %v", got)
+	}
+}
@@ -0,0 +1,39 @@
+// Package nasctest provides testing helpers for Nasc containers.
+package nasctest
+
+import (
+	"errors"
+	"testing"
+
+	nasc "github.com/toutaio/toutago-nasc-dependency-injector"
+)
+
+// Smoke proves that the production wiring of container constructs
+// end-to-end. Bindings with a nasc.SmokeSafeTag test double are replaced by
+// the double, the sandbox is validated, and each root is resolved in a
+// sandbox scope. Every failure is reported through t.
+//
+// Example:
+//
+//	func TestWiring(t *testing.T) {
+//	    container := app.NewContainer()
+//	    nasctest.Smoke(t, container, (*app.Server)(nil), (*app.Worker)(nil))
+//	}
+func Smoke(t testing.TB, container *nasc.Nasc, roots ...interface{}) {
+	t.Helper()
+
+	err := container.SmokeTest(roots...)
+	if err == nil {
+		return
+	}
+
+	// Report joined errors individually for readable CI output
+	var joined interface{ Unwrap() []error }
+	if errors.As(err, &joined) {
+		for _, e := range joined.Unwrap() {
+			t.Errorf("smoke test: %v", e)
+		}
+		return
+	}
+	t.Errorf("smoke test: %v", err)
+}
@@ -0,0 +1,58 @@
+package nasctest
+
+import (
+	"testing"
+
+	nasc "github.com/toutaio/toutago-nasc-dependency-injector"
+)
+
+type clock interface {
+	Now() int64
+}
+
+type systemClock struct{}
+
+func (c *systemClock) Now() int64 { return 1 }
+
+type service interface {
+	Run()
+}
+
+type serviceImpl struct{}
+
+func (s *serviceImpl) Run() {}
+
+func newService(c clock) *serviceImpl { return &serviceImpl{} }
+
+// recordingT captures failures without failing the outer test.
+type recordingT struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recordingT) Helper() {}
+
+func (r *recordingT) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, format)
+}
+
+func TestSmoke_Passes(t *testing.T) {
+	container := nasc.New()
+	_ = container.Singleton((*clock)(nil), &systemClock{})
+	_ = container.BindConstructor((*service)(nil), newService)
+
+	Smoke(t, container, (*service)(nil))
+}
+
+func TestSmoke_ReportsEachFailure(t *testing.T) {
+	container := nasc.New()
+	_ = container.BindConstructor((*service)(nil), newService)
+
+	rec := &recordingT{TB: t}
+	Smoke(rec, container, (*service)(nil))
+
+	// One validation error plus one failing root
+	if len(rec.errors) != 2 {
+		t.Errorf("expected 2 reported failures, got %d", len(rec.errors))
+	}
+}
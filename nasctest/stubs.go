@@ -0,0 +1,274 @@
+package nasctest
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// WriteStubs writes the Go source of a package declaring a stub for each
+// interface token: an empty struct named after the interface with a "Stub"
+// suffix, whose methods do nothing and return zero values. Pass the stubs
+// to nasc.WithStubFallback. pkgPath is the import path of the package the
+// source is written for; its last element is the package name.
+//
+// Interfaces must be named and have only exported methods. Generic
+// interfaces are not supported.
+//
+// Example:
+//
+//	// internal/stubs/gen/main.go, run by go generate
+//	func main() {
+//	    f, err := os.Create("stubs.go")
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	    defer f.Close()
+//	    err = nasctest.WriteStubs(f, "example.com/app/internal/stubs",
+//	        (*app.Logger)(nil), (*app.Database)(nil))
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	}
+func WriteStubs(w io.Writer, pkgPath string, ifaces ...interface{}) error {
+	g := &stubWriter{pkgPath: pkgPath, imports: make(map[string]string), names: make(map[string]string)}
+
+	var body bytes.Buffer
+	stubs := make(map[string]bool, len(ifaces))
+	for _, token := range ifaces {
+		t := reflect.TypeOf(token)
+		if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Interface {
+			return fmt.Errorf("stub token must be an interface pointer like (*Logger)(nil), got %T", token)
+		}
+		t = t.Elem()
+		if t.Name() == "" || strings.Contains(t.Name(), "[") {
+			return fmt.Errorf("cannot stub %v: only named, non-generic interfaces are supported", t)
+		}
+
+		stubName := t.Name() + "Stub"
+		if stubs[stubName] {
+			return fmt.Errorf("cannot stub %v: another interface is stubbed as %s", t, stubName)
+		}
+		stubs[stubName] = true
+
+		if err := g.writeStub(&body, t, stubName); err != nil {
+			return fmt.Errorf("cannot stub %v: %w", t, err)
+		}
+	}
+
+	var src bytes.Buffer
+	src.WriteString("// Code generated by nasctest.WriteStubs. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&src, "package %s\n\n", path.Base(pkgPath))
+	if len(g.imports) > 0 {
+		paths := make([]string, 0, len(g.imports))
+		for p := range g.imports {
+			paths = append(paths, p)
+		}
+		sort.Strings(paths)
+
+		src.WriteString("import (\n")
+		for _, p := range paths {
+			if name := g.imports[p]; name != path.Base(p) {
+				fmt.Fprintf(&src, "\t%s %q\n", name, p)
+			} else {
+				fmt.Fprintf(&src, "\t%q\n", p)
+			}
+		}
+		src.WriteString(")\n\n")
+	}
+	src.Write(body.Bytes())
+
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		return fmt.Errorf("generated invalid source: %w", err)
+	}
+	_, err = w.Write(formatted)
+	return err
+}
+
+// stubWriter renders stubs, collecting the imports they need.
+type stubWriter struct {
+	pkgPath string
+
+	// imports maps import paths to the names they are referred to by
+	imports map[string]string
+
+	// names maps the names in use to their import paths
+	names map[string]string
+}
+
+// writeStub writes the stub type for interface t and its methods.
+func (g *stubWriter) writeStub(w *bytes.Buffer, t reflect.Type, stubName string) error {
+	var methods bytes.Buffer
+	for i := 0; i < t.NumMethod(); i++ {
+		m := t.Method(i)
+		if m.PkgPath != "" {
+			return fmt.Errorf("method %s is unexported", m.Name)
+		}
+		sig, err := g.signature(m.Type, true)
+		if err != nil {
+			return err
+		}
+		body := "{}"
+		if m.Type.NumOut() > 0 {
+			body = "{\n\treturn\n}"
+		}
+		fmt.Fprintf(&methods, "func (%s) %s%s %s\n\n", stubName, m.Name, sig, body)
+	}
+
+	fmt.Fprintf(w, "// %s is a %v whose methods do nothing and return zero values.\n", stubName, t)
+	fmt.Fprintf(w, "type %s struct{}\n\n", stubName)
+	w.Write(methods.Bytes())
+	return nil
+}
+
+// signature renders a function type's parameters and results, without the
+// func keyword. Named results let stub methods return zero values with a
+// bare return.
+func (g *stubWriter) signature(ft reflect.Type, namedResults bool) (string, error) {
+	params := make([]string, ft.NumIn())
+	for i := range params {
+		in := ft.In(i)
+		prefix := ""
+		if ft.IsVariadic() && i == ft.NumIn()-1 {
+			in, prefix = in.Elem(), "..."
+		}
+		s, err := g.typeString(in)
+		if err != nil {
+			return "", err
+		}
+		params[i] = prefix + s
+	}
+
+	results := make([]string, ft.NumOut())
+	for i := range results {
+		s, err := g.typeString(ft.Out(i))
+		if err != nil {
+			return "", err
+		}
+		if namedResults {
+			s = fmt.Sprintf("r%d %s", i, s)
+		}
+		results[i] = s
+	}
+
+	sig := "(" + strings.Join(params, ", ") + ")"
+	switch {
+	case len(results) == 1 && !namedResults:
+		sig += " " + results[0]
+	case len(results) > 0:
+		sig += " (" + strings.Join(results, ", ") + ")"
+	}
+	return sig, nil
+}
+
+// typeString renders t as written in the generated package.
+func (g *stubWriter) typeString(t reflect.Type) (string, error) {
+	if t.Name() != "" {
+		if strings.Contains(t.Name(), "[") {
+			return "", fmt.Errorf("generic type %v is not supported", t)
+		}
+		if t.PkgPath() == "" || t.PkgPath() == g.pkgPath {
+			return t.Name(), nil
+		}
+		return g.qualifier(t) + "." + t.Name(), nil
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice:
+		elem, err := g.typeString(t.Elem())
+		if t.Kind() == reflect.Ptr {
+			return "*" + elem, err
+		}
+		return "[]" + elem, err
+	case reflect.Array:
+		elem, err := g.typeString(t.Elem())
+		return fmt.Sprintf("[%d]%s", t.Len(), elem), err
+	case reflect.Map:
+		key, err := g.typeString(t.Key())
+		if err != nil {
+			return "", err
+		}
+		elem, err := g.typeString(t.Elem())
+		return "map[" + key + "]" + elem, err
+	case reflect.Chan:
+		elem, err := g.typeString(t.Elem())
+		switch t.ChanDir() {
+		case reflect.RecvDir:
+			return "<-chan " + elem, err
+		case reflect.SendDir:
+			return "chan<- " + elem, err
+		}
+		if t.Elem().Kind() == reflect.Chan && t.Elem().Name() == "" && t.Elem().ChanDir() == reflect.RecvDir {
+			elem = "(" + elem + ")"
+		}
+		return "chan " + elem, err
+	case reflect.Func:
+		sig, err := g.signature(t, false)
+		return "func" + sig, err
+	case reflect.Interface:
+		var methods []string
+		for i := 0; i < t.NumMethod(); i++ {
+			m := t.Method(i)
+			if m.PkgPath != "" {
+				return "", fmt.Errorf("interface %v has unexported method %s", t, m.Name)
+			}
+			sig, err := g.signature(m.Type, false)
+			if err != nil {
+				return "", err
+			}
+			methods = append(methods, m.Name+sig)
+		}
+		if len(methods) == 0 {
+			return "interface{}", nil
+		}
+		return "interface{ " + strings.Join(methods, "; ") + " }", nil
+	case reflect.Struct:
+		var fields []string
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" && f.PkgPath != g.pkgPath {
+				return "", fmt.Errorf("struct %v has unexported field %s", t, f.Name)
+			}
+			s, err := g.typeString(f.Type)
+			if err != nil {
+				return "", err
+			}
+			if !f.Anonymous {
+				s = f.Name + " " + s
+			}
+			if f.Tag != "" {
+				s += " " + fmt.Sprintf("%q", string(f.Tag))
+			}
+			fields = append(fields, s)
+		}
+		if len(fields) == 0 {
+			return "struct{}", nil
+		}
+		return "struct{ " + strings.Join(fields, "; ") + " }", nil
+	}
+	return "", fmt.Errorf("unsupported type %v", t)
+}
+
+// qualifier returns the name the package of named type t is imported as,
+// adding the import on first use.
+func (g *stubWriter) qualifier(t reflect.Type) string {
+	if name, ok := g.imports[t.PkgPath()]; ok {
+		return name
+	}
+
+	// A named type prints as "pkgname.Type", which gives the package name
+	base := strings.SplitN(t.String(), ".", 2)[0]
+	name := base
+	for i := 2; g.names[name] != ""; i++ {
+		name = fmt.Sprintf("%s%d", base, i)
+	}
+	g.imports[t.PkgPath()] = name
+	g.names[name] = t.PkgPath()
+	return name
+}
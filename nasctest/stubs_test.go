@@ -0,0 +1,78 @@
+package nasctest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+)
+
+type store interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+	Put(key string, values ...int)
+	Watch() <-chan map[string]*bytes.Buffer
+	Close()
+}
+
+type hidden interface {
+	fmt.Stringer
+	reset()
+}
+
+func TestWriteStubs(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteStubs(&buf, "example.com/app/stubs", (*store)(nil), (*fmt.Stringer)(nil)); err != nil {
+		t.Fatalf("WriteStubs failed: %v", err)
+	}
+
+	want := `// Code generated by nasctest.WriteStubs. DO NOT EDIT.
+
+package stubs
+
+import (
+	"bytes"
+	"context"
+)
+
+// storeStub is a nasctest.store whose methods do nothing and return zero values.
+type storeStub struct{}
+
+func (storeStub) Close() {}
+
+func (storeStub) Get(context.Context, string) (r0 []uint8, r1 error) {
+	return
+}
+
+func (storeStub) Put(string, ...int) {}
+
+func (storeStub) Watch() (r0 <-chan map[string]*bytes.Buffer) {
+	return
+}
+
+// StringerStub is a fmt.Stringer whose methods do nothing and return zero values.
+type StringerStub struct{}
+
+func (StringerStub) String() (r0 string) {
+	return
+}
+`
+	if buf.String() != want {
+		t.Errorf("WriteStubs output:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestWriteStubs_Errors(t *testing.T) {
+	for name, token := range map[string]interface{}{
+		"not an interface":  (*systemClock)(nil),
+		"unexported method": (*hidden)(nil),
+		"unnamed":           (*interface{ Run() })(nil),
+	} {
+		if err := WriteStubs(&bytes.Buffer{}, "example.com/app/stubs", token); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	if err := WriteStubs(&bytes.Buffer{}, "example.com/app/stubs", (*fmt.Stringer)(nil), (*fmt.Stringer)(nil)); err == nil {
+		t.Error("expected an error for two stubs with the same name")
+	}
+}
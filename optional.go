@@ -0,0 +1,92 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Optional holds a dependency that may not be bound. Declare it as a
+// constructor parameter (or auto-wired field) of type Optional[T] to
+// receive an absent value instead of a resolution failure when T has no
+// binding, mirroring `inject:"optional"` for constructor injection.
+//
+// Errors other than a missing binding, such as a failing constructor,
+// are still reported.
+//
+// Example:
+//
+//	func NewCheckout(cache nasc.Optional[Cache]) *Checkout {
+//	    c := &Checkout{}
+//	    if cache, ok := cache.Get(); ok {
+//	        c.cache = cache
+//	    }
+//	    return c
+//	}
+type Optional[T any] struct {
+	value   T
+	present bool
+}
+
+// Some returns a present Optional holding value.
+func Some[T any](value T) Optional[T] {
+	return Optional[T]{value: value, present: true}
+}
+
+// None returns an absent Optional.
+func None[T any]() Optional[T] {
+	return Optional[T]{}
+}
+
+// Get returns the value and whether it is present.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.present
+}
+
+// IsPresent reports whether the dependency was bound.
+func (o Optional[T]) IsPresent() bool {
+	return o.present
+}
+
+// OrElse returns the value if present, otherwise fallback.
+func (o Optional[T]) OrElse(fallback T) T {
+	if o.present {
+		return o.value
+	}
+	return fallback
+}
+
+// fill resolves the dependency with resolve, leaving it absent only when
+// T itself has no binding.
+func (o *Optional[T]) fill(resolve func(interface{}) interface{}) (err error) {
+	target := reflect.TypeOf((*T)(nil)).Elem()
+
+	defer func() {
+		if r := recover(); r != nil && !isNotFoundPanic(r, target) {
+			err = fmt.Errorf("failed to resolve optional %v: %v", target, r)
+		}
+	}()
+
+	resolved := resolve(reflect.Zero(reflect.PointerTo(target)).Interface())
+	value, ok := resolved.(T)
+	if !ok {
+		return fmt.Errorf("optional %v resolved to %T", target, resolved)
+	}
+	o.value, o.present = value, true
+	return nil
+}
+
+// isNotFoundPanic reports whether p is the panic Make raises when t itself
+// has no binding, rather than a failure resolving one of t's dependencies,
+// which is reported with a different prefix.
+func isNotFoundPanic(p interface{}, t reflect.Type) bool {
+	msg, ok := p.(string)
+	return ok && strings.HasPrefix(msg, fmt.Sprintf("binding not found for type %v:", t))
+}
+
+// optionalInjectable is implemented by *Optional[T] for every T.
+type optionalInjectable interface {
+	fill(resolve func(interface{}) interface{}) error
+}
+
+var optionalInjectableType = reflect.TypeOf((*optionalInjectable)(nil)).Elem()
@@ -0,0 +1,110 @@
+package nasc
+
+import (
+	"errors"
+	"testing"
+)
+
+type optionalConsumer struct {
+	logger Optional[Logger]
+}
+
+func newOptionalConsumer(logger Optional[Logger]) *optionalConsumer {
+	return &optionalConsumer{logger: logger}
+}
+
+func TestOptional_Absent(t *testing.T) {
+	container := New()
+	_ = container.BindConstructor((*optionalConsumer)(nil), newOptionalConsumer)
+
+	consumer, err := container.MakeSafe((*optionalConsumer)(nil))
+	if err != nil {
+		t.Fatalf("MakeSafe failed: %v", err)
+	}
+	if consumer.(*optionalConsumer).logger.IsPresent() {
+		t.Error("unbound dependency should be absent")
+	}
+
+	fallback := &FileLogger{}
+	if consumer.(*optionalConsumer).logger.OrElse(fallback) != fallback {
+		t.Error("OrElse should return the fallback when absent")
+	}
+}
+
+func TestOptional_Present(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+	_ = container.BindConstructor((*optionalConsumer)(nil), newOptionalConsumer)
+
+	consumer := container.Make((*optionalConsumer)(nil)).(*optionalConsumer)
+	logger, ok := consumer.logger.Get()
+	if !ok {
+		t.Fatal("bound dependency should be present")
+	}
+	if _, isConsole := logger.(*ConsoleLogger); !isConsole {
+		t.Errorf("unexpected logger %T", logger)
+	}
+}
+
+func TestOptional_PropagatesOtherErrors(t *testing.T) {
+	container := New()
+	_ = container.Factory((*Logger)(nil), func(c *Nasc) (interface{}, error) {
+		return nil, errors.New("log sink unavailable")
+	})
+	_ = container.BindConstructor((*optionalConsumer)(nil), newOptionalConsumer)
+
+	if _, err := container.MakeSafe((*optionalConsumer)(nil)); err == nil {
+		t.Error("a failing bound dependency should still be an error")
+	}
+}
+
+func TestOptional_ResolvesUnregisteredSources(t *testing.T) {
+	t.Run("lazy provider", func(t *testing.T) {
+		container := New()
+		_ = container.RegisterProvider(&lazyLoggingProvider{})
+		_ = container.BindConstructor((*optionalConsumer)(nil), newOptionalConsumer)
+
+		consumer, err := container.MakeSafe((*optionalConsumer)(nil))
+		if err != nil {
+			t.Fatalf("MakeSafe failed: %v", err)
+		}
+		if !consumer.(*optionalConsumer).logger.IsPresent() {
+			t.Error("a type provided by a lazy provider should be present")
+		}
+	})
+
+	t.Run("scope binding", func(t *testing.T) {
+		container := New()
+		_ = container.BindConstructor((*optionalConsumer)(nil), newOptionalConsumer)
+		scope := container.CreateScope()
+		defer scope.Dispose()
+		_ = scope.Bind((*Logger)(nil), &FileLogger{})
+
+		consumer := scope.Make((*optionalConsumer)(nil)).(*optionalConsumer)
+		if logger, ok := consumer.logger.Get(); !ok {
+			t.Error("a type bound in the scope should be present")
+		} else if _, isFile := logger.(*FileLogger); !isFile {
+			t.Errorf("unexpected logger %T", logger)
+		}
+	})
+}
+
+func TestOptional_MissingNestedDependencyIsAnError(t *testing.T) {
+	container := New()
+	_ = container.BindConstructor((*Logger)(nil), func(db Database) *ConsoleLogger { return &ConsoleLogger{} })
+	_ = container.BindConstructor((*optionalConsumer)(nil), newOptionalConsumer)
+
+	if _, err := container.MakeSafe((*optionalConsumer)(nil)); err == nil {
+		t.Error("a bound dependency whose own dependency is missing should be an error")
+	}
+}
+
+func TestOptional_SomeAndNone(t *testing.T) {
+	logger := &ConsoleLogger{}
+	if value, ok := Some[Logger](logger).Get(); !ok || value != logger {
+		t.Error("Some should be present")
+	}
+	if None[Logger]().IsPresent() {
+		t.Error("None should be absent")
+	}
+}
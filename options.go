@@ -1,5 +1,7 @@
 package nasc
 
+import "fmt"
+
 // Option is a function that configures a Nasc container.
 type Option func(*Nasc) error
 
@@ -20,3 +22,19 @@ func WithValidation() Option {
 		return nil
 	}
 }
+
+// WithValidationWorkers sets the number of workers used by Validate.
+// A value of 0 (the default) uses runtime.GOMAXPROCS(0) workers.
+//
+// Example:
+//
+//	container := nasc.New(nasc.WithValidationWorkers(4))
+func WithValidationWorkers(workers int) Option {
+	return func(n *Nasc) error {
+		if workers < 0 {
+			return fmt.Errorf("validation workers cannot be negative, got %d", workers)
+		}
+		n.validationWorkers = workers
+		return nil
+	}
+}
@@ -0,0 +1,154 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// OriginProvider is an optional interface for providers that name their
+// origin explicitly. By default a provider's origin is the package path of
+// its type, e.g. "github.com/acme/libpay".
+type OriginProvider interface {
+	ServiceProvider
+	Origin() string
+}
+
+// providerOrigin returns the origin recorded for bindings a provider registers.
+func providerOrigin(provider ServiceProvider) string {
+	if op, ok := provider.(OriginProvider); ok {
+		return op.Origin()
+	}
+
+	t := reflect.TypeOf(provider)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.PkgPath()
+}
+
+// withOrigin returns a handle on the container that records origin on the
+// bindings and decorators registered through it, keeping n's quotas.
+// Providers and modules register through such a handle, so concurrent
+// registrations each keep their own origin.
+func (n *Nasc) withOrigin(origin string) *Nasc {
+	return &Nasc{containerState: n.containerState, quotas: n.quotas, origin: origin}
+}
+
+// Origins returns every non-empty binding origin, sorted.
+// Use it to find which third-party modules contributed bindings.
+func (n *Nasc) Origins() []string {
+	seen := make(map[string]bool)
+	for _, b := range n.sortedBindings() {
+		if b.Origin != "" {
+			seen[b.Origin] = true
+		}
+	}
+
+	origins := make([]string, 0, len(seen))
+	for origin := range seen {
+		origins = append(origins, origin)
+	}
+	sort.Strings(origins)
+	return origins
+}
+
+// OriginView scopes queries and overrides to bindings from one origin.
+type OriginView struct {
+	container *Nasc
+	origin    string
+}
+
+// FromOrigin returns a view over the bindings registered by an origin.
+// Use an empty origin for bindings registered directly by the application.
+//
+// Example:
+//
+//	for _, b := range container.FromOrigin("github.com/acme/libpay").Bindings() {
+//	    fmt.Println(b.Type, b.Lifetime)
+//	}
+func (n *Nasc) FromOrigin(origin string) *OriginView {
+	return &OriginView{container: n, origin: origin}
+}
+
+// Bindings returns the bindings registered by this origin.
+func (v *OriginView) Bindings() []BindingInfo {
+	var infos []BindingInfo
+	for _, b := range v.container.sortedBindings() {
+		if b.Origin == v.origin {
+			infos = append(infos, describeBinding(b))
+		}
+	}
+	return infos
+}
+
+// Has reports whether this origin registered the default binding for abstractType.
+func (v *OriginView) Has(abstractType interface{}) bool {
+	binding, err := v.container.registry.Get(typeOfToken(abstractType))
+	return err == nil && binding.Origin == v.origin
+}
+
+// Replace overrides the default binding this origin registered for
+// abstractType with a different concrete type, keeping its lifetime.
+// The replacement is owned by the application (empty origin).
+//
+// Returns an error if the binding does not exist or belongs to another
+// origin, which prevents accidentally overriding the wrong module.
+//
+// Example:
+//
+//	container.FromOrigin("github.com/acme/libpay").Replace((*Gateway)(nil), &SandboxGateway{})
+func (v *OriginView) Replace(abstractType, concreteType interface{}) error {
+	if abstractType == nil {
+		return &InvalidBindingError{Reason: "abstract type cannot be nil"}
+	}
+	if concreteType == nil {
+		return &InvalidBindingError{Reason: "concrete type cannot be nil"}
+	}
+
+	abstractT := typeOfToken(abstractType)
+	concreteT := reflect.TypeOf(concreteType)
+	if concreteT.Kind() != reflect.Ptr || concreteT.Elem().Kind() != reflect.Struct {
+		return &InvalidBindingError{
+			Reason: fmt.Sprintf("concrete type must be pointer to struct, got %v", concreteT),
+		}
+	}
+
+	existing, err := v.container.registry.Get(abstractT)
+	if err != nil {
+		return err
+	}
+	if existing.Origin != v.origin {
+		return &InvalidBindingError{
+			Reason: fmt.Sprintf("binding for %v was registered by %q, not %q", abstractT, existing.Origin, v.origin),
+		}
+	}
+
+	lifetime := existing.Lifetime
+	if Lifetime(lifetime) == LifetimeFactory {
+		lifetime = string(LifetimeTransient)
+	}
+
+	replacement := &registry.Binding{
+		AbstractType:    abstractT,
+		ConcreteType:    concreteT,
+		Lifetime:        lifetime,
+		AutoWireEnabled: existing.AutoWireEnabled,
+		Priority:        existing.Priority,
+		TTL:             existing.TTL,
+	}
+	err = v.container.storeBinding(replacement, existing, func() error {
+		_, err := v.container.registry.Replace(replacement)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	v.container.singletonCache.remove(keyFor(abstractT, ""))
+	v.container.emitRegistered(replacement)
+	v.container.rebound(abstractT)
+	return nil
+}
@@ -0,0 +1,141 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type payProvider struct{}
+
+func (p *payProvider) Register(c *Nasc) error {
+	return c.Singleton((*Logger)(nil), &ConsoleLogger{})
+}
+
+func (p *payProvider) Origin() string { return "github.com/acme/libpay" }
+
+type shipProvider struct{}
+
+func (p *shipProvider) Register(c *Nasc) error {
+	return c.Singleton((*Logger)(nil), &FileLogger{})
+}
+
+func (p *shipProvider) Origin() string { return "github.com/acme/libship" }
+
+func TestOrigin_RecordedForProviderBindings(t *testing.T) {
+	container := New()
+	_ = container.Bind((*NotificationService)(nil), &EmailNotifier{})
+	_ = container.RegisterProvider(&payProvider{})
+	_ = container.RegisterProvider(&DatabaseProvider{})
+
+	origins := container.Origins()
+	if len(origins) != 2 || origins[0] != "github.com/acme/libpay" || origins[1] != nascImportPath {
+		t.Errorf("unexpected origins: %v", origins)
+	}
+
+	pay := container.FromOrigin("github.com/acme/libpay")
+	if bindings := pay.Bindings(); len(bindings) != 1 || bindings[0].Type.String() != "nasc.Logger" {
+		t.Errorf("unexpected libpay bindings: %+v", bindings)
+	}
+	if !pay.Has((*Logger)(nil)) {
+		t.Error("libpay should own the Logger binding")
+	}
+
+	app := container.FromOrigin("")
+	if !app.Has((*NotificationService)(nil)) {
+		t.Error("application should own the NotificationService binding")
+	}
+}
+
+func TestOrigin_ConflictNamesBothOrigins(t *testing.T) {
+	container := New()
+	_ = container.RegisterProvider(&payProvider{})
+
+	err := container.RegisterProvider(&shipProvider{})
+	if err == nil {
+		t.Fatal("expected conflict between providers")
+	}
+	if !strings.Contains(err.Error(), "registered by github.com/acme/libpay") {
+		t.Errorf("conflict should name the existing origin, got %v", err)
+	}
+}
+
+func TestOriginView_Replace(t *testing.T) {
+	container := New()
+	_ = container.RegisterProvider(&payProvider{})
+
+	before := container.Make((*Logger)(nil))
+
+	if err := container.FromOrigin("github.com/acme/libship").Replace((*Logger)(nil), &FileLogger{}); err == nil {
+		t.Error("replacing through the wrong origin should fail")
+	}
+
+	if err := container.FromOrigin("github.com/acme/libpay").Replace((*Logger)(nil), &FileLogger{}); err != nil {
+		t.Fatalf("Replace failed: %v", err)
+	}
+
+	after := container.Make((*Logger)(nil))
+	if _, ok := after.(*FileLogger); !ok || after == before {
+		t.Errorf("expected a fresh FileLogger singleton, got %T", after)
+	}
+	if container.Make((*Logger)(nil)) != after {
+		t.Error("replacement should keep the singleton lifetime")
+	}
+	if !container.FromOrigin("").Has((*Logger)(nil)) {
+		t.Error("replacement should be owned by the application")
+	}
+}
+
+// originRaceProvider binds named loggers under its own origin, yielding
+// between bindings so concurrent registrations interleave.
+type originRaceProvider struct {
+	origin string
+}
+
+func (p *originRaceProvider) Register(c *Nasc) error {
+	for i := 0; i < 20; i++ {
+		runtime.Gosched()
+		if err := c.BindNamed((*Logger)(nil), &ConsoleLogger{}, fmt.Sprintf("%s-%d", p.origin, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *originRaceProvider) Origin() string { return p.origin }
+
+func (p *originRaceProvider) ProviderKey() string { return p.origin }
+
+func TestOrigin_ConcurrentProviders(t *testing.T) {
+	container := New()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(origin string) {
+			defer wg.Done()
+			if err := container.RegisterProvider(&originRaceProvider{origin: origin}); err != nil {
+				t.Errorf("RegisterProvider() error = %v", err)
+			}
+		}(fmt.Sprintf("lib%d", i))
+	}
+	wg.Wait()
+
+	loggerType := reflect.TypeOf((*Logger)(nil)).Elem()
+	names := container.registry.GetAllNamedFor(loggerType)
+	if len(names) != 8*20 {
+		t.Fatalf("registered %d named loggers, want %d", len(names), 8*20)
+	}
+	for _, name := range names {
+		b, err := container.registry.GetNamed(loggerType, name)
+		if err != nil {
+			t.Fatalf("GetNamed(%q) error = %v", name, err)
+		}
+		if !strings.HasPrefix(name, b.Origin+"-") {
+			t.Errorf("binding %q recorded origin %q", name, b.Origin)
+		}
+	}
+}
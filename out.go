@@ -0,0 +1,248 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// Out marks a result object. A constructor passed to BindOut returns a
+// struct embedding Out, and each exported interface field is registered as
+// a binding of its own, honoring `inject` tags:
+//   - `inject:"name=foo"` - registers a named binding
+//   - `inject:"group=foo"` - registers a tagged binding (see MakeWithTag)
+//   - `inject:"-"` - does not register the field
+//
+// This lets one constructor provide several services at once.
+//
+// Example:
+//
+//	type StorageResult struct {
+//	    nasc.Out
+//
+//	    Reader  Reader
+//	    Writer  Writer
+//	    Primary Database    `inject:"name=primary"`
+//	    Check   HealthCheck `inject:"group=health"`
+//	}
+//
+//	func NewStorage(cfg Config) (StorageResult, error) { ... }
+type Out struct{}
+
+var outType = reflect.TypeOf(Out{})
+
+// isOutStruct reports whether t is a struct embedding Out.
+func isOutStruct(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Type == outType {
+			return true
+		}
+	}
+	return false
+}
+
+// sharedResult invokes a constructor once and shares its results between
+// the bindings registered for them (see BindOut and BindMulti). A failed
+// invocation is not cached, so the next resolution retries it.
+type sharedResult struct {
+	info   *constructorInfo
+	mu     sync.Mutex
+	values []reflect.Value
+
+	// track, if set, is called with the results of a successful invocation
+	track func(n *Nasc, values []reflect.Value)
+}
+
+// get returns the constructor's non-error results, invoking it on first use.
+func (r *sharedResult) get(n *Nasc) ([]reflect.Value, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.values != nil {
+		return r.values, nil
+	}
+
+	params, err := n.resolveConstructorParams(r.info, r.info.returnType, n)
+	if err != nil {
+		return nil, err
+	}
+	results := r.info.fn.Call(params)
+	if r.info.returnsError {
+		errValue := results[len(results)-1]
+		if !errValue.IsNil() {
+			return nil, fmt.Errorf("constructor returned error: %w", errValue.Interface().(error))
+		}
+		results = results[:len(results)-1]
+	}
+
+	r.values = results
+	if r.track != nil {
+		r.track(n, results)
+	}
+	return r.values, nil
+}
+
+// BindOut registers every exported interface field of the result object
+// returned by constructor as a separate binding. Parameters are resolved
+// as for BindConstructor.
+//
+// The constructor runs once, on the first resolution of any of its fields,
+// and all fields are served from that result, so they behave as
+// singletons and are stopped and disposed by Shutdown like them. The
+// bindings themselves are factory bindings.
+//
+// Registration is all-or-nothing: if any field's binding already exists,
+// or two fields would register the same binding, nothing is registered.
+// Returns an InvalidBindingError if constructor does not return a struct
+// embedding Out, optionally with an error.
+//
+// Example:
+//
+//	container.BindOut(NewStorage)
+//
+//	reader := container.Make((*Reader)(nil)).(Reader)
+//	db := container.MakeNamed((*Database)(nil), "primary").(Database)
+//	checks := container.MakeWithTag("health")
+func (n *Nasc) BindOut(constructor ConstructorFunc) error {
+	info, err := parseOutConstructor(constructor)
+	if err != nil {
+		return &InvalidBindingError{Reason: fmt.Sprintf("invalid constructor: %v", err)}
+	}
+
+	result := &sharedResult{info: info}
+	var bindings []*registry.Binding
+	var keys []singletonKey
+	fields := make(map[singletonKey]int)
+
+	outT := info.returnType
+	for i := 0; i < outT.NumField(); i++ {
+		field := outT.Field(i)
+		if field.Type == outType || field.PkgPath != "" {
+			continue
+		}
+
+		opts := parseInjectTag(field.Tag.Get("inject"))
+		if opts.skip {
+			continue
+		}
+		if field.Type.Kind() != reflect.Interface {
+			return &InvalidBindingError{
+				Reason: fmt.Sprintf("result field %s must be an interface, got %v", field.Name, field.Type),
+			}
+		}
+
+		binding := &registry.Binding{
+			AbstractType: field.Type,
+			Lifetime:     string(LifetimeFactory),
+			Factory:      outFieldFactory(result, i),
+		}
+		switch {
+		case opts.group != "":
+			binding.Name = fmt.Sprintf("%s%s_%v.%s", tagBindingPrefix, opts.group, outT, field.Name)
+			binding.Tags = []string{opts.group}
+		case opts.name != "":
+			binding.Name = opts.name
+		}
+
+		key := keyFor(binding.AbstractType, binding.Name)
+		if _, seen := fields[key]; seen {
+			return &InvalidBindingError{
+				Reason: fmt.Sprintf("result field %s: %v is registered by another field", field.Name, key),
+			}
+		}
+		if _, exists := n.lookupBinding(binding); exists {
+			return &InvalidBindingError{
+				Reason: fmt.Sprintf("result field %s: binding already exists for %v", field.Name, binding.AbstractType),
+			}
+		}
+		keys = append(keys, key)
+		fields[key] = i
+		bindings = append(bindings, binding)
+	}
+
+	if len(bindings) == 0 {
+		return &InvalidBindingError{Reason: fmt.Sprintf("result object %v has no fields to register", outT)}
+	}
+
+	// Cache each produced service as a singleton, once even if several
+	// fields hold it, so that Shutdown stops and disposes it
+	result.track = func(c *Nasc, values []reflect.Value) {
+		var stored []interface{}
+		for _, key := range keys {
+			field := values[0].Field(fields[key])
+			if field.IsNil() || containsInstance(stored, field.Interface()) {
+				continue
+			}
+			stored = append(stored, field.Interface())
+			c.singletonCache.store(key, field.Interface())
+		}
+	}
+
+	for _, binding := range bindings {
+		if binding.Name != "" {
+			err = n.registerNamed(binding)
+		} else {
+			err = n.register(binding)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// outFieldFactory returns a factory serving field i of a shared result object.
+func outFieldFactory(result *sharedResult, i int) FactoryFunc {
+	return func(c *Nasc) (interface{}, error) {
+		values, err := result.get(c)
+		if err != nil {
+			return nil, err
+		}
+		return values[0].Field(i).Interface(), nil
+	}
+}
+
+// parseOutConstructor analyzes a constructor returning a result object.
+func parseOutConstructor(constructor ConstructorFunc) (*constructorInfo, error) {
+	if constructor == nil {
+		return nil, fmt.Errorf("constructor cannot be nil")
+	}
+
+	fnValue := reflect.ValueOf(constructor)
+	fnType := fnValue.Type()
+	if fnType.Kind() != reflect.Func {
+		return nil, fmt.Errorf("constructor must be a function, got %v", fnType.Kind())
+	}
+
+	numOut := fnType.NumOut()
+	if numOut == 0 || numOut > 2 {
+		return nil, fmt.Errorf("constructor must return (T) or (T, error), got %d return values", numOut)
+	}
+	returnType := fnType.Out(0)
+	if !isOutStruct(returnType) {
+		return nil, fmt.Errorf("constructor must return a struct embedding nasc.Out, got %v", returnType)
+	}
+	if numOut == 2 && fnType.Out(1) != errorType {
+		return nil, fmt.Errorf("constructor's second return value must be error, got %v", fnType.Out(1))
+	}
+
+	paramTypes := make([]reflect.Type, fnType.NumIn())
+	for i := range paramTypes {
+		paramTypes[i] = fnType.In(i)
+	}
+
+	return &constructorInfo{
+		fn:           fnValue,
+		fnType:       fnType,
+		paramTypes:   paramTypes,
+		returnsError: numOut == 2,
+		returnType:   returnType,
+		numParams:    len(paramTypes),
+	}, nil
+}
@@ -0,0 +1,154 @@
+package nasc
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type storageResult struct {
+	Out
+
+	Logger   Logger
+	Database Database            `inject:"name=primary"`
+	Notifier NotificationService `inject:"group=notify"`
+	Ignored  Logger              `inject:"-"`
+}
+
+func TestBindOut_RegistersFields(t *testing.T) {
+	calls := 0
+	container := New()
+	err := container.BindOut(func() storageResult {
+		calls++
+		return storageResult{
+			Logger:   &ConsoleLogger{},
+			Database: &MockDB{},
+			Notifier: &SMSNotifier{},
+		}
+	})
+	if err != nil {
+		t.Fatalf("BindOut failed: %v", err)
+	}
+
+	logger := container.Make((*Logger)(nil))
+	if logger != container.Make((*Logger)(nil)) {
+		t.Error("result fields should be shared across resolutions")
+	}
+	if _, ok := container.MakeNamed((*Database)(nil), "primary").(*MockDB); !ok {
+		t.Error("named field should register a named binding")
+	}
+	if got := container.MakeWithTag("notify"); len(got) != 1 {
+		t.Errorf("group field should register a tagged binding, got %d", len(got))
+	}
+	if calls != 1 {
+		t.Errorf("constructor called %d times, want 1", calls)
+	}
+}
+
+func TestBindOut_ResolvesParameters(t *testing.T) {
+	type result struct {
+		Out
+		Database Database
+	}
+
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+	_ = container.BindOut(func(l Logger) (result, error) {
+		if l == nil {
+			return result{}, errors.New("missing logger")
+		}
+		return result{Database: &MockDB{}}, nil
+	})
+
+	if _, err := container.MakeSafe((*Database)(nil)); err != nil {
+		t.Errorf("MakeSafe failed: %v", err)
+	}
+}
+
+func TestBindOut_ErrorRetried(t *testing.T) {
+	type result struct {
+		Out
+		Logger Logger
+	}
+
+	fail := true
+	container := New()
+	_ = container.BindOut(func() (result, error) {
+		if fail {
+			return result{}, errors.New("boom")
+		}
+		return result{Logger: &ConsoleLogger{}}, nil
+	})
+
+	if _, err := container.MakeSafe((*Logger)(nil)); err == nil {
+		t.Error("expected constructor error")
+	}
+	fail = false
+	if _, err := container.MakeSafe((*Logger)(nil)); err != nil {
+		t.Errorf("failed constructor should be retried: %v", err)
+	}
+}
+
+type duplicateResult struct {
+	Out
+
+	Primary  Database `inject:"name=primary"`
+	Fallback Database `inject:"name=primary"`
+}
+
+func TestBindOut_Invalid(t *testing.T) {
+	type concreteField struct {
+		Out
+		DB *MockDB
+	}
+
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	tests := []struct {
+		name        string
+		constructor interface{}
+	}{
+		{"nil", nil},
+		{"not a function", storageResult{}},
+		{"no Out", func() *ConsoleLogger { return nil }},
+		{"concrete field", func() concreteField { return concreteField{} }},
+		{"existing binding", func() storageResult { return storageResult{} }},
+		{"repeated field", func() duplicateResult { return duplicateResult{} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var invalid *InvalidBindingError
+			if err := container.BindOut(tt.constructor); !errors.As(err, &invalid) {
+				t.Errorf("expected InvalidBindingError, got %v", err)
+			}
+		})
+	}
+
+	// A rejected result object registers nothing
+	if _, err := container.registry.GetNamed(typeOfToken((*Database)(nil)), "primary"); err == nil {
+		t.Error("failed BindOut should not register any field")
+	}
+}
+
+func TestBindOut_DisposedOnShutdown(t *testing.T) {
+	type result struct {
+		Out
+		Logger Logger
+		Audit  Logger `inject:"name=audit"`
+	}
+
+	logger := &traceLogger{}
+	container := New()
+	_ = container.BindOut(func() result {
+		return result{Logger: logger, Audit: logger}
+	})
+
+	container.Make((*Logger)(nil))
+	if err := container.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+	if !logger.disposed {
+		t.Error("result field should be disposed on shutdown")
+	}
+}
@@ -0,0 +1,140 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// overrideEntry records the state an override replaced.
+type overrideEntry struct {
+	previous         *registry.Binding
+	previousInstance *singletonInstance
+}
+
+// overrideStack tracks active overrides per abstract type.
+type overrideStack struct {
+	mu      sync.Mutex
+	entries map[reflect.Type][]*overrideEntry
+}
+
+// newOverrideStack creates an empty override stack.
+func newOverrideStack() *overrideStack {
+	return &overrideStack{
+		entries: make(map[reflect.Type][]*overrideEntry),
+	}
+}
+
+// Override temporarily replaces the default binding for abstractType with
+// a fixed instance and returns a function that restores the original
+// binding, including any singleton instance that was already created.
+//
+// Overrides stack: overriding the same type twice and restoring in reverse
+// order (as defer does) restores each layer in turn. Restoring out of order
+// is also safe. The restore function is idempotent.
+//
+// Override is intended for tests and panics if the arguments are invalid.
+//
+// Example:
+//
+//	fake := &FakeMailer{}
+//	defer container.Override((*Mailer)(nil), fake)()
+func (n *Nasc) Override(abstractType, instance interface{}) func() {
+	if abstractType == nil {
+		panic("cannot override nil type")
+	}
+	if instance == nil {
+		panic("override instance cannot be nil")
+	}
+	if n.IsFrozen() {
+		panic("cannot override bindings of a frozen container")
+	}
+
+	abstractT := typeOfToken(abstractType)
+	if abstractT.Kind() == reflect.Interface && !reflect.TypeOf(instance).Implements(abstractT) {
+		panic(fmt.Sprintf("override %T does not implement %v", instance, abstractT))
+	}
+
+	var factory FactoryFunc = func(*Nasc) (interface{}, error) {
+		return instance, nil
+	}
+	binding := &registry.Binding{
+		AbstractType: abstractT,
+		Lifetime:     string(LifetimeFactory),
+		Factory:      factory,
+	}
+
+	existing, _ := n.registry.Get(abstractT)
+
+	n.overrides.mu.Lock()
+	var previous *registry.Binding
+	err := n.storeBinding(binding, existing, func() error {
+		previous, _ = n.registry.Replace(binding)
+		return nil
+	})
+	if err != nil {
+		n.overrides.mu.Unlock()
+		panic(err)
+	}
+	entry := &overrideEntry{
+		previous:         previous,
+		previousInstance: n.singletonCache.take(keyFor(abstractT, "")),
+	}
+	n.overrides.entries[abstractT] = append(n.overrides.entries[abstractT], entry)
+	n.overrides.mu.Unlock()
+	n.emitRegistered(binding)
+	n.rebound(abstractT)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			if n.restoreOverride(abstractT, entry) {
+				if entry.previous != nil {
+					n.emitRegistered(entry.previous)
+				}
+				n.rebound(abstractT)
+			}
+		})
+	}
+}
+
+// restoreOverride removes an override from its type's stack, reporting
+// whether that changed the active binding.
+func (n *Nasc) restoreOverride(abstractT reflect.Type, entry *overrideEntry) (restored bool) {
+	n.overrides.mu.Lock()
+	defer n.overrides.mu.Unlock()
+
+	stack := n.overrides.entries[abstractT]
+	for i, e := range stack {
+		if e != entry {
+			continue
+		}
+
+		if i == len(stack)-1 {
+			// Top of the stack: reinstate what this override replaced
+			if entry.previous != nil {
+				_, _ = n.registry.Replace(entry.previous)
+			} else {
+				n.registry.Remove(abstractT)
+			}
+			n.singletonCache.put(keyFor(abstractT, ""), entry.previousInstance)
+			restored = true
+		} else {
+			// Buried override: the layer above now replaces what this one did
+			stack[i+1].previous = entry.previous
+			stack[i+1].previousInstance = entry.previousInstance
+		}
+
+		stack = append(stack[:i], stack[i+1:]...)
+		break
+	}
+
+	if len(stack) == 0 {
+		delete(n.overrides.entries, abstractT)
+	} else {
+		n.overrides.entries[abstractT] = stack
+	}
+	return restored
+}
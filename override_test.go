@@ -0,0 +1,85 @@
+package nasc
+
+import "testing"
+
+func TestOverride_RestoresSingleton(t *testing.T) {
+	container := New()
+	_ = container.Singleton((*Logger)(nil), &ConsoleLogger{})
+	original := container.Make((*Logger)(nil))
+
+	fake := &FileLogger{}
+	restore := container.Override((*Logger)(nil), fake)
+
+	if container.Make((*Logger)(nil)) != fake {
+		t.Error("expected the override instance")
+	}
+	if container.CreateScope().Make((*Logger)(nil)) != fake {
+		t.Error("scopes should see the override")
+	}
+
+	restore()
+	restore() // idempotent
+
+	if container.Make((*Logger)(nil)) != original {
+		t.Error("restore should bring back the original singleton instance")
+	}
+}
+
+func TestOverride_Stacked(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	first := &FileLogger{filename: "first"}
+	second := &FileLogger{filename: "second"}
+
+	restoreFirst := container.Override((*Logger)(nil), first)
+	restoreSecond := container.Override((*Logger)(nil), second)
+
+	if container.Make((*Logger)(nil)) != second {
+		t.Error("expected the innermost override")
+	}
+
+	// Restoring out of order keeps the innermost override active
+	restoreFirst()
+	if container.Make((*Logger)(nil)) != second {
+		t.Error("restoring a buried override should not affect the top")
+	}
+
+	restoreSecond()
+	if _, ok := container.Make((*Logger)(nil)).(*ConsoleLogger); !ok {
+		t.Error("expected the original binding after all restores")
+	}
+}
+
+func TestOverride_UnboundType(t *testing.T) {
+	container := New()
+
+	restore := container.Override((*Logger)(nil), &ConsoleLogger{})
+	if _, err := container.MakeSafe((*Logger)(nil)); err != nil {
+		t.Errorf("override of unbound type should resolve: %v", err)
+	}
+
+	restore()
+	if _, err := container.MakeSafe((*Logger)(nil)); err == nil {
+		t.Error("binding should be removed after restore")
+	}
+}
+
+func TestOverride_InvalidArguments(t *testing.T) {
+	container := New()
+
+	for name, fn := range map[string]func(){
+		"nil type":     func() { container.Override(nil, &ConsoleLogger{}) },
+		"nil instance": func() { container.Override((*Logger)(nil), nil) },
+		"wrong type":   func() { container.Override((*Logger)(nil), &MockDB{}) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected panic", name)
+				}
+			}()
+			fn()
+		}()
+	}
+}
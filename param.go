@@ -0,0 +1,77 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ConstructorOption configures how a constructor registered with
+// BindConstructor, SingletonConstructor or ScopedConstructor is resolved.
+type ConstructorOption func(*constructorInfo) error
+
+// ParamOption configures how one constructor parameter is resolved.
+type ParamOption func(*paramSpec)
+
+// paramSpec collects the settings applied by ParamOption values.
+type paramSpec struct {
+	name string
+}
+
+// Param annotates the constructor parameter at index (starting at 0).
+// Registration fails if the constructor has no such parameter.
+//
+// Example:
+//
+//	// func NewReplicator(primary, replica Database) *Replicator
+//	container.BindConstructor((*Replicator)(nil), NewReplicator,
+//	    nasc.Param(0, nasc.Named("primary")),
+//	    nasc.Param(1, nasc.Named("replica")),
+//	)
+func Param(index int, opts ...ParamOption) ConstructorOption {
+	return func(info *constructorInfo) error {
+		if index < 0 || index >= info.numParams {
+			return fmt.Errorf("parameter index %d out of range for constructor with %d parameter(s)", index, info.numParams)
+		}
+
+		spec := paramSpec{}
+		for _, opt := range opts {
+			opt(&spec)
+		}
+
+		if spec.name != "" {
+			if info.paramNames == nil {
+				info.paramNames = make([]string, info.numParams)
+			}
+			info.paramNames[index] = spec.name
+		}
+		return nil
+	}
+}
+
+// Named resolves a constructor parameter from the named binding instead of
+// the default binding (see BindNamed).
+func Named(name string) ParamOption {
+	return func(spec *paramSpec) {
+		spec.name = name
+	}
+}
+
+// paramName returns the binding name for parameter i, or "" for the default.
+func (info *constructorInfo) paramName(i int) string {
+	if info.paramNames == nil {
+		return ""
+	}
+	return info.paramNames[i]
+}
+
+// resolveNamedParam resolves a parameter annotated with Named with r.
+func (n *Nasc) resolveNamedParam(t reflect.Type, name string, r resolver) (value reflect.Value, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("resolution panicked: %v", p)
+		}
+	}()
+
+	token := reflect.Zero(reflect.PointerTo(t)).Interface()
+	return paramValue(r.MakeNamed(token, name), t)
+}
@@ -0,0 +1,96 @@
+package nasc
+
+import (
+	"strings"
+	"testing"
+)
+
+type Replicator struct {
+	primary Database
+	replica Database
+}
+
+type ReplicaDB struct {
+	host string
+}
+
+func (r *ReplicaDB) Connect() error { return nil }
+
+func NewReplicator(primary, replica Database) *Replicator {
+	return &Replicator{primary: primary, replica: replica}
+}
+
+func TestParam_Named(t *testing.T) {
+	container := New()
+	_ = container.Singleton((*Database)(nil), &MockDB{})
+	_ = container.BindNamed((*Database)(nil), &ReplicaDB{}, "replica")
+
+	err := container.BindConstructor((*Replicator)(nil), NewReplicator, Param(1, Named("replica")))
+	if err != nil {
+		t.Fatalf("BindConstructor failed: %v", err)
+	}
+
+	check := func(r *Replicator) {
+		t.Helper()
+		if _, ok := r.primary.(*MockDB); !ok {
+			t.Errorf("unannotated parameter should use the default binding, got %T", r.primary)
+		}
+		if _, ok := r.replica.(*ReplicaDB); !ok {
+			t.Errorf("named parameter should use the named binding, got %T", r.replica)
+		}
+	}
+
+	check(container.Make((*Replicator)(nil)).(*Replicator))
+
+	instance, err := container.MakeSafe((*Replicator)(nil))
+	if err != nil {
+		t.Fatalf("MakeSafe failed: %v", err)
+	}
+	check(instance.(*Replicator))
+}
+
+func TestParam_NamedScoped(t *testing.T) {
+	container := New()
+	_ = container.Singleton((*Database)(nil), &MockDB{})
+	_ = As[Database, ReplicaDB](container, WithName("replica"), WithLifetime(LifetimeScoped))
+	_ = container.ScopedConstructor((*Replicator)(nil), NewReplicator, Param(1, Named("replica")))
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+
+	replicator := scope.Make((*Replicator)(nil)).(*Replicator)
+	if replicator.replica != scope.MakeNamed((*Database)(nil), "replica") {
+		t.Error("a named scoped parameter should be resolved from the scope")
+	}
+}
+
+func TestParam_Errors(t *testing.T) {
+	container := New()
+
+	err := container.BindConstructor((*Replicator)(nil), NewReplicator, Param(2, Named("replica")))
+	if _, ok := err.(*InvalidBindingError); !ok || !strings.Contains(err.Error(), "out of range") {
+		t.Errorf("expected out of range error, got %v", err)
+	}
+
+	_ = container.Singleton((*Database)(nil), &MockDB{})
+	_ = container.BindConstructor((*Replicator)(nil), NewReplicator, Param(0, Named("missing")))
+	if _, err := container.MakeSafe((*Replicator)(nil)); err == nil {
+		t.Error("expected error for a missing named binding")
+	}
+}
+
+func TestParam_Export(t *testing.T) {
+	container := New()
+	_ = container.SingletonConstructor((*Replicator)(nil), NewReplicator,
+		Param(0, Named("primary")), Param(1, Named("replica")))
+
+	src, err := container.ExportGo("wiring")
+	if err != nil {
+		t.Fatalf("ExportGo failed: %v", err)
+	}
+
+	want := `c.SingletonConstructor((*nasc.Replicator)(nil), nasc.NewReplicator, nasc.Param(0, nasc.Named("primary")), nasc.Param(1, nasc.Named("replica")))`
+	if !strings.Contains(string(src), want) {
+		t.Errorf("generated code missing %q:\n%s", want, src)
+	}
+}
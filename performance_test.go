@@ -264,3 +264,54 @@ func BenchmarkValidation(b *testing.B) {
 		_ = container.Validate()
 	}
 }
+
+// buildLayeredGraph registers layers*width constructor bindings where every
+// node depends on all nodes of the previous layer.
+func buildLayeredGraph(options ...Option) *Nasc {
+	const layers, width = 20, 50
+
+	container := New(options...)
+	var previous []reflect.Type
+	id := 0
+	for l := 0; l < layers; l++ {
+		current := make([]reflect.Type, 0, width)
+		for w := 0; w < width; w++ {
+			id++
+			nodeType := reflect.StructOf([]reflect.StructField{{
+				Name: "ID",
+				Type: reflect.ArrayOf(id, reflect.TypeOf(byte(0))),
+			}})
+			ptrType := reflect.PointerTo(nodeType)
+
+			fnType := reflect.FuncOf(previous, []reflect.Type{ptrType}, false)
+			fn := reflect.MakeFunc(fnType, func([]reflect.Value) []reflect.Value {
+				return []reflect.Value{reflect.New(nodeType)}
+			})
+
+			token := reflect.Zero(reflect.PointerTo(ptrType)).Interface()
+			_ = container.BindConstructor(token, fn.Interface())
+			current = append(current, ptrType)
+		}
+		previous = current
+	}
+	return container
+}
+
+// BenchmarkValidation_LargeGraph compares serial and parallel validation.
+func BenchmarkValidation_LargeGraph(b *testing.B) {
+	b.Run("serial", func(b *testing.B) {
+		container := buildLayeredGraph(WithValidationWorkers(1))
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = container.Validate()
+		}
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		container := buildLayeredGraph()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = container.Validate()
+		}
+	})
+}
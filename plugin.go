@@ -0,0 +1,192 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// APIVersion is the container API version plugins negotiate against.
+// Plugins are compatible when they target the same major version and a
+// minor version no newer than the host's.
+const APIVersion = "1.0"
+
+// PluginManifest describes what a plugin needs from the host container.
+type PluginManifest struct {
+	// Name identifies the plugin in error messages. Defaults to the plugin's type.
+	Name string
+
+	// APIVersion is the container API version the plugin was built against,
+	// in "MAJOR.MINOR" form.
+	APIVersion string
+
+	// Requires lists abstract types the plugin resolves from the host,
+	// as interface tokens like (*Logger)(nil).
+	Requires []interface{}
+}
+
+// Plugin is a service provider that declares its requirements up front,
+// so the host can refuse it before any of its registrations run.
+//
+// Example:
+//
+//	type AuditPlugin struct{}
+//
+//	func (p *AuditPlugin) Manifest() nasc.PluginManifest {
+//	    return nasc.PluginManifest{
+//	        Name:       "audit",
+//	        APIVersion: "1.0",
+//	        Requires:   []interface{}{(*Logger)(nil)},
+//	    }
+//	}
+//
+//	func (p *AuditPlugin) Register(c *nasc.Nasc) error {
+//	    return c.Singleton((*Auditor)(nil), &FileAuditor{})
+//	}
+type Plugin interface {
+	ServiceProvider
+	Manifest() PluginManifest
+}
+
+// RegisterPlugin checks a plugin's manifest with CheckPlugin and, when it is
+// compatible, registers the plugin like any other provider.
+//
+// Example:
+//
+//	if err := container.RegisterPlugin(&AuditPlugin{}); err != nil {
+//	    log.Printf("skipping plugin: %v", err)
+//	}
+func (n *Nasc) RegisterPlugin(plugin Plugin) error {
+	if plugin == nil {
+		return fmt.Errorf("plugin cannot be nil")
+	}
+	if err := n.CheckPlugin(plugin); err != nil {
+		return err
+	}
+	return n.RegisterProvider(plugin)
+}
+
+// CheckPlugin reports whether a plugin can run in this container without
+// registering it. It verifies the API version and that every required type
+// is bound with matching method signatures.
+//
+// Returns an IncompatiblePluginError listing every problem found.
+func (n *Nasc) CheckPlugin(plugin Plugin) error {
+	if plugin == nil {
+		return fmt.Errorf("plugin cannot be nil")
+	}
+
+	manifest := plugin.Manifest()
+	name := manifest.Name
+	if name == "" {
+		name = reflect.TypeOf(plugin).String()
+	}
+
+	var reasons []string
+	if reason := checkAPIVersion(manifest.APIVersion); reason != "" {
+		reasons = append(reasons, reason)
+	}
+	for _, required := range manifest.Requires {
+		if reason := n.checkRequirement(required); reason != "" {
+			reasons = append(reasons, reason)
+		}
+	}
+
+	if len(reasons) > 0 {
+		return &IncompatiblePluginError{Plugin: name, Reasons: reasons}
+	}
+	return nil
+}
+
+// checkAPIVersion compares a plugin's target version with APIVersion.
+func checkAPIVersion(version string) string {
+	hostMajor, hostMinor, _ := parseAPIVersion(APIVersion)
+
+	major, minor, ok := parseAPIVersion(version)
+	if !ok {
+		return fmt.Sprintf("invalid API version %q, expected MAJOR.MINOR", version)
+	}
+	if major != hostMajor {
+		return fmt.Sprintf("API version %s is not supported by host version %s", version, APIVersion)
+	}
+	if minor > hostMinor {
+		return fmt.Sprintf("API version %s is newer than host version %s", version, APIVersion)
+	}
+	return ""
+}
+
+// parseAPIVersion splits a "MAJOR.MINOR" version string.
+func parseAPIVersion(version string) (major, minor int, ok bool) {
+	parts := strings.Split(version, ".")
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil || major < 0 {
+		return 0, 0, false
+	}
+	minor, err = strconv.Atoi(parts[1])
+	if err != nil || minor < 0 {
+		return 0, 0, false
+	}
+	return major, minor, true
+}
+
+// checkRequirement verifies that a required type is bound in the container.
+// When the exact type is missing but a type with the same import path and
+// name is bound (e.g. a plugin compiled against another version of the
+// package), their method signatures are compared to explain the mismatch.
+// Such a type never satisfies the requirement, since resolving the required
+// type would still fail.
+func (n *Nasc) checkRequirement(required interface{}) string {
+	if required == nil {
+		return "required type cannot be nil"
+	}
+
+	t := typeOfToken(required)
+	if n.registry.Has(t) {
+		return ""
+	}
+
+	name := qualifiedName(t)
+	for _, bound := range n.registry.GetAllTypes() {
+		if name != "" && qualifiedName(bound) == name {
+			if diff := methodSetDiff(t, bound); diff != "" {
+				return fmt.Sprintf("required type %v does not match host: %s", t, diff)
+			}
+			return fmt.Sprintf("required type %v is not bound in the host container, which binds a distinct type of the same name", t)
+		}
+	}
+	return fmt.Sprintf("required type %v is not bound in the host container", t)
+}
+
+// qualifiedName returns t's import path and name, prefixed with a "*" for
+// each level of pointer, or "" for unnamed types.
+func qualifiedName(t reflect.Type) string {
+	prefix := ""
+	for t.Kind() == reflect.Ptr {
+		prefix += "*"
+		t = t.Elem()
+	}
+	if t.Name() == "" {
+		return ""
+	}
+	return prefix + t.PkgPath() + "." + t.Name()
+}
+
+// methodSetDiff describes the first method of want that have does not
+// provide with an identical signature.
+func methodSetDiff(want, have reflect.Type) string {
+	for i := 0; i < want.NumMethod(); i++ {
+		wm := want.Method(i)
+		hm, ok := have.MethodByName(wm.Name)
+		if !ok {
+			return fmt.Sprintf("method %s is missing", wm.Name)
+		}
+		if wm.Type.String() != hm.Type.String() {
+			return fmt.Sprintf("method %s has signature %v, plugin expects %v", wm.Name, hm.Type, wm.Type)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,142 @@
+package nasc
+
+import (
+	"errors"
+	randv1 "math/rand"
+	randv2 "math/rand/v2"
+	"strings"
+	"testing"
+)
+
+// nascLogger is the package-level Logger, shadowed in some tests.
+type nascLogger = Logger
+
+type testPlugin struct {
+	manifest   PluginManifest
+	registered bool
+}
+
+func (p *testPlugin) Manifest() PluginManifest { return p.manifest }
+
+func (p *testPlugin) Register(container *Nasc) error {
+	p.registered = true
+	return nil
+}
+
+func TestRegisterPlugin_Compatible(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	plugin := &testPlugin{manifest: PluginManifest{
+		Name:       "audit",
+		APIVersion: APIVersion,
+		Requires:   []interface{}{(*Logger)(nil)},
+	}}
+	if err := container.RegisterPlugin(plugin); err != nil {
+		t.Fatalf("RegisterPlugin failed: %v", err)
+	}
+	if !plugin.registered {
+		t.Error("compatible plugin should be registered")
+	}
+}
+
+func TestRegisterPlugin_Incompatible(t *testing.T) {
+	container := New()
+
+	plugin := &testPlugin{manifest: PluginManifest{
+		Name:       "audit",
+		APIVersion: "2.0",
+		Requires:   []interface{}{(*Logger)(nil)},
+	}}
+	err := container.RegisterPlugin(plugin)
+
+	var incompatible *IncompatiblePluginError
+	if !errors.As(err, &incompatible) {
+		t.Fatalf("expected IncompatiblePluginError, got %v", err)
+	}
+	if len(incompatible.Reasons) != 2 {
+		t.Errorf("expected 2 reasons, got %v", incompatible.Reasons)
+	}
+	if plugin.registered {
+		t.Error("incompatible plugin must not be registered")
+	}
+}
+
+func TestCheckPlugin_APIVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		ok      bool
+	}{
+		{APIVersion, true},
+		{"1.0", true},
+		{"1.99", false},
+		{"0.9", false},
+		{"1", false},
+		{"", false},
+	}
+
+	container := New()
+	for _, tt := range tests {
+		err := container.CheckPlugin(&testPlugin{manifest: PluginManifest{APIVersion: tt.version}})
+		if (err == nil) != tt.ok {
+			t.Errorf("version %q: got err=%v, want ok=%v", tt.version, err, tt.ok)
+		}
+	}
+}
+
+func TestCheckPlugin_SignatureMismatch(t *testing.T) {
+	// A plugin built against a different version of the package sees a
+	// distinct type with the same name
+	type Logger interface {
+		Log(level int, msg string)
+	}
+
+	host := New()
+	_ = host.Bind((*Database)(nil), &MockDB{})
+	_ = host.Bind((*nascLogger)(nil), &ConsoleLogger{})
+
+	err := host.CheckPlugin(&testPlugin{manifest: PluginManifest{
+		APIVersion: APIVersion,
+		Requires:   []interface{}{(*Logger)(nil)},
+	}})
+	if err == nil {
+		t.Fatal("expected signature mismatch")
+	}
+	if !strings.Contains(err.Error(), "method Log has signature") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestCheckPlugin_SameNameOtherPackage(t *testing.T) {
+	// math/rand and math/rand/v2 both declare a Zipf with an identical
+	// Uint64 method, so only the import path tells them apart
+	host := New()
+	_ = host.BindInstanceAs(&randv1.Zipf{}, (**randv1.Zipf)(nil))
+
+	err := host.CheckPlugin(&testPlugin{manifest: PluginManifest{
+		APIVersion: APIVersion,
+		Requires:   []interface{}{(**randv2.Zipf)(nil)},
+	}})
+	if err == nil || !strings.Contains(err.Error(), "is not bound") {
+		t.Errorf("expected a type from another package to be reported unbound, got %v", err)
+	}
+}
+
+func TestCheckPlugin_SameNameSameMethods(t *testing.T) {
+	// A distinct type that matches the bound one method for method still
+	// cannot be resolved, so it does not satisfy the requirement
+	type Logger interface {
+		Log(msg string)
+	}
+
+	host := New()
+	_ = host.Bind((*nascLogger)(nil), &ConsoleLogger{})
+
+	err := host.CheckPlugin(&testPlugin{manifest: PluginManifest{
+		APIVersion: APIVersion,
+		Requires:   []interface{}{(*Logger)(nil)},
+	}})
+	if err == nil || !strings.Contains(err.Error(), "distinct type of the same name") {
+		t.Errorf("expected the requirement to be reported unbound, got %v", err)
+	}
+}
@@ -0,0 +1,127 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// ProfileTest is the profile name that enables test-only behavior such as
+// stub fallback (see WithStubFallback).
+const ProfileTest = "test"
+
+// WithProfile sets the profile the container runs under, e.g. "test" or
+// "production". Profiles gate behavior that must never run in production.
+//
+// Example:
+//
+//	container := nasc.New(nasc.WithProfile(nasc.ProfileTest), nasc.WithStubFallback())
+func WithProfile(profile string) Option {
+	return func(n *Nasc) error {
+		n.profile = profile
+		return nil
+	}
+}
+
+// WithStubFallback lets a container under the test profile resolve unbound
+// interface types to permissive stubs instead of failing. Every stubbed type
+// is recorded and reported by StubbedTypes, so partial graphs can be
+// exercised before all providers exist.
+//
+// Go cannot synthesize method implementations at runtime, so the stubs
+// come from stubs: implementations whose methods return zero values, as
+// generated by nasctest.WriteStubs. An unbound interface is resolved to the
+// first of them that implements it. Interfaces without methods need no
+// stub; any other interface no stub implements fails to resolve as usual.
+//
+// The option has no effect outside ProfileTest.
+//
+// Example:
+//
+//	container := nasc.New(nasc.WithProfile(nasc.ProfileTest),
+//	    nasc.WithStubFallback(stubs.LoggerStub{}, stubs.DatabaseStub{}))
+func WithStubFallback(stubs ...interface{}) Option {
+	return func(n *Nasc) error {
+		for _, stub := range stubs {
+			if stub == nil {
+				return fmt.Errorf("stub cannot be nil")
+			}
+		}
+		n.stubs = newStubRegistry(stubs)
+		return nil
+	}
+}
+
+// Profile returns the profile set with WithProfile, or "" if none.
+func (n *Nasc) Profile() string {
+	return n.profile
+}
+
+// StubbedTypes returns the interface types resolved to stubs so far,
+// sorted by name.
+func (n *Nasc) StubbedTypes() []reflect.Type {
+	if n.stubs == nil {
+		return nil
+	}
+
+	n.stubs.mu.Lock()
+	defer n.stubs.mu.Unlock()
+
+	types := make([]reflect.Type, 0, len(n.stubs.instances))
+	for t := range n.stubs.instances {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return registry.TypeLess(types[i], types[j])
+	})
+	return types
+}
+
+// stubRegistry holds the stubs given to WithStubFallback and caches the
+// one chosen for each interface type.
+type stubRegistry struct {
+	mu        sync.Mutex
+	provided  []interface{}
+	instances map[reflect.Type]interface{}
+}
+
+// newStubRegistry creates a stub registry choosing from provided.
+func newStubRegistry(provided []interface{}) *stubRegistry {
+	return &stubRegistry{provided: provided, instances: make(map[reflect.Type]interface{})}
+}
+
+// stubFor returns a stub for an unbound interface when stub fallback is
+// active. It reports false for non-interface types and for interfaces no
+// provided stub implements.
+func (n *Nasc) stubFor(t reflect.Type) (interface{}, bool) {
+	if n.stubs == nil || n.profile != ProfileTest || t.Kind() != reflect.Interface {
+		return nil, false
+	}
+
+	n.stubs.mu.Lock()
+	defer n.stubs.mu.Unlock()
+
+	if stub, ok := n.stubs.instances[t]; ok {
+		return stub, true
+	}
+
+	var stub interface{}
+	for _, candidate := range n.stubs.provided {
+		if reflect.TypeOf(candidate).Implements(t) {
+			stub = candidate
+			break
+		}
+	}
+	if stub == nil {
+		// Without methods there is nothing to call, so any value will do
+		if t.NumMethod() > 0 {
+			return nil, false
+		}
+		stub = struct{}{}
+	}
+	n.stubs.instances[t] = stub
+	return stub, true
+}
@@ -0,0 +1,85 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// Stubs as written by nasctest.WriteStubs.
+type LoggerStub struct{}
+
+func (LoggerStub) Log(string) {}
+
+type DatabaseStub struct{}
+
+func (DatabaseStub) Connect() (r0 error) {
+	return
+}
+
+func TestStubFallback_ResolvesUnboundInterfaces(t *testing.T) {
+	container := New(WithProfile(ProfileTest), WithStubFallback(LoggerStub{}, DatabaseStub{}))
+	_ = container.BindConstructor((*ConstructorService)(nil), NewServiceWithDeps)
+
+	svc, err := container.MakeSafe((*ConstructorService)(nil))
+	if err != nil {
+		t.Fatalf("expected stubs for missing dependencies, got %v", err)
+	}
+	impl := svc.(*ConstructorServiceImpl)
+	if impl.Logger == nil {
+		t.Fatal("missing Logger should be stubbed")
+	}
+	impl.Logger.Log("stubs must not panic")
+	if err := impl.Database.Connect(); err != nil {
+		t.Errorf("stub methods should return zero values, got %v", err)
+	}
+
+	stubbed := container.StubbedTypes()
+	want := []reflect.Type{typeOfToken((*Database)(nil)), typeOfToken((*Logger)(nil))}
+	if !reflect.DeepEqual(stubbed, want) {
+		t.Errorf("StubbedTypes() = %v, want %v", stubbed, want)
+	}
+
+	if container.Make((*Logger)(nil)) != container.Make((*Logger)(nil)) {
+		t.Error("a type should be stubbed once")
+	}
+}
+
+func TestStubFallback_WithoutStub(t *testing.T) {
+	container := New(WithProfile(ProfileTest), WithStubFallback(LoggerStub{}))
+
+	if _, err := container.MakeSafe((*Database)(nil)); err == nil {
+		t.Error("an interface no stub implements should not resolve")
+	}
+	if _, err := container.MakeSafe((*fmt.Stringer)(nil)); err == nil {
+		t.Error("an interface with methods should not be stubbed without a stub")
+	}
+	if _, err := container.MakeSafe((*interface{})(nil)); err != nil {
+		t.Errorf("an interface without methods needs no stub, got %v", err)
+	}
+	if err := WithStubFallback(nil)(New()); err == nil {
+		t.Error("a nil stub should be rejected")
+	}
+}
+
+func TestStubFallback_RequiresTestProfile(t *testing.T) {
+	container := New(WithProfile("production"), WithStubFallback(LoggerStub{}))
+
+	if _, err := container.MakeSafe((*Logger)(nil)); err == nil {
+		t.Error("stub fallback must not apply outside the test profile")
+	}
+	if len(container.StubbedTypes()) != 0 {
+		t.Error("no types should be stubbed")
+	}
+}
+
+func TestStubFallback_Disabled(t *testing.T) {
+	container := New(WithProfile(ProfileTest))
+
+	if _, err := container.MakeSafe((*Logger)(nil)); err == nil {
+		t.Error("stub fallback must be opt-in")
+	}
+	if container.Profile() != ProfileTest {
+		t.Errorf("Profile() = %q, want %q", container.Profile(), ProfileTest)
+	}
+}
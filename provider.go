@@ -1,6 +1,7 @@
 package nasc
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 )
@@ -58,17 +59,69 @@ type DeferredProvider interface {
 	ShouldRegister(container *Nasc) bool
 }
 
-// providerEntry tracks a registered provider.
+// DependentProvider is an optional interface for providers that depend on
+// other providers. Requires lists the provider types that must be
+// registered first, such as reflect.TypeOf(&DatabaseProvider{}). A
+// provider registered before its requirements is held back and registered
+// as soon as they are, so registration and boot follow dependency order
+// whatever order RegisterProvider is called in.
+//
+// Example:
+//
+//	type CacheProvider struct{}
+//
+//	func (p *CacheProvider) Requires() []reflect.Type {
+//	    return []reflect.Type{reflect.TypeOf(&ConfigProvider{})}
+//	}
+type DependentProvider interface {
+	ServiceProvider
+	Requires() []reflect.Type
+}
+
+// KeyedProvider is an optional interface for providers that can be
+// registered several times with different configurations. Providers are
+// deduplicated by type; a keyed provider is only a duplicate of a provider
+// of the same type with the same key.
+//
+// Example:
+//
+//	type DatabaseProvider struct{ Name, DSN string }
+//
+//	func (p *DatabaseProvider) ProviderKey() string { return p.Name }
+//
+//	container.RegisterProvider(&DatabaseProvider{Name: "primary", DSN: primaryDSN})
+//	container.RegisterProvider(&DatabaseProvider{Name: "analytics", DSN: analyticsDSN})
+type KeyedProvider interface {
+	ServiceProvider
+	ProviderKey() string
+}
+
+// providerEntry tracks a registered provider. Its fields are guarded by
+// the container's providerMu.
 type providerEntry struct {
 	provider ServiceProvider
 	booted   bool
+	booting  bool
+
+	// quotas are enforced while the provider boots (see RegisterProviderWithQuota)
+	quotas []*quotaState
+}
+
+// pendingProvider is a provider held back until the providers it requires
+// are registered.
+type pendingProvider struct {
+	provider ServiceProvider
+	quotas   []*quotaState // the quotas it was registered under
 }
 
 // RegisterProvider registers a service provider with the container.
-// The provider's Register method is called immediately.
+// The provider's Register method is called immediately, unless the provider
+// is a LazyProvider, which registers when its types are first resolved.
 // If the provider implements BootableProvider, its Boot method will be called
 // when BootProviders() is invoked.
 //
+// RegisterProvider is safe for concurrent use.
+//
 // Example:
 //
 //	container.RegisterProvider(&LoggingProvider{})
@@ -78,6 +131,9 @@ func (n *Nasc) RegisterProvider(provider ServiceProvider) error {
 	if provider == nil {
 		return fmt.Errorf("provider cannot be nil")
 	}
+	if n.IsFrozen() {
+		return ErrContainerFrozen
+	}
 
 	// Check if provider is deferred
 	if deferred, ok := provider.(DeferredProvider); ok {
@@ -87,17 +143,60 @@ func (n *Nasc) RegisterProvider(provider ServiceProvider) error {
 		}
 	}
 
-	// Check if already registered (by type)
+	n.providerMu.Lock()
+
+	// Check if already registered
+	if n.isRegistered(provider) {
+		n.providerMu.Unlock()
+		return nil
+	}
+
+	// Lazy providers register when one of their types is first resolved
+	if lazy, ok := provider.(LazyProvider); ok {
+		err := n.deferLazyProvider(lazy)
+		n.providerMu.Unlock()
+		return err
+	}
+
+	// Hold the provider back until the providers it requires are registered
+	if len(n.missingRequirements(provider)) > 0 {
+		n.pendingProviders = append(n.pendingProviders, pendingProvider{provider: provider, quotas: n.quotas})
+		n.providerMu.Unlock()
+		return nil
+	}
+
+	n.registering = append(n.registering, provider)
+	n.providerMu.Unlock()
+
+	if err := n.registerProviderNow(provider); err != nil {
+		return err
+	}
+	return n.registerReadyProviders()
+}
+
+// registerProviderNow calls a provider's Register method and tracks it.
+// The caller must have added the provider to n.registering, so concurrent
+// registrations of the same provider are skipped in the meantime.
+func (n *Nasc) registerProviderNow(provider ServiceProvider) error {
 	providerType := reflect.TypeOf(provider)
-	for _, entry := range n.providers {
-		if reflect.TypeOf(entry.provider) == providerType {
-			// Already registered, skip
-			return nil
-		}
+
+	// Call Register method, recording the provider as origin of its bindings
+	end := n.trace(TimelineProviderRegister, providerType.String())
+	err := n.configureProvider(provider)
+	if err == nil {
+		err = provider.Register(n.withOrigin(providerOrigin(provider)))
 	}
+	end(err)
 
-	// Call Register method
-	if err := provider.Register(n); err != nil {
+	n.providerMu.Lock()
+	defer n.providerMu.Unlock()
+	for i, registering := range n.registering {
+		if registering == provider {
+			n.registering = append(n.registering[:i:i], n.registering[i+1:]...)
+			break
+		}
+	}
+	if err != nil {
 		return fmt.Errorf("provider registration failed: %w", err)
 	}
 
@@ -105,13 +204,136 @@ func (n *Nasc) RegisterProvider(provider ServiceProvider) error {
 	n.providers = append(n.providers, &providerEntry{
 		provider: provider,
 		booted:   false,
+		quotas:   n.quotas,
 	})
 
 	return nil
 }
 
+// registerReadyProviders registers held-back providers whose requirements
+// are now registered, until none is ready.
+func (n *Nasc) registerReadyProviders() error {
+	for {
+		n.providerMu.Lock()
+		ready := -1
+		for i, pending := range n.pendingProviders {
+			if len(n.missingRequirements(pending.provider)) == 0 {
+				ready = i
+				break
+			}
+		}
+		if ready < 0 {
+			n.providerMu.Unlock()
+			return nil
+		}
+
+		pending := n.pendingProviders[ready]
+		n.pendingProviders = append(n.pendingProviders[:ready:ready], n.pendingProviders[ready+1:]...)
+		n.registering = append(n.registering, pending.provider)
+		n.providerMu.Unlock()
+		if err := n.handle(pending.quotas).registerProviderNow(pending.provider); err != nil {
+			return err
+		}
+	}
+}
+
+// isRegistered reports whether the same provider (see sameProvider) is
+// registered, registering, held back or waiting to register lazily. The
+// caller must hold n.providerMu.
+func (n *Nasc) isRegistered(provider ServiceProvider) bool {
+	for _, entry := range n.providers {
+		if sameProvider(entry.provider, provider) {
+			return true
+		}
+	}
+	for _, pending := range n.pendingProviders {
+		if sameProvider(pending.provider, provider) {
+			return true
+		}
+	}
+	for _, registering := range n.registering {
+		if sameProvider(registering, provider) {
+			return true
+		}
+	}
+	return n.hasLazyProvider(provider)
+}
+
+// sameProvider reports whether two providers are the same registration.
+// Providers are deduplicated by type, and keyed providers (see
+// KeyedProvider) by type and key. Inline providers all share the
+// FuncProvider type and are deduplicated by identity.
+func sameProvider(a, b ServiceProvider) bool {
+	if _, inline := a.(*FuncProvider); inline {
+		return a == b
+	}
+	if reflect.TypeOf(a) != reflect.TypeOf(b) {
+		return false
+	}
+	if keyed, ok := a.(KeyedProvider); ok {
+		return keyed.ProviderKey() == b.(KeyedProvider).ProviderKey()
+	}
+	return true
+}
+
+// hasProvider reports whether a provider of the given type is registered.
+// The caller must hold n.providerMu.
+func (n *Nasc) hasProvider(t reflect.Type) bool {
+	for _, entry := range n.providers {
+		if providerIs(entry.provider, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// providerIs reports whether provider satisfies a requirement on type t.
+// A required pointer type is also satisfied by its element type.
+func providerIs(provider ServiceProvider, t reflect.Type) bool {
+	registered := reflect.TypeOf(provider)
+	return registered == t || (registered.Kind() == reflect.Ptr && registered.Elem() == t)
+}
+
+// missingRequirements returns the provider types a provider requires that
+// are not registered yet. The caller must hold n.providerMu.
+func (n *Nasc) missingRequirements(provider ServiceProvider) []reflect.Type {
+	dependent, ok := provider.(DependentProvider)
+	if !ok {
+		return nil
+	}
+
+	var missing []reflect.Type
+	for _, required := range dependent.Requires() {
+		if !n.hasProvider(required) {
+			missing = append(missing, required)
+		}
+	}
+	return missing
+}
+
+// unmetRequirements reports the held-back providers, for BootProviders.
+func (n *Nasc) unmetRequirements() error {
+	n.providerMu.Lock()
+	defer n.providerMu.Unlock()
+
+	if len(n.pendingProviders) == 0 {
+		return nil
+	}
+
+	var errs []error
+	for _, pending := range n.pendingProviders {
+		errs = append(errs, fmt.Errorf("provider %T requires %v, which is not registered", pending.provider, n.missingRequirements(pending.provider)))
+	}
+	return errors.Join(errs...)
+}
+
 // BootProviders calls the Boot method on all registered providers that implement
 // BootableProvider. This should be called after all providers have been registered.
+// Providers boot in registration order, so a provider boots after the
+// providers it requires (see DependentProvider). Booting fails if a required
+// provider was never registered, including when providers require each other.
+// Pending conditional bindings (see BindIf) are evaluated before any provider boots,
+// and singletons marked with Eager are created after all providers have booted.
 //
 // Example:
 //
@@ -123,27 +345,81 @@ func (n *Nasc) RegisterProvider(provider ServiceProvider) error {
 //	    log.Fatal(err)
 //	}
 func (n *Nasc) BootProviders() error {
-	for _, entry := range n.providers {
-		if entry.booted {
-			continue
+	if err := n.unmetRequirements(); err != nil {
+		return fmt.Errorf("provider dependencies not satisfied: %w", err)
+	}
+
+	// Activate conditional bindings before providers use them
+	if err := n.applyConditionalBindings(); err != nil {
+		return fmt.Errorf("conditional binding failed: %w", err)
+	}
+
+	if n.bootWorkers > 1 {
+		if err := n.bootConcurrently(); err != nil {
+			return err
 		}
+	}
 
-		if bootable, ok := entry.provider.(BootableProvider); ok {
-			if err := bootable.Boot(n); err != nil {
-				return fmt.Errorf("provider boot failed: %w", err)
-			}
-			entry.booted = true
+	// Boot in registration order, including providers registered while
+	// booting, such as lazy providers whose types a Boot method resolves
+	for i := 0; ; i++ {
+		n.providerMu.Lock()
+		if i == len(n.providers) {
+			n.providersBooted = true
+			n.providerMu.Unlock()
+			break
+		}
+		entry := n.providers[i]
+		n.providerMu.Unlock()
+
+		if err := n.bootProvider(entry); err != nil {
+			return fmt.Errorf("provider boot failed: %w", err)
 		}
 	}
 
-	return nil
+	return n.warmUpEager()
+}
+
+// bootProvider boots one provider if it implements BootableProvider and
+// has not booted or started booting yet.
+func (n *Nasc) bootProvider(entry *providerEntry) error {
+	bootable, ok := entry.provider.(BootableProvider)
+	if !ok {
+		return nil
+	}
+
+	n.providerMu.Lock()
+	if entry.booted || entry.booting {
+		n.providerMu.Unlock()
+		return nil
+	}
+	entry.booting = true
+	n.providerMu.Unlock()
+
+	end := n.trace(TimelineProviderBoot, reflect.TypeOf(bootable).String())
+	err := bootable.Boot(n.handle(entry.quotas).withOrigin(providerOrigin(entry.provider)))
+	end(err)
+
+	n.providerMu.Lock()
+	entry.booting = false
+	entry.booted = err == nil
+	n.providerMu.Unlock()
+	return err
+}
+
+// snapshotProviders returns the registered provider entries.
+func (n *Nasc) snapshotProviders() []*providerEntry {
+	n.providerMu.Lock()
+	defer n.providerMu.Unlock()
+	return append([]*providerEntry(nil), n.providers...)
 }
 
 // GetProviders returns a list of all registered providers.
 // This is useful for debugging and introspection.
 func (n *Nasc) GetProviders() []ServiceProvider {
-	providers := make([]ServiceProvider, len(n.providers))
-	for i, entry := range n.providers {
+	entries := n.snapshotProviders()
+	providers := make([]ServiceProvider, len(entries))
+	for i, entry := range entries {
 		providers[i] = entry.provider
 	}
 	return providers
@@ -2,6 +2,11 @@ package nasc
 
 import (
 	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 )
 
@@ -352,3 +357,164 @@ func TestProvider_RealWorldScenario(t *testing.T) {
 		t.Error("Database not connected during boot")
 	}
 }
+
+type depProvider struct {
+	name     string
+	requires []reflect.Type
+	log      *[]string
+}
+
+func (p *depProvider) Register(container *Nasc) error {
+	*p.log = append(*p.log, "register:"+p.name)
+	return nil
+}
+
+func (p *depProvider) Boot(container *Nasc) error {
+	*p.log = append(*p.log, "boot:"+p.name)
+	return nil
+}
+
+func (p *depProvider) Requires() []reflect.Type {
+	return p.requires
+}
+
+type configDepProvider struct{ depProvider }
+type cacheDepProvider struct{ depProvider }
+type webDepProvider struct{ depProvider }
+
+func TestRegisterProvider_Requires(t *testing.T) {
+	container := New()
+	var log []string
+
+	web := &webDepProvider{depProvider{name: "web", log: &log, requires: []reflect.Type{
+		reflect.TypeOf(&cacheDepProvider{}),
+		reflect.TypeOf(configDepProvider{}),
+	}}}
+	cache := &cacheDepProvider{depProvider{name: "cache", log: &log, requires: []reflect.Type{
+		reflect.TypeOf(&configDepProvider{}),
+	}}}
+	config := &configDepProvider{depProvider{name: "config", log: &log}}
+
+	for _, p := range []ServiceProvider{web, cache, config} {
+		if err := container.RegisterProvider(p); err != nil {
+			t.Fatalf("RegisterProvider() error = %v", err)
+		}
+	}
+	if err := container.BootProviders(); err != nil {
+		t.Fatalf("BootProviders() error = %v", err)
+	}
+
+	want := []string{
+		"register:config", "register:cache", "register:web",
+		"boot:config", "boot:cache", "boot:web",
+	}
+	if !reflect.DeepEqual(log, want) {
+		t.Errorf("order = %v, want %v", log, want)
+	}
+	if len(container.GetProviders()) != 3 {
+		t.Errorf("GetProviders() returned %d providers, want 3", len(container.GetProviders()))
+	}
+}
+
+func TestBootProviders_UnmetRequirements(t *testing.T) {
+	container := New()
+	var log []string
+
+	_ = container.RegisterProvider(&cacheDepProvider{depProvider{name: "cache", log: &log, requires: []reflect.Type{
+		reflect.TypeOf(&webDepProvider{}),
+	}}})
+	_ = container.RegisterProvider(&webDepProvider{depProvider{name: "web", log: &log, requires: []reflect.Type{
+		reflect.TypeOf(&cacheDepProvider{}),
+	}}})
+
+	err := container.BootProviders()
+	if err == nil || !strings.Contains(err.Error(), "cacheDepProvider requires") {
+		t.Errorf("BootProviders() error = %v, want unmet requirements", err)
+	}
+	if len(log) != 0 {
+		t.Errorf("providers with unmet requirements should not run, got %v", log)
+	}
+}
+
+func TestRegisterProvider_Concurrent(t *testing.T) {
+	container := New()
+	var registered, booted atomic.Int32
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			provider := NewProvider("", func(c *Nasc) error {
+				registered.Add(1)
+				return c.BindNamed((*Logger)(nil), &ConsoleLogger{}, fmt.Sprintf("logger-%d", i))
+			}).OnBoot(func(c *Nasc) error {
+				booted.Add(1)
+				return nil
+			})
+			if err := container.RegisterProvider(provider); err != nil {
+				t.Errorf("RegisterProvider() error = %v", err)
+			}
+			// Providers of the same type are registered once
+			_ = container.RegisterProvider(&LoggingProvider{})
+			_ = container.GetProviders()
+		}(i)
+	}
+	wg.Wait()
+
+	if got := len(container.GetProviders()); got != 21 {
+		t.Errorf("registered %d providers, want 21", got)
+	}
+	if registered.Load() != 20 {
+		t.Errorf("Register called %d times, want 20", registered.Load())
+	}
+
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := container.BootProviders(); err != nil {
+				t.Errorf("BootProviders() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if booted.Load() != 20 {
+		t.Errorf("Boot called %d times, want each provider booted once", booted.Load())
+	}
+}
+
+type keyedDBProvider struct {
+	name       string
+	registered int
+}
+
+func (p *keyedDBProvider) ProviderKey() string { return p.name }
+
+func (p *keyedDBProvider) Register(container *Nasc) error {
+	p.registered++
+	return container.BindNamed((*Database)(nil), &MockDB{}, p.name)
+}
+
+func TestRegisterProvider_ProviderKey(t *testing.T) {
+	container := New()
+	primary := &keyedDBProvider{name: "primary"}
+	analytics := &keyedDBProvider{name: "analytics"}
+	duplicate := &keyedDBProvider{name: "primary"}
+
+	for _, p := range []ServiceProvider{primary, analytics, duplicate} {
+		if err := container.RegisterProvider(p); err != nil {
+			t.Fatalf("RegisterProvider() error = %v", err)
+		}
+	}
+
+	if got := len(container.GetProviders()); got != 2 {
+		t.Errorf("registered %d providers, want one per key", got)
+	}
+	if primary.registered != 1 || analytics.registered != 1 || duplicate.registered != 0 {
+		t.Errorf("Register calls = %d, %d, %d; want 1, 1, 0",
+			primary.registered, analytics.registered, duplicate.registered)
+	}
+	container.MakeNamed((*Database)(nil), "primary")
+	container.MakeNamed((*Database)(nil), "analytics")
+}
@@ -0,0 +1,144 @@
+package nasc
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// WithBootWorkers lets BootProviders boot up to workers providers
+// concurrently. A provider boots only after the providers it requires
+// (see DependentProvider) have booted; providers without declared
+// requirements are treated as independent.
+//
+// With more than one worker every boot error is collected instead of
+// stopping at the first, and providers that require a failed provider are
+// skipped and reported too. A value of 0 (the default) or 1 boots providers
+// one at a time in registration order.
+//
+// Example:
+//
+//	container := nasc.New(nasc.WithBootWorkers(4))
+func WithBootWorkers(workers int) Option {
+	return func(n *Nasc) error {
+		if workers < 0 {
+			return fmt.Errorf("boot workers cannot be negative, got %d", workers)
+		}
+		n.bootWorkers = workers
+		return nil
+	}
+}
+
+// bootConcurrently boots the providers that have not booted yet using up
+// to the configured number of workers, starting each provider once the
+// providers it requires have booted, and aggregates the failures.
+func (n *Nasc) bootConcurrently() error {
+	var entries []*providerEntry
+	n.providerMu.Lock()
+	for _, entry := range n.providers {
+		if !entry.booted {
+			entries = append(entries, entry)
+		}
+	}
+	n.providerMu.Unlock()
+
+	// Count unmet requirements and record who waits on whom
+	pending := make([]int, len(entries))
+	dependents := make([][]int, len(entries))
+	for i, entry := range entries {
+		dependent, ok := entry.provider.(DependentProvider)
+		if !ok {
+			continue
+		}
+		for _, required := range dependent.Requires() {
+			for j, other := range entries {
+				if j != i && providerIs(other.provider, required) {
+					pending[i]++
+					dependents[j] = append(dependents[j], i)
+				}
+			}
+		}
+	}
+
+	results := make([]error, len(entries))
+	jobs := make(chan int)
+	done := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < n.bootWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = n.bootProvider(entries[i])
+				done <- i
+			}
+		}()
+	}
+
+	var ready []int
+	for i := range entries {
+		if pending[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+
+	// finish releases the dependents of a provider that booted, failed or
+	// was skipped; dependents of a failure are skipped in turn
+	var finish func(i int)
+	finish = func(i int) {
+		for _, dependent := range dependents[i] {
+			if results[i] != nil && results[dependent] == nil {
+				results[dependent] = fmt.Errorf("skipped because required provider %T failed", entries[i].provider)
+			}
+			pending[dependent]--
+			if pending[dependent] > 0 {
+				continue
+			}
+			if results[dependent] != nil {
+				finish(dependent)
+			} else {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	started := make([]bool, len(entries))
+	running := 0
+	for len(ready) > 0 || running > 0 {
+		// Hand out ready work while waiting for completions
+		var send chan int
+		var next int
+		if len(ready) > 0 {
+			send, next = jobs, ready[0]
+		}
+		select {
+		case send <- next:
+			started[next] = true
+			ready = ready[1:]
+			running++
+		case i := <-done:
+			running--
+			finish(i)
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	// Whatever never became ready waits on itself through its requirements
+	for i := range entries {
+		if !started[i] && results[i] == nil {
+			results[i] = fmt.Errorf("not booted: its requirements form a cycle")
+		}
+	}
+
+	var errs []error
+	for i, err := range results {
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%T: %w", entries[i].provider, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("provider boot failed for %d provider(s): %w", len(errs), errors.Join(errs...))
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package nasc
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type bootFuncProvider struct {
+	requires []reflect.Type
+	boot     func() error
+}
+
+func (p *bootFuncProvider) Register(container *Nasc) error { return nil }
+
+func (p *bootFuncProvider) Boot(container *Nasc) error { return p.boot() }
+
+func (p *bootFuncProvider) Requires() []reflect.Type { return p.requires }
+
+type alphaBootProvider struct{ bootFuncProvider }
+type betaBootProvider struct{ bootFuncProvider }
+type gammaBootProvider struct{ bootFuncProvider }
+
+func TestWithBootWorkers_Concurrent(t *testing.T) {
+	container := New(WithBootWorkers(2))
+
+	// Independent providers wait for each other, so they only finish if
+	// they boot at the same time
+	var started sync.WaitGroup
+	started.Add(2)
+	rendezvous := func() error {
+		started.Done()
+		wait := make(chan struct{})
+		go func() { started.Wait(); close(wait) }()
+		select {
+		case <-wait:
+			return nil
+		case <-time.After(5 * time.Second):
+			return errors.New("providers did not boot concurrently")
+		}
+	}
+
+	var mu sync.Mutex
+	var order []string
+	record := func(name string) {
+		mu.Lock()
+		order = append(order, name)
+		mu.Unlock()
+	}
+
+	_ = container.RegisterProvider(&gammaBootProvider{bootFuncProvider{
+		requires: []reflect.Type{reflect.TypeOf(&alphaBootProvider{}), reflect.TypeOf(&betaBootProvider{})},
+		boot:     func() error { record("gamma"); return nil },
+	}})
+	_ = container.RegisterProvider(&alphaBootProvider{bootFuncProvider{
+		boot: func() error { err := rendezvous(); record("alpha"); return err },
+	}})
+	_ = container.RegisterProvider(&betaBootProvider{bootFuncProvider{
+		boot: func() error { err := rendezvous(); record("beta"); return err },
+	}})
+
+	if err := container.BootProviders(); err != nil {
+		t.Fatalf("BootProviders() error = %v", err)
+	}
+	if len(order) != 3 || order[2] != "gamma" {
+		t.Errorf("boot order = %v, want gamma after its requirements", order)
+	}
+}
+
+func TestWithBootWorkers_AggregatesErrors(t *testing.T) {
+	container := New(WithBootWorkers(4))
+
+	gammaBooted := false
+	_ = container.RegisterProvider(&alphaBootProvider{bootFuncProvider{
+		boot: func() error { return errors.New("alpha down") },
+	}})
+	_ = container.RegisterProvider(&betaBootProvider{bootFuncProvider{
+		boot: func() error { return errors.New("beta down") },
+	}})
+	_ = container.RegisterProvider(&gammaBootProvider{bootFuncProvider{
+		requires: []reflect.Type{reflect.TypeOf(&alphaBootProvider{})},
+		boot:     func() error { gammaBooted = true; return nil },
+	}})
+
+	err := container.BootProviders()
+	if err == nil {
+		t.Fatal("BootProviders() should fail")
+	}
+	for _, want := range []string{"alpha down", "beta down", "gammaBootProvider: skipped"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q should mention %q", err, want)
+		}
+	}
+	if gammaBooted {
+		t.Error("a provider requiring a failed provider should not boot")
+	}
+}
+
+func TestWithBootWorkers_Invalid(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("New should panic on negative boot workers")
+		}
+	}()
+	New(WithBootWorkers(-1))
+}
+
+func TestWithBootWorkers_ZeroIsDefault(t *testing.T) {
+	container := New(WithBootWorkers(0))
+	_ = container.Singleton((*Logger)(nil), &ConsoleLogger{})
+	_ = container.RegisterProvider(&DatabaseProvider{})
+	if err := container.BootProviders(); err != nil {
+		t.Fatalf("BootProviders() error = %v", err)
+	}
+}
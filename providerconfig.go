@@ -0,0 +1,145 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ConfigSource populates configuration structs for providers, from files,
+// the environment or anything else. Bind one in the container to configure
+// the providers registered after it.
+//
+// Example:
+//
+//	container.Singleton((*nasc.ConfigSource)(nil), &YAMLConfig{Path: "app.yaml"})
+type ConfigSource interface {
+	// Load fills target, a pointer to a struct, from the settings under
+	// section. Settings missing from the source keep target's values.
+	Load(section string, target interface{}) error
+}
+
+// ConfigSourceFunc adapts a function to the ConfigSource interface.
+//
+// Example:
+//
+//	source := nasc.ConfigSourceFunc(func(section string, target interface{}) error {
+//	    return json.Unmarshal(settings[section], target)
+//	})
+//	container.BindInstanceAs(source, (*nasc.ConfigSource)(nil))
+type ConfigSourceFunc func(section string, target interface{}) error
+
+// Load calls f(section, target).
+func (f ConfigSourceFunc) Load(section string, target interface{}) error {
+	return f(section, target)
+}
+
+// ConfigurableProvider is an optional interface for providers that take
+// settings, so one provider can be reused across applications. Config
+// returns the section to read and a pointer to a struct holding the
+// defaults. Before Register runs, the container populates the struct from
+// the bound ConfigSource, if any, and passes it to Configure, which may
+// reject invalid settings.
+//
+// Example:
+//
+//	type DatabaseConfig struct {
+//	    DSN      string
+//	    MaxConns int
+//	}
+//
+//	type DatabaseProvider struct{ cfg *DatabaseConfig }
+//
+//	func (p *DatabaseProvider) Config() (string, interface{}) {
+//	    return "database", &DatabaseConfig{MaxConns: 10}
+//	}
+//
+//	func (p *DatabaseProvider) Configure(cfg interface{}) error {
+//	    p.cfg = cfg.(*DatabaseConfig)
+//	    if p.cfg.DSN == "" {
+//	        return errors.New("database.DSN is required")
+//	    }
+//	    return nil
+//	}
+type ConfigurableProvider interface {
+	ServiceProvider
+	Config() (section string, cfg interface{})
+	Configure(cfg interface{}) error
+}
+
+var configSourceType = reflect.TypeOf((*ConfigSource)(nil)).Elem()
+
+// configureProvider populates and applies a configurable provider's
+// settings before it registers.
+func (n *Nasc) configureProvider(provider ServiceProvider) error {
+	configurable, ok := provider.(ConfigurableProvider)
+	if !ok {
+		return nil
+	}
+
+	section, cfg := configurable.Config()
+	if err := n.loadConfig(section, cfg); err != nil {
+		return err
+	}
+	if err := configurable.Configure(cfg); err != nil {
+		return fmt.Errorf("invalid config section %q: %w", section, err)
+	}
+	return nil
+}
+
+// loadConfig populates cfg from the bound ConfigSource, if any, leaving it
+// unchanged otherwise.
+func (n *Nasc) loadConfig(section string, cfg interface{}) error {
+	if err := checkConfigTarget(cfg); err != nil {
+		return err
+	}
+
+	if err := n.loadLazyProvider(configSourceType); err != nil {
+		return err
+	}
+	if !n.registry.Has(configSourceType) {
+		return nil
+	}
+	source, err := n.MakeSafe((*ConfigSource)(nil))
+	if err != nil {
+		return err
+	}
+	if err := source.(ConfigSource).Load(section, cfg); err != nil {
+		return fmt.Errorf("loading config section %q: %w", section, err)
+	}
+	return nil
+}
+
+// checkConfigTarget reports whether cfg can be populated by a ConfigSource.
+func checkConfigTarget(cfg interface{}) error {
+	if t := reflect.TypeOf(cfg); t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct || reflect.ValueOf(cfg).IsNil() {
+		return fmt.Errorf("config must be a non-nil pointer to a struct, got %T", cfg)
+	}
+	return nil
+}
+
+// BindConfig populates cfg, a pointer to a struct holding the defaults,
+// from the settings under section of the bound ConfigSource, and registers
+// it as an instance of its pointer type, so constructors can take typed
+// configuration such as *DBConfig. Without a ConfigSource the defaults are
+// registered as they are. The nascconfig package provides sources for JSON
+// and YAML files and the environment.
+//
+// Example:
+//
+//	container.BindInstanceAs(nascconfig.Env("APP"), (*nasc.ConfigSource)(nil))
+//	container.BindConfig(&DBConfig{MaxConns: 10}, "database")
+//
+//	container.BindConstructor((*Database)(nil), func(cfg *DBConfig) *PostgresDB {
+//	    return NewPostgresDB(cfg.DSN, cfg.MaxConns)
+//	})
+//
+//	cfg := container.Make((**DBConfig)(nil)).(*DBConfig)
+func (n *Nasc) BindConfig(cfg interface{}, section string) error {
+	if err := checkConfigTarget(cfg); err != nil {
+		return &InvalidBindingError{Reason: err.Error()}
+	}
+	if err := n.loadConfig(section, cfg); err != nil {
+		return err
+	}
+	return n.BindInstanceAs(cfg, reflect.Zero(reflect.PointerTo(reflect.TypeOf(cfg))).Interface())
+}
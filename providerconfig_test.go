@@ -0,0 +1,109 @@
+package nasc
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type mailConfig struct {
+	Host string
+	Port int
+}
+
+type mailProvider struct {
+	cfg        *mailConfig
+	registered bool
+}
+
+func (p *mailProvider) Config() (string, interface{}) {
+	return "mail", &mailConfig{Host: "localhost", Port: 25}
+}
+
+func (p *mailProvider) Configure(cfg interface{}) error {
+	p.cfg = cfg.(*mailConfig)
+	if p.cfg.Port <= 0 {
+		return errors.New("port must be positive")
+	}
+	return nil
+}
+
+func (p *mailProvider) Register(container *Nasc) error {
+	p.registered = true
+	return nil
+}
+
+func mailSettings(port int) ConfigSource {
+	return ConfigSourceFunc(func(section string, target interface{}) error {
+		if section != "mail" {
+			return errors.New("unknown section " + section)
+		}
+		target.(*mailConfig).Port = port
+		return nil
+	})
+}
+
+func TestConfigurableProvider(t *testing.T) {
+	container := New()
+	_ = container.BindInstanceAs(mailSettings(2525), (*ConfigSource)(nil))
+
+	provider := &mailProvider{}
+	if err := container.RegisterProvider(provider); err != nil {
+		t.Fatalf("RegisterProvider() error = %v", err)
+	}
+	if !provider.registered {
+		t.Fatal("Register should run after Configure")
+	}
+	if provider.cfg.Port != 2525 || provider.cfg.Host != "localhost" {
+		t.Errorf("config = %+v, want the source's port over the defaults", *provider.cfg)
+	}
+}
+
+func TestConfigurableProvider_Defaults(t *testing.T) {
+	container := New()
+	provider := &mailProvider{}
+	if err := container.RegisterProvider(provider); err != nil {
+		t.Fatalf("RegisterProvider() error = %v", err)
+	}
+	if provider.cfg.Port != 25 {
+		t.Errorf("config = %+v, want the defaults without a config source", *provider.cfg)
+	}
+}
+
+func TestConfigurableProvider_Invalid(t *testing.T) {
+	container := New()
+	_ = container.BindInstanceAs(mailSettings(-1), (*ConfigSource)(nil))
+
+	provider := &mailProvider{}
+	err := container.RegisterProvider(provider)
+	if err == nil || !strings.Contains(err.Error(), `invalid config section "mail"`) {
+		t.Errorf("RegisterProvider() error = %v, want the Configure error", err)
+	}
+	if provider.registered {
+		t.Error("Register should not run when Configure fails")
+	}
+}
+
+func TestBindConfig(t *testing.T) {
+	container := New()
+	_ = container.BindInstanceAs(mailSettings(2525), (*ConfigSource)(nil))
+
+	if err := container.BindConfig(&mailConfig{Host: "localhost"}, "mail"); err != nil {
+		t.Fatalf("BindConfig() error = %v", err)
+	}
+	_ = container.BindConstructor((*Consumer)(nil), func(cfg *mailConfig) *recordingConsumer {
+		if cfg.Port != 2525 || cfg.Host != "localhost" {
+			t.Errorf("config = %+v, want the source's port over the defaults", *cfg)
+		}
+		return &recordingConsumer{}
+	})
+	container.Make((*Consumer)(nil))
+
+	var invalid *InvalidBindingError
+	if err := container.BindConfig(mailConfig{}, "mail"); !errors.As(err, &invalid) {
+		t.Errorf("BindConfig() error = %v, want an InvalidBindingError for a non-pointer", err)
+	}
+	if err := container.BindConfig(&mailConfig{}, "unknown"); err == nil {
+		t.Error("a source error should fail BindConfig")
+	}
+}
@@ -0,0 +1,166 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// ProviderQuota limits what a sandboxed provider may register (see
+// RegisterProviderWithQuota). The zero value imposes no limits.
+type ProviderQuota struct {
+	// MaxBindings caps the number of bindings the provider registers; 0 means no limit
+	MaxBindings int
+
+	// ForbiddenTypes lists abstract types the provider may not bind,
+	// as tokens like (*http.Handler)(nil)
+	ForbiddenTypes []interface{}
+
+	// NoOverrides forbids replacing bindings the provider did not register,
+	// through Override, Merge or OriginView.Replace
+	NoOverrides bool
+}
+
+// Quota rules reported by QuotaViolationError.
+const (
+	QuotaMaxBindings   = "max-bindings"
+	QuotaForbiddenType = "forbidden-type"
+	QuotaOverride      = "override"
+)
+
+// quotaState tracks a sandboxed provider's registrations against its quota.
+type quotaState struct {
+	provider  string
+	quota     ProviderQuota
+	forbidden map[reflect.Type]bool
+	bindings  int
+	owned     map[*registry.Binding]bool
+}
+
+// RegisterProviderWithQuota registers a provider, such as an untrusted
+// plugin, under a quota enforced at registration. Every binding the
+// provider registers in Register or Boot, directly or through nested
+// providers, counts against it, and the first violation fails registration
+// with a *QuotaViolationError. Bindings registered before the violation are
+// kept, so treat the error as fatal for the container.
+//
+// The quota is enforced on the handle to the container that the provider
+// receives in Register and Boot, including on registrations it makes from
+// other goroutines, while the application keeps registering bindings
+// without it. A provider whose registration is deferred (see LazyProvider
+// and DependentProvider) registers under the quota when it does.
+//
+// Example:
+//
+//	err := container.RegisterProviderWithQuota(vendorPlugin, nasc.ProviderQuota{
+//	    MaxBindings:    20,
+//	    ForbiddenTypes: []interface{}{(*Authenticator)(nil)},
+//	    NoOverrides:    true,
+//	})
+func (n *Nasc) RegisterProviderWithQuota(provider ServiceProvider, quota ProviderQuota) error {
+	if provider == nil {
+		return fmt.Errorf("provider cannot be nil")
+	}
+	return n.registerWithQuota(provider, reflect.TypeOf(provider).String(), quota)
+}
+
+// RegisterPluginWithQuota checks a plugin's manifest like RegisterPlugin and
+// registers it under a quota (see RegisterProviderWithQuota). Violations
+// name the plugin by its manifest name.
+//
+// Example:
+//
+//	if err := container.RegisterPluginWithQuota(vendorPlugin, sandbox); err != nil {
+//	    log.Printf("refusing plugin: %v", err)
+//	}
+func (n *Nasc) RegisterPluginWithQuota(plugin Plugin, quota ProviderQuota) error {
+	if plugin == nil {
+		return fmt.Errorf("plugin cannot be nil")
+	}
+	if err := n.CheckPlugin(plugin); err != nil {
+		return err
+	}
+
+	name := plugin.Manifest().Name
+	if name == "" {
+		name = reflect.TypeOf(plugin).String()
+	}
+	return n.registerWithQuota(plugin, name, quota)
+}
+
+// registerWithQuota registers a provider while its quota is active.
+func (n *Nasc) registerWithQuota(provider ServiceProvider, name string, quota ProviderQuota) error {
+	if quota.MaxBindings < 0 {
+		return fmt.Errorf("max bindings cannot be negative, got %d", quota.MaxBindings)
+	}
+
+	state := &quotaState{
+		provider:  name,
+		quota:     quota,
+		forbidden: make(map[reflect.Type]bool, len(quota.ForbiddenTypes)),
+		owned:     make(map[*registry.Binding]bool),
+	}
+	for _, token := range quota.ForbiddenTypes {
+		if token == nil {
+			return fmt.Errorf("forbidden type cannot be nil")
+		}
+		state.forbidden[typeOfToken(token)] = true
+	}
+
+	quotas := append(append([]*quotaState(nil), n.quotas...), state)
+	return n.handle(quotas).RegisterProvider(provider)
+}
+
+// handle returns a handle to the container enforcing quotas on the
+// bindings registered through it, keeping n's origin. Providers receive such a handle in
+// Register and Boot, so their registrations are charged to their quotas
+// from whichever goroutine they make them.
+func (n *Nasc) handle(quotas []*quotaState) *Nasc {
+	return &Nasc{containerState: n.containerState, quotas: quotas, origin: n.origin}
+}
+
+// storeBinding stores a binding with store after checking it against the
+// quotas of the handle it is registered through, then charges it to them.
+// Existing is the binding it replaces, or nil for a new binding. Checking,
+// storing and charging happen under one lock, so concurrent registrations
+// cannot exceed a quota.
+func (n *Nasc) storeBinding(binding, existing *registry.Binding, store func() error) error {
+	if len(n.quotas) == 0 {
+		return store()
+	}
+
+	n.quotaMu.Lock()
+	defer n.quotaMu.Unlock()
+	for _, state := range n.quotas {
+		if err := state.check(binding, existing); err != nil {
+			return err
+		}
+	}
+	if err := store(); err != nil {
+		return err
+	}
+	for _, state := range n.quotas {
+		state.bindings++
+		state.owned[binding] = true
+	}
+	return nil
+}
+
+// check reports whether a binding would violate the quota.
+func (q *quotaState) check(binding, existing *registry.Binding) error {
+	violation := func(rule, detail string) error {
+		return &QuotaViolationError{Provider: q.provider, Rule: rule, Type: binding.AbstractType, Detail: detail}
+	}
+
+	if q.forbidden[binding.AbstractType] {
+		return violation(QuotaForbiddenType, "type may not be bound by this provider")
+	}
+	if existing != nil && q.quota.NoOverrides && !q.owned[existing] {
+		return violation(QuotaOverride, "binding was registered outside the provider")
+	}
+	if q.quota.MaxBindings > 0 && q.bindings >= q.quota.MaxBindings {
+		return violation(QuotaMaxBindings, fmt.Sprintf("limit of %d bindings reached", q.quota.MaxBindings))
+	}
+	return nil
+}
@@ -0,0 +1,282 @@
+package nasc
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+type quotaPlugin struct {
+	register func(c *Nasc) error
+}
+
+func (p *quotaPlugin) Register(c *Nasc) error { return p.register(c) }
+
+func TestQuota_MaxBindings(t *testing.T) {
+	container := New()
+	plugin := &quotaPlugin{register: func(c *Nasc) error {
+		if err := c.Bind((*Logger)(nil), &ConsoleLogger{}); err != nil {
+			return err
+		}
+		return c.Bind((*Database)(nil), &MockDB{})
+	}}
+
+	err := container.RegisterProviderWithQuota(plugin, ProviderQuota{MaxBindings: 1})
+	var violation *QuotaViolationError
+	if !errors.As(err, &violation) {
+		t.Fatalf("expected QuotaViolationError, got %v", err)
+	}
+	if violation.Rule != QuotaMaxBindings || violation.Type != typeOfToken((*Database)(nil)) {
+		t.Errorf("unexpected violation: %+v", violation)
+	}
+	if !container.registry.Has(typeOfToken((*Logger)(nil))) {
+		t.Error("bindings within the quota should be registered")
+	}
+}
+
+func TestQuota_ForbiddenTypes(t *testing.T) {
+	container := New()
+	plugin := &quotaPlugin{register: func(c *Nasc) error {
+		return c.BindNamed((*Logger)(nil), &ConsoleLogger{}, "sneaky")
+	}}
+
+	err := container.RegisterProviderWithQuota(plugin, ProviderQuota{
+		ForbiddenTypes: []interface{}{(*Logger)(nil)},
+	})
+	var violation *QuotaViolationError
+	if !errors.As(err, &violation) || violation.Rule != QuotaForbiddenType {
+		t.Errorf("expected forbidden-type violation, got %v", err)
+	}
+}
+
+func TestQuota_NoOverrides(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	plugin := &quotaPlugin{register: func(c *Nasc) error {
+		if err := c.Bind((*Database)(nil), &MockDB{}); err != nil {
+			return err
+		}
+		// Replacing its own binding is allowed
+		if err := c.FromOrigin(providerOrigin(&quotaPlugin{})).Replace((*Database)(nil), &MockDB{}); err != nil {
+			return err
+		}
+		return c.FromOrigin("").Replace((*Logger)(nil), &FileLogger{})
+	}}
+
+	err := container.RegisterProviderWithQuota(plugin, ProviderQuota{NoOverrides: true})
+	var violation *QuotaViolationError
+	if !errors.As(err, &violation) || violation.Rule != QuotaOverride {
+		t.Fatalf("expected override violation, got %v", err)
+	}
+	if _, ok := container.Make((*Logger)(nil)).(*ConsoleLogger); !ok {
+		t.Error("host binding should be unchanged")
+	}
+}
+
+func TestQuota_OverridePanics(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	plugin := &quotaPlugin{register: func(c *Nasc) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err, _ = r.(error)
+			}
+		}()
+		c.Override((*Logger)(nil), &FileLogger{})
+		return nil
+	}}
+
+	err := container.RegisterProviderWithQuota(plugin, ProviderQuota{NoOverrides: true})
+	var violation *QuotaViolationError
+	if !errors.As(err, &violation) {
+		t.Errorf("expected Override to be refused, got %v", err)
+	}
+}
+
+func TestQuota_OnlyAppliesDuringRegistration(t *testing.T) {
+	container := New()
+	plugin := &quotaPlugin{register: func(c *Nasc) error {
+		return c.Bind((*Logger)(nil), &ConsoleLogger{})
+	}}
+
+	if err := container.RegisterProviderWithQuota(plugin, ProviderQuota{MaxBindings: 1}); err != nil {
+		t.Fatalf("RegisterProviderWithQuota failed: %v", err)
+	}
+	if err := container.Bind((*Database)(nil), &MockDB{}); err != nil {
+		t.Errorf("host registrations should not count against the quota: %v", err)
+	}
+}
+
+func TestQuota_Invalid(t *testing.T) {
+	container := New()
+	plugin := &quotaPlugin{register: func(c *Nasc) error { return nil }}
+
+	if err := container.RegisterProviderWithQuota(plugin, ProviderQuota{MaxBindings: -1}); err == nil {
+		t.Error("expected error for negative limit")
+	}
+	if err := container.RegisterProviderWithQuota(plugin, ProviderQuota{ForbiddenTypes: []interface{}{nil}}); err == nil {
+		t.Error("expected error for nil forbidden type")
+	}
+	if err := container.RegisterProviderWithQuota(nil, ProviderQuota{}); err == nil {
+		t.Error("expected error for nil provider")
+	}
+}
+
+type quotaVendorPlugin struct{ quotaPlugin }
+
+func (p *quotaVendorPlugin) Manifest() PluginManifest {
+	return PluginManifest{Name: "vendor", APIVersion: APIVersion}
+}
+
+func TestRegisterPluginWithQuota(t *testing.T) {
+	container := New()
+	plugin := &quotaVendorPlugin{quotaPlugin{register: func(c *Nasc) error {
+		return c.Bind((*Logger)(nil), &ConsoleLogger{})
+	}}}
+
+	err := container.RegisterPluginWithQuota(plugin, ProviderQuota{
+		ForbiddenTypes: []interface{}{(*Logger)(nil)},
+	})
+	var violation *QuotaViolationError
+	if !errors.As(err, &violation) || violation.Provider != "vendor" {
+		t.Errorf("expected violation naming the plugin, got %v", err)
+	}
+
+	incompatible := &testPlugin{manifest: PluginManifest{APIVersion: "9.0"}}
+	var incompatibleErr *IncompatiblePluginError
+	if err := container.RegisterPluginWithQuota(incompatible, ProviderQuota{}); !errors.As(err, &incompatibleErr) {
+		t.Errorf("expected manifest check, got %v", err)
+	}
+}
+
+type quotaBootPlugin struct {
+	quotaPlugin
+	boot func(c *Nasc) error
+}
+
+func (p *quotaBootPlugin) Boot(c *Nasc) error { return p.boot(c) }
+
+func TestQuota_AppliesToBoot(t *testing.T) {
+	container := New()
+	plugin := &quotaBootPlugin{
+		quotaPlugin: quotaPlugin{register: func(c *Nasc) error { return nil }},
+		boot: func(c *Nasc) error {
+			return c.Bind((*Logger)(nil), &ConsoleLogger{})
+		},
+	}
+
+	if err := container.RegisterProviderWithQuota(plugin, ProviderQuota{ForbiddenTypes: []interface{}{(*Logger)(nil)}}); err != nil {
+		t.Fatalf("RegisterProviderWithQuota failed: %v", err)
+	}
+	err := container.BootProviders()
+	var violation *QuotaViolationError
+	if !errors.As(err, &violation) || violation.Rule != QuotaForbiddenType {
+		t.Errorf("expected forbidden-type violation from Boot, got %v", err)
+	}
+}
+
+func TestQuota_ChargesOnlyStoredBindings(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+	plugin := &quotaPlugin{register: func(c *Nasc) error {
+		if err := c.Bind((*Logger)(nil), &ConsoleLogger{}); err == nil {
+			return errors.New("duplicate binding should fail")
+		}
+		return c.Bind((*Database)(nil), &MockDB{})
+	}}
+
+	if err := container.RegisterProviderWithQuota(plugin, ProviderQuota{MaxBindings: 1}); err != nil {
+		t.Errorf("a rejected binding should not count against the quota: %v", err)
+	}
+}
+
+func TestQuota_IgnoresApplicationRegistrations(t *testing.T) {
+	container := New()
+	registering := make(chan struct{})
+	host := make(chan error)
+	plugin := &quotaPlugin{register: func(c *Nasc) error {
+		close(registering)
+		if err := <-host; err != nil {
+			return err
+		}
+		return c.Bind((*Database)(nil), &MockDB{})
+	}}
+
+	go func() {
+		<-registering
+		host <- container.Bind((*Logger)(nil), &ConsoleLogger{})
+	}()
+
+	err := container.RegisterProviderWithQuota(plugin, ProviderQuota{
+		MaxBindings:    1,
+		ForbiddenTypes: []interface{}{(*Logger)(nil)},
+	})
+	if err != nil {
+		t.Errorf("concurrent registrations by the application should not count against the quota: %v", err)
+	}
+}
+
+func TestQuota_AppliesToProviderGoroutines(t *testing.T) {
+	container := New()
+	plugin := &quotaPlugin{register: func(c *Nasc) error {
+		done := make(chan error)
+		go func() { done <- c.Bind((*Logger)(nil), &ConsoleLogger{}) }()
+		return <-done
+	}}
+
+	err := container.RegisterProviderWithQuota(plugin, ProviderQuota{ForbiddenTypes: []interface{}{(*Logger)(nil)}})
+	var violation *QuotaViolationError
+	if !errors.As(err, &violation) || violation.Rule != QuotaForbiddenType {
+		t.Errorf("expected forbidden-type violation from a provider goroutine, got %v", err)
+	}
+	if container.registry.Has(typeOfToken((*Logger)(nil))) {
+		t.Error("the forbidden type should not be bound")
+	}
+}
+
+func TestQuota_ConcurrentRegistrations(t *testing.T) {
+	container := New()
+	names := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+	plugin := &quotaPlugin{register: func(c *Nasc) error {
+		var wg sync.WaitGroup
+		for _, name := range names {
+			wg.Add(1)
+			go func(name string) {
+				defer wg.Done()
+				_ = c.BindNamed((*Logger)(nil), &ConsoleLogger{}, name)
+			}(name)
+		}
+		wg.Wait()
+		return nil
+	}}
+
+	_ = container.RegisterProviderWithQuota(plugin, ProviderQuota{MaxBindings: 3})
+	if got := len(container.registry.GetAllNamedFor(typeOfToken((*Logger)(nil)))); got != 3 {
+		t.Errorf("registered %d bindings, want the quota of 3", got)
+	}
+}
+
+type quotaDependentPlugin struct{ quotaPlugin }
+
+func (p *quotaDependentPlugin) Requires() []reflect.Type {
+	return []reflect.Type{reflect.TypeOf(&quotaVendorPlugin{})}
+}
+
+func TestQuota_AppliesToHeldBackProviders(t *testing.T) {
+	container := New()
+	plugin := &quotaDependentPlugin{quotaPlugin{register: func(c *Nasc) error {
+		return c.Bind((*Logger)(nil), &ConsoleLogger{})
+	}}}
+
+	if err := container.RegisterProviderWithQuota(plugin, ProviderQuota{ForbiddenTypes: []interface{}{(*Logger)(nil)}}); err != nil {
+		t.Fatalf("RegisterProviderWithQuota failed: %v", err)
+	}
+	err := container.RegisterProvider(&quotaVendorPlugin{quotaPlugin{register: func(c *Nasc) error { return nil }}})
+	var violation *QuotaViolationError
+	if !errors.As(err, &violation) || violation.Rule != QuotaForbiddenType {
+		t.Errorf("expected forbidden-type violation once requirements register, got %v", err)
+	}
+}
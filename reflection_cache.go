@@ -1,17 +1,41 @@
 package nasc
 
 import (
+	"fmt"
 	"reflect"
-	"sync"
+	"sync/atomic"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/internal/lockstat"
 )
 
 // reflectionCache caches reflection metadata to avoid repeated type analysis.
 // This significantly improves performance by reducing reflection overhead.
 type reflectionCache struct {
-	mu sync.RWMutex
+	mu lockstat.RWMutex
 
 	// Struct field cache for auto-wiring
 	fields map[reflect.Type][]fieldInfo
+
+	// Constructor metadata keyed by function type. Keying by function
+	// pointer would be unsafe: closures created from one function literal
+	// share a code pointer but capture different variables.
+	constructors map[reflect.Type]*constructorInfo
+
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+// ReflectionCacheStats reports how well the reflection cache is working.
+type ReflectionCacheStats struct {
+	// Structs is the number of struct types whose fields are cached
+	Structs int
+
+	// Constructors is the number of constructor function types cached
+	Constructors int
+
+	// Hits and Misses count lookups served from the cache and computed
+	Hits   int64
+	Misses int64
 }
 
 // fieldInfo stores metadata about a struct field for auto-wiring.
@@ -21,15 +45,71 @@ type fieldInfo struct {
 	typ          reflect.Type
 	tag          reflect.StructTag
 	isInjectable bool
+	embedded     bool
 }
 
 // newReflectionCache creates a new reflection cache.
 func newReflectionCache() *reflectionCache {
 	return &reflectionCache{
-		fields: make(map[reflect.Type][]fieldInfo),
+		fields:       make(map[reflect.Type][]fieldInfo),
+		constructors: make(map[reflect.Type]*constructorInfo),
 	}
 }
 
+// ReflectionCacheStats returns statistics for the reflection metadata
+// cache shared by the container and its clones.
+//
+// Example:
+//
+//	stats := container.ReflectionCacheStats()
+//	fmt.Printf("%d hits, %d misses\n", stats.Hits, stats.Misses)
+func (n *Nasc) ReflectionCacheStats() ReflectionCacheStats {
+	rc := n.reflectionCache
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+
+	return ReflectionCacheStats{
+		Structs:      len(rc.fields),
+		Constructors: len(rc.constructors),
+		Hits:         rc.hits.Load(),
+		Misses:       rc.misses.Load(),
+	}
+}
+
+// parseConstructor analyzes a constructor like the package-level
+// parseConstructor, reusing the metadata of earlier constructors with the
+// same function type.
+func (rc *reflectionCache) parseConstructor(constructor ConstructorFunc) (*constructorInfo, error) {
+	if constructor == nil {
+		return nil, fmt.Errorf("constructor cannot be nil")
+	}
+	fnValue := reflect.ValueOf(constructor)
+
+	rc.mu.RLock()
+	cached, exists := rc.constructors[fnValue.Type()]
+	rc.mu.RUnlock()
+
+	if !exists {
+		rc.misses.Add(1)
+		info, err := parseConstructor(constructor)
+		if err != nil {
+			return nil, err
+		}
+
+		shape := *info
+		shape.fn = reflect.Value{}
+		rc.mu.Lock()
+		rc.constructors[fnValue.Type()] = &shape
+		rc.mu.Unlock()
+		return info, nil
+	}
+
+	rc.hits.Add(1)
+	info := *cached
+	info.fn = fnValue
+	return &info, nil
+}
+
 // getFieldInfo retrieves or computes struct field information.
 func (rc *reflectionCache) getFieldInfo(typ reflect.Type) []fieldInfo {
 	// Fast path: check cache with read lock
@@ -38,8 +118,10 @@ func (rc *reflectionCache) getFieldInfo(typ reflect.Type) []fieldInfo {
 	rc.mu.RUnlock()
 
 	if exists {
+		rc.hits.Add(1)
 		return fields
 	}
+	rc.misses.Add(1)
 
 	// Slow path: compute and cache with write lock
 	rc.mu.Lock()
@@ -51,13 +133,15 @@ func (rc *reflectionCache) getFieldInfo(typ reflect.Type) []fieldInfo {
 		return fields
 	}
 
-	// Compute field information
+	// Compute field information, caching under the type that was asked
+	// for so pointer lookups hit too
+	key := typ
 	if typ.Kind() == reflect.Ptr {
 		typ = typ.Elem()
 	}
 
 	if typ.Kind() != reflect.Struct {
-		rc.fields[typ] = nil
+		rc.fields[key] = nil
 		return nil
 	}
 
@@ -77,10 +161,11 @@ func (rc *reflectionCache) getFieldInfo(typ reflect.Type) []fieldInfo {
 			typ:          field.Type,
 			tag:          field.Tag,
 			isInjectable: isInjectable,
+			embedded:     field.Anonymous,
 		})
 	}
 
-	rc.fields[typ] = fields
+	rc.fields[key] = fields
 	return fields
 }
 
@@ -90,4 +175,5 @@ func (rc *reflectionCache) clear() {
 	defer rc.mu.Unlock()
 
 	rc.fields = make(map[reflect.Type][]fieldInfo)
+	rc.constructors = make(map[reflect.Type]*constructorInfo)
 }
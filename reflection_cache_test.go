@@ -0,0 +1,52 @@
+package nasc
+
+import (
+	"reflect"
+	"testing"
+)
+
+// newConfiguredServiceCtor returns a closure; every closure it returns has
+// the same function type and code pointer.
+func newConfiguredServiceCtor(cfg *appConfig) func(Logger) *configuredService {
+	return func(logger Logger) *configuredService {
+		return &configuredService{cfg: cfg, logger: logger}
+	}
+}
+
+func TestReflectionCache_Constructors(t *testing.T) {
+	container := New()
+	_ = container.Singleton((*Logger)(nil), &ConsoleLogger{})
+
+	primary, secondary := &appConfig{DSN: "primary"}, &appConfig{DSN: "secondary"}
+	_ = container.BindConstructor((*configuredService)(nil), newConfiguredServiceCtor(primary))
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+	_ = scope.BindConstructor((*configuredService)(nil), newConfiguredServiceCtor(secondary))
+
+	stats := container.ReflectionCacheStats()
+	if stats.Constructors != 1 {
+		t.Errorf("constructors of one function type should share metadata, got %d entries", stats.Constructors)
+	}
+	if stats.Misses != 1 || stats.Hits != 1 {
+		t.Errorf("expected 1 miss and 1 hit, got %+v", stats)
+	}
+
+	if got := container.Make((*configuredService)(nil)).(*configuredService).cfg; got != primary {
+		t.Error("container constructor should call its own closure")
+	}
+	if got := scope.Make((*configuredService)(nil)).(*configuredService).cfg; got != secondary {
+		t.Error("scope constructor should call its own closure despite the shared type")
+	}
+}
+
+func TestReflectionCache_PointerFieldLookupsHit(t *testing.T) {
+	cache := newReflectionCache()
+	ptrType := reflect.TypeOf(&configuredService{})
+	cache.getFieldInfo(ptrType)
+	cache.getFieldInfo(ptrType)
+
+	if cache.misses.Load() != 1 || cache.hits.Load() != 1 {
+		t.Errorf("pointer lookups should be cached, got %d misses and %d hits", cache.misses.Load(), cache.hits.Load())
+	}
+}
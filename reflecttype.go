@@ -0,0 +1,67 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// MakeType resolves the default binding for a reflect.Type, for frameworks
+// that already work with types and have no (*T)(nil) token at hand. The
+// type is the one a token names: MakeType(reflect.TypeOf((*Logger)(nil)).Elem())
+// is MakeSafe((*Logger)(nil)).
+//
+// Example:
+//
+//	for _, param := range handlerParams {
+//	    arg, err := container.MakeType(param)
+//	    if err != nil {
+//	        return err
+//	    }
+//	    args = append(args, reflect.ValueOf(arg))
+//	}
+func (n *Nasc) MakeType(t reflect.Type) (interface{}, error) {
+	if t == nil {
+		return nil, &InvalidBindingError{Reason: "cannot resolve nil type"}
+	}
+	return n.makeSafeWithContext(t, "", newResolutionContext())
+}
+
+// BindType binds abstract to concrete by reflect.Type, accepting the same
+// options as As. The concrete type may be a struct or a pointer to struct;
+// instances are always created as pointers. When abstract is an interface,
+// the pointer type must implement it; otherwise the pointer type must be
+// assignable to abstract or point to it.
+//
+// Example:
+//
+//	err := container.BindType(pluginType, implType, nasc.WithTags("plugin"))
+func (n *Nasc) BindType(abstract, concrete reflect.Type, opts ...BindOption) error {
+	if abstract == nil {
+		return &InvalidBindingError{Reason: "abstract type cannot be nil"}
+	}
+	if concrete == nil {
+		return &InvalidBindingError{Reason: "concrete type cannot be nil"}
+	}
+
+	options := bindOptions{lifetime: LifetimeTransient}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if concrete.Kind() == reflect.Struct {
+		concrete = reflect.PointerTo(concrete)
+	}
+	if concrete.Kind() != reflect.Ptr || concrete.Elem().Kind() != reflect.Struct {
+		return &InvalidBindingError{
+			Reason: fmt.Sprintf("concrete type must be struct or pointer to struct, got %v", concrete),
+		}
+	}
+	if abstract.Kind() == reflect.Interface && !concrete.Implements(abstract) {
+		return &InvalidBindingError{Reason: fmt.Sprintf("%v does not implement %v", concrete, abstract)}
+	}
+	if abstract.Kind() != reflect.Interface && !concrete.AssignableTo(abstract) && concrete != reflect.PointerTo(abstract) {
+		return &InvalidBindingError{Reason: fmt.Sprintf("%v cannot be resolved as %v", concrete, abstract)}
+	}
+
+	return n.bindType(abstract, concrete, options)
+}
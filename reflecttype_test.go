@@ -0,0 +1,83 @@
+package nasc
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+var loggerType = reflect.TypeOf((*Logger)(nil)).Elem()
+
+func TestMakeType(t *testing.T) {
+	container := New()
+	_ = container.Singleton((*Logger)(nil), &ConsoleLogger{})
+
+	instance, err := container.MakeType(loggerType)
+	if err != nil {
+		t.Fatalf("MakeType failed: %v", err)
+	}
+	if instance != container.Make((*Logger)(nil)) {
+		t.Error("MakeType should resolve the same binding as Make")
+	}
+}
+
+func TestMakeType_Errors(t *testing.T) {
+	container := New()
+
+	var invalid *InvalidBindingError
+	if _, err := container.MakeType(nil); !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidBindingError for nil type, got %v", err)
+	}
+	if _, err := container.MakeType(loggerType); err == nil {
+		t.Error("expected error for unbound type")
+	}
+}
+
+func TestBindType(t *testing.T) {
+	container := New()
+	err := container.BindType(loggerType, reflect.TypeOf(ConsoleLogger{}), WithLifetime(LifetimeSingleton))
+	if err != nil {
+		t.Fatalf("BindType failed: %v", err)
+	}
+
+	first, _ := container.MakeType(loggerType)
+	if _, ok := first.(*ConsoleLogger); !ok {
+		t.Fatalf("expected *ConsoleLogger, got %T", first)
+	}
+	if second, _ := container.MakeType(loggerType); second != first {
+		t.Error("singleton option should be honored")
+	}
+}
+
+func TestBindType_Named(t *testing.T) {
+	container := New()
+	if err := container.BindType(loggerType, reflect.TypeOf(&ConsoleLogger{}), WithName("console")); err != nil {
+		t.Fatalf("BindType failed: %v", err)
+	}
+	if _, ok := container.MakeNamed((*Logger)(nil), "console").(*ConsoleLogger); !ok {
+		t.Error("named binding should resolve to *ConsoleLogger")
+	}
+}
+
+func TestBindType_Invalid(t *testing.T) {
+	container := New()
+	cases := map[string]struct {
+		abstract, concrete reflect.Type
+		opts               []BindOption
+	}{
+		"nil abstract":      {nil, reflect.TypeOf(ConsoleLogger{}), nil},
+		"nil concrete":      {loggerType, nil, nil},
+		"not a struct":      {loggerType, reflect.TypeOf(""), nil},
+		"not implemented":   {loggerType, reflect.TypeOf(rotatingClient{}), nil},
+		"not assignable":    {reflect.TypeOf(MockDB{}), reflect.TypeOf(ConsoleLogger{}), nil},
+		"factory lifetime":  {loggerType, reflect.TypeOf(ConsoleLogger{}), []BindOption{WithLifetime(LifetimeFactory)}},
+		"name and tags set": {loggerType, reflect.TypeOf(ConsoleLogger{}), []BindOption{WithName("a"), WithTags("b")}},
+	}
+	for name, tc := range cases {
+		err := container.BindType(tc.abstract, tc.concrete, tc.opts...)
+		var invalid *InvalidBindingError
+		if !errors.As(err, &invalid) {
+			t.Errorf("%s: expected InvalidBindingError, got %v", name, err)
+		}
+	}
+}
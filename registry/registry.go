@@ -2,9 +2,14 @@
 package registry
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
-	"sync"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/internal/lockstat"
 )
 
 // Binding represents a mapping between an interface type and its concrete implementation.
@@ -37,24 +42,124 @@ type Binding struct {
 
 	// Tags are optional labels for tagged bindings (Phase 6 feature)
 	Tags []string
+
+	// Origin identifies the module that registered the binding, typically the
+	// package path of a service provider. Empty for application bindings.
+	Origin string
+
+	// Eager marks a singleton for creation at boot rather than on first use
+	Eager bool
+
+	// Priority orders bindings in GetAll and GetByTag: higher priorities
+	// come first, equal priorities keep registration order
+	Priority int
+
+	// Meta holds key/value metadata for selecting bindings with Query
+	Meta Metadata
+
+	// TTL is how long an instance is reused under a cached lifetime, parsed
+	// from Lifetime when the binding is registered. Zero for other lifetimes.
+	TTL time.Duration
+}
+
+// Metadata is key/value metadata attached to a binding, such as
+// "version" => "2". Reading a missing key yields "".
+type Metadata map[string]string
+
+// copy returns a copy of m, empty rather than nil.
+func (m Metadata) copy() Metadata {
+	c := make(Metadata, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
 }
 
 // Registry provides thread-safe storage for bindings.
 // It uses a map with reflect.Type keys for O(1) lookup performance.
 type Registry struct {
-	mu            sync.RWMutex
+	mu            lockstat.RWMutex
 	bindings      map[reflect.Type]*Binding
 	namedBindings map[reflect.Type]map[string]*Binding
+
+	// order records when each binding was stored, for deterministic listings
+	order   map[*Binding]uint64
+	nextSeq uint64
+
+	// tags indexes bindings by tag, for GetByTag and GetByTagExpr
+	tags map[string]map[*Binding]struct{}
+
+	// frozen disables writes; reads skip locking once it is set
+	frozen atomic.Bool
+
+	// lockStats counts lock acquisitions once EnableLockStats is called
+	lockStats lockstat.Counter
 }
 
+// ErrFrozen is returned when modifying a frozen registry.
+var ErrFrozen = errors.New("registry is frozen")
+
 // New creates a new Registry instance.
 func New() *Registry {
 	return &Registry{
 		bindings:      make(map[reflect.Type]*Binding),
 		namedBindings: make(map[reflect.Type]map[string]*Binding),
+		tags:          make(map[string]map[*Binding]struct{}),
+		order:         make(map[*Binding]uint64),
+	}
+}
+
+// track records the registration order of a binding and indexes its
+// tags. Callers hold the write lock.
+func (r *Registry) track(binding *Binding) {
+	r.nextSeq++
+	r.order[binding] = r.nextSeq
+	r.indexTags(binding)
+}
+
+// indexTags adds a binding to the tag index. Callers hold the write lock.
+func (r *Registry) indexTags(binding *Binding) {
+	for _, tag := range binding.Tags {
+		if r.tags[tag] == nil {
+			r.tags[tag] = make(map[*Binding]struct{})
+		}
+		r.tags[tag][binding] = struct{}{}
+	}
+}
+
+// untrack forgets a removed or replaced binding. Callers hold the write lock.
+func (r *Registry) untrack(binding *Binding) {
+	if binding == nil {
+		return
+	}
+	delete(r.order, binding)
+	for _, tag := range binding.Tags {
+		delete(r.tags[tag], binding)
+		if len(r.tags[tag]) == 0 {
+			delete(r.tags, tag)
+		}
 	}
 }
 
+// sortByOrder orders bindings by priority, highest first, then by
+// registration order. Callers hold the read lock.
+func (r *Registry) sortByOrder(bindings []*Binding) {
+	sort.Slice(bindings, func(i, j int) bool {
+		if bindings[i].Priority != bindings[j].Priority {
+			return bindings[i].Priority > bindings[j].Priority
+		}
+		return r.order[bindings[i]] < r.order[bindings[j]]
+	})
+}
+
+// sortByPriority orders bindings by priority, highest first, keeping the
+// existing order of equal priorities.
+func sortByPriority(bindings []*Binding) {
+	sort.SliceStable(bindings, func(i, j int) bool {
+		return bindings[i].Priority > bindings[j].Priority
+	})
+}
+
 // Register stores a binding in the registry.
 // Returns an error if a binding for the same type already exists.
 //
@@ -67,12 +172,17 @@ func (r *Registry) Register(binding *Binding) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	if r.frozen.Load() {
+		return ErrFrozen
+	}
+
 	// Check for duplicate
-	if _, exists := r.bindings[binding.AbstractType]; exists {
-		return &BindingAlreadyExistsError{Type: binding.AbstractType}
+	if existing, exists := r.bindings[binding.AbstractType]; exists {
+		return &BindingAlreadyExistsError{Type: binding.AbstractType, Origin: existing.Origin}
 	}
 
 	r.bindings[binding.AbstractType] = binding
+	r.track(binding)
 	return nil
 }
 
@@ -82,8 +192,7 @@ func (r *Registry) Register(binding *Binding) error {
 //
 // This method is goroutine-safe.
 func (r *Registry) Get(abstractType reflect.Type) (*Binding, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	defer r.rlock()()
 
 	binding, exists := r.bindings[abstractType]
 	if !exists {
@@ -98,8 +207,7 @@ func (r *Registry) Get(abstractType reflect.Type) (*Binding, error) {
 //
 // This method is goroutine-safe.
 func (r *Registry) Has(abstractType reflect.Type) bool {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	defer r.rlock()()
 
 	_, exists := r.bindings[abstractType]
 	return exists
@@ -108,9 +216,15 @@ func (r *Registry) Has(abstractType reflect.Type) bool {
 // BindingAlreadyExistsError is returned when attempting to register a duplicate binding.
 type BindingAlreadyExistsError struct {
 	Type reflect.Type
+
+	// Origin is the origin of the existing binding, if any
+	Origin string
 }
 
 func (e *BindingAlreadyExistsError) Error() string {
+	if e.Origin != "" {
+		return fmt.Sprintf("binding already exists for type %v (registered by %s)", e.Type, e.Origin)
+	}
 	return fmt.Sprintf("binding already exists for type %v", e.Type)
 }
 
@@ -138,17 +252,25 @@ func (r *Registry) RegisterNamed(binding *Binding) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	if r.frozen.Load() {
+		return ErrFrozen
+	}
+
 	// Initialize nested map if needed
 	if r.namedBindings[binding.AbstractType] == nil {
 		r.namedBindings[binding.AbstractType] = make(map[string]*Binding)
 	}
 
 	// Check for duplicate name
-	if _, exists := r.namedBindings[binding.AbstractType][binding.Name]; exists {
+	if existing, exists := r.namedBindings[binding.AbstractType][binding.Name]; exists {
+		if existing.Origin != "" {
+			return fmt.Errorf("named binding '%s' for type %v already exists (registered by %s)", binding.Name, binding.AbstractType, existing.Origin)
+		}
 		return fmt.Errorf("named binding '%s' for type %v already exists", binding.Name, binding.AbstractType)
 	}
 
 	r.namedBindings[binding.AbstractType][binding.Name] = binding
+	r.track(binding)
 	return nil
 }
 
@@ -158,8 +280,7 @@ func (r *Registry) RegisterNamed(binding *Binding) error {
 //
 // This method is goroutine-safe.
 func (r *Registry) GetNamed(abstractType reflect.Type, name string) (*Binding, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	defer r.rlock()()
 
 	typeBindings, exists := r.namedBindings[abstractType]
 	if !exists {
@@ -174,13 +295,14 @@ func (r *Registry) GetNamed(abstractType reflect.Type, name string) (*Binding, e
 	return binding, nil
 }
 
-// GetAll returns all bindings for a given type (both named and unnamed).
+// GetAll returns all bindings for a given type (both named and unnamed),
+// ordered by priority, highest first. Within a priority the default
+// binding comes first, then named bindings in registration order.
 // Returns empty slice if no bindings found.
 //
 // This method is goroutine-safe.
 func (r *Registry) GetAll(abstractType reflect.Type) []*Binding {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	defer r.rlock()()
 
 	var result []*Binding
 
@@ -191,57 +313,74 @@ func (r *Registry) GetAll(abstractType reflect.Type) []*Binding {
 
 	// Add all named bindings
 	if namedBindings, exists := r.namedBindings[abstractType]; exists {
+		named := make([]*Binding, 0, len(namedBindings))
 		for _, binding := range namedBindings {
-			result = append(result, binding)
+			named = append(named, binding)
 		}
+		r.sortByOrder(named)
+		result = append(result, named...)
 	}
 
+	sortByPriority(result)
 	return result
 }
 
-// GetByTag returns all bindings that have the specified tag.
+// GetByTag returns all bindings that have the specified tag, ordered by
+// priority, highest first, then registration order.
 // Returns empty slice if no tagged bindings found.
 //
 // This method is goroutine-safe.
 func (r *Registry) GetByTag(tag string) []*Binding {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	defer r.rlock()()
 
-	var result []*Binding
-
-	// Check unnamed bindings
-	for _, binding := range r.bindings {
-		if containsTag(binding.Tags, tag) {
-			result = append(result, binding)
-		}
-	}
-
-	// Check named bindings
-	for _, namedMap := range r.namedBindings {
-		for _, binding := range namedMap {
-			if containsTag(binding.Tags, tag) {
-				result = append(result, binding)
-			}
-		}
+	result := make([]*Binding, 0, len(r.tags[tag]))
+	for binding := range r.tags[tag] {
+		result = append(result, binding)
 	}
 
+	r.sortByOrder(result)
 	return result
 }
 
-// containsTag checks if a tag exists in a slice of tags.
-func containsTag(tags []string, tag string) bool {
-	for _, t := range tags {
-		if t == tag {
-			return true
+// Query returns the bindings whose metadata satisfies match, ordered like
+// GetByTag. match receives a copy of each binding's metadata, or an empty
+// Metadata for bindings without any, and is called without the registry
+// lock held, so it can neither change the registry's state nor deadlock
+// by calling back into it.
+//
+// Example:
+//
+//	v2 := reg.Query(func(meta registry.Metadata) bool {
+//	    return meta["version"] == "2"
+//	})
+//
+// This method is goroutine-safe.
+func (r *Registry) Query(match func(meta Metadata) bool) []*Binding {
+	unlock := r.rlock()
+	bindings := make([]*Binding, 0, len(r.order))
+	for binding := range r.order {
+		bindings = append(bindings, binding)
+	}
+	r.sortByOrder(bindings)
+	metas := make([]Metadata, len(bindings))
+	for i, binding := range bindings {
+		metas[i] = binding.Meta.copy()
+	}
+	unlock()
+
+	var result []*Binding
+	for i, binding := range bindings {
+		if match(metas[i]) {
+			result = append(result, binding)
 		}
 	}
-	return false
+	return result
 }
 
-// GetAllTypes returns all types that have bindings (named or unnamed).
+// GetAllTypes returns all types that have bindings (named or unnamed),
+// ordered by TypeLess.
 func (r *Registry) GetAllTypes() []reflect.Type {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	defer r.rlock()()
 
 	typeSet := make(map[reflect.Type]bool)
 
@@ -260,14 +399,25 @@ func (r *Registry) GetAllTypes() []reflect.Type {
 	for t := range typeSet {
 		types = append(types, t)
 	}
+	sort.Slice(types, func(i, j int) bool {
+		return TypeLess(types[i], types[j])
+	})
 
 	return types
 }
 
-// GetAllNamedFor returns all names for a given type.
+// TypeLess orders types by their string form, then package path, giving
+// listings a stable order across runs.
+func TypeLess(a, b reflect.Type) bool {
+	if sa, sb := a.String(), b.String(); sa != sb {
+		return sa < sb
+	}
+	return a.PkgPath() < b.PkgPath()
+}
+
+// GetAllNamedFor returns all names for a given type, sorted.
 func (r *Registry) GetAllNamedFor(abstractType reflect.Type) []string {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	defer r.rlock()()
 
 	namedMap, exists := r.namedBindings[abstractType]
 	if !exists {
@@ -278,15 +428,175 @@ func (r *Registry) GetAllNamedFor(abstractType reflect.Type) []string {
 	for name := range namedMap {
 		names = append(names, name)
 	}
+	sort.Strings(names)
 
 	return names
 }
 
 // HasUnnamedBinding checks if there's an unnamed binding for a type.
 func (r *Registry) HasUnnamedBinding(abstractType reflect.Type) bool {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	defer r.rlock()()
 
 	_, exists := r.bindings[abstractType]
 	return exists
 }
+
+// Replace stores a binding, overwriting any existing binding for the type
+// (and name, for named bindings).
+// Returns the previous binding, or nil if there was none.
+//
+// This method is goroutine-safe.
+func (r *Registry) Replace(binding *Binding) (*Binding, error) {
+	if binding == nil {
+		return nil, fmt.Errorf("binding cannot be nil")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.frozen.Load() {
+		return nil, ErrFrozen
+	}
+
+	if binding.Name != "" {
+		if r.namedBindings[binding.AbstractType] == nil {
+			r.namedBindings[binding.AbstractType] = make(map[string]*Binding)
+		}
+		previous := r.namedBindings[binding.AbstractType][binding.Name]
+		r.namedBindings[binding.AbstractType][binding.Name] = binding
+		r.replaceOrder(previous, binding)
+		return previous, nil
+	}
+
+	previous := r.bindings[binding.AbstractType]
+	r.bindings[binding.AbstractType] = binding
+	r.replaceOrder(previous, binding)
+	return previous, nil
+}
+
+// replaceOrder gives a replacement the position of the binding it replaces,
+// or a new position if there was none. Callers hold the write lock.
+func (r *Registry) replaceOrder(previous, binding *Binding) {
+	if previous == binding {
+		return
+	}
+	if previous == nil {
+		r.track(binding)
+		return
+	}
+	seq := r.order[previous]
+	r.untrack(previous)
+	r.order[binding] = seq
+	r.indexTags(binding)
+}
+
+// Clone returns a new registry holding the same bindings.
+// Binding values are shared; they must be treated as immutable once registered.
+//
+// This method is goroutine-safe.
+func (r *Registry) Clone() *Registry {
+	defer r.rlock()()
+
+	clone := New()
+	for t, binding := range r.bindings {
+		clone.bindings[t] = binding
+	}
+	for t, named := range r.namedBindings {
+		clone.namedBindings[t] = make(map[string]*Binding, len(named))
+		for name, binding := range named {
+			clone.namedBindings[t][name] = binding
+		}
+	}
+	for binding, seq := range r.order {
+		clone.order[binding] = seq
+	}
+	for tag, tagged := range r.tags {
+		clone.tags[tag] = make(map[*Binding]struct{}, len(tagged))
+		for binding := range tagged {
+			clone.tags[tag][binding] = struct{}{}
+		}
+	}
+	clone.nextSeq = r.nextSeq
+	return clone
+}
+
+// Remove deletes the default binding for a type.
+// Returns the removed binding, or nil if there was none.
+// Frozen registries are left unchanged.
+//
+// This method is goroutine-safe.
+func (r *Registry) Remove(abstractType reflect.Type) *Binding {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.frozen.Load() {
+		return nil
+	}
+
+	binding := r.bindings[abstractType]
+	delete(r.bindings, abstractType)
+	r.untrack(binding)
+	return binding
+}
+
+// RemoveNamed deletes the named binding for a type.
+// Returns the removed binding, or nil if there was none.
+// Frozen registries are left unchanged.
+//
+// This method is goroutine-safe.
+func (r *Registry) RemoveNamed(abstractType reflect.Type, name string) *Binding {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.frozen.Load() {
+		return nil
+	}
+
+	binding := r.namedBindings[abstractType][name]
+	if binding == nil {
+		return nil
+	}
+	delete(r.namedBindings[abstractType], name)
+	if len(r.namedBindings[abstractType]) == 0 {
+		delete(r.namedBindings, abstractType)
+	}
+	r.untrack(binding)
+	return binding
+}
+
+// Freeze makes the registry read-only. Subsequent writes return ErrFrozen,
+// and reads no longer take the lock since the maps can no longer change.
+//
+// This method is goroutine-safe.
+func (r *Registry) Freeze() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.frozen.Store(true)
+}
+
+// EnableLockStats starts counting lock acquisitions and contention.
+// Reads of a frozen registry take no lock and are not counted.
+func (r *Registry) EnableLockStats() {
+	r.mu.Observe(&r.lockStats)
+}
+
+// LockStats returns the lock counters recorded since EnableLockStats.
+func (r *Registry) LockStats() lockstat.Stats {
+	return r.lockStats.Stats()
+}
+
+// IsFrozen reports whether Freeze has been called.
+func (r *Registry) IsFrozen() bool {
+	return r.frozen.Load()
+}
+
+// rlock acquires the read lock unless the registry is frozen,
+// returning the matching unlock function.
+func (r *Registry) rlock() func() {
+	if r.frozen.Load() {
+		return func() {}
+	}
+	r.mu.RLock()
+	return r.mu.RUnlock
+}
@@ -573,3 +573,268 @@ func TestBindingNotFoundError_Error(t *testing.T) {
 		t.Error("Error() should return non-empty string")
 	}
 }
+
+func TestReplace(t *testing.T) {
+	reg := New()
+	interfaceType := reflect.TypeOf((*testInterface)(nil)).Elem()
+	first := &Binding{AbstractType: interfaceType, ConcreteType: reflect.TypeOf(&testImplementation{})}
+	second := &Binding{AbstractType: interfaceType, ConcreteType: reflect.TypeOf(&testImplementation{})}
+
+	previous, err := reg.Replace(first)
+	if err != nil || previous != nil {
+		t.Fatalf("Replace() on empty registry = %v, %v", previous, err)
+	}
+
+	previous, _ = reg.Replace(second)
+	if previous != first {
+		t.Error("Replace() should return the previous binding")
+	}
+
+	got, _ := reg.Get(interfaceType)
+	if got != second {
+		t.Error("Replace() did not store the new binding")
+	}
+
+	if _, err := reg.Replace(nil); err == nil {
+		t.Error("Replace(nil) should return error")
+	}
+
+	named := &Binding{AbstractType: interfaceType, Name: "a"}
+	if previous, _ := reg.Replace(named); previous != nil {
+		t.Error("Replace() of a new named binding should return nil")
+	}
+	if got, _ := reg.GetNamed(interfaceType, "a"); got != named {
+		t.Error("Replace() did not store the named binding")
+	}
+	if got, _ := reg.Get(interfaceType); got != second {
+		t.Error("Replace() of a named binding must not touch the default binding")
+	}
+}
+
+func TestClone_Independent(t *testing.T) {
+	reg := New()
+	interfaceType := reflect.TypeOf((*testInterface)(nil)).Elem()
+	_ = reg.Register(&Binding{AbstractType: interfaceType, ConcreteType: reflect.TypeOf(&testImplementation{})})
+	_ = reg.RegisterNamed(&Binding{AbstractType: interfaceType, Name: "a"})
+
+	clone := reg.Clone()
+	_ = clone.RegisterNamed(&Binding{AbstractType: interfaceType, Name: "b"})
+
+	if !clone.Has(interfaceType) {
+		t.Error("clone is missing the default binding")
+	}
+	if _, err := clone.GetNamed(interfaceType, "a"); err != nil {
+		t.Error("clone is missing the named binding")
+	}
+	if _, err := reg.GetNamed(interfaceType, "b"); err == nil {
+		t.Error("registering on the clone affected the original")
+	}
+}
+
+func TestRemove(t *testing.T) {
+	reg := New()
+	interfaceType := reflect.TypeOf((*testInterface)(nil)).Elem()
+	binding := &Binding{AbstractType: interfaceType}
+	_ = reg.Register(binding)
+
+	if removed := reg.Remove(interfaceType); removed != binding {
+		t.Error("Remove() should return the removed binding")
+	}
+	if reg.Has(interfaceType) {
+		t.Error("binding still present after Remove()")
+	}
+	if removed := reg.Remove(interfaceType); removed != nil {
+		t.Error("Remove() on a missing binding should return nil")
+	}
+}
+
+func TestFreeze(t *testing.T) {
+	reg := New()
+	interfaceType := reflect.TypeOf((*testInterface)(nil)).Elem()
+	_ = reg.Register(&Binding{AbstractType: interfaceType})
+
+	reg.Freeze()
+	if !reg.IsFrozen() {
+		t.Fatal("IsFrozen() should be true after Freeze()")
+	}
+
+	if err := reg.Register(&Binding{AbstractType: reflect.TypeOf(0)}); err != ErrFrozen {
+		t.Errorf("Register() on frozen registry = %v, want ErrFrozen", err)
+	}
+	if err := reg.RegisterNamed(&Binding{AbstractType: interfaceType, Name: "x"}); err != ErrFrozen {
+		t.Errorf("RegisterNamed() on frozen registry = %v, want ErrFrozen", err)
+	}
+	if _, err := reg.Replace(&Binding{AbstractType: interfaceType}); err != ErrFrozen {
+		t.Errorf("Replace() on frozen registry = %v, want ErrFrozen", err)
+	}
+	if reg.Remove(interfaceType) != nil || !reg.Has(interfaceType) {
+		t.Error("Remove() should not modify a frozen registry")
+	}
+
+	// Lock-free reads still work
+	if _, err := reg.Get(interfaceType); err != nil {
+		t.Errorf("Get() on frozen registry failed: %v", err)
+	}
+}
+
+func TestGetAll_RegistrationOrder(t *testing.T) {
+	reg := New()
+	interfaceType := reflect.TypeOf((*testInterface)(nil)).Elem()
+	names := []string{"zeta", "alpha", "mid", "beta"}
+	for _, name := range names {
+		_ = reg.RegisterNamed(&Binding{AbstractType: interfaceType, Name: name})
+	}
+	_ = reg.Register(&Binding{AbstractType: interfaceType})
+
+	// Replacing keeps the position
+	_, _ = reg.Replace(&Binding{AbstractType: interfaceType, Name: "alpha"})
+
+	for i := 0; i < 5; i++ {
+		all := reg.GetAll(interfaceType)
+		if all[0].Name != "" {
+			t.Fatal("default binding should come first")
+		}
+		for j, name := range names {
+			if all[j+1].Name != name {
+				t.Fatalf("binding %d = %q, want %q", j+1, all[j+1].Name, name)
+			}
+		}
+	}
+}
+
+func TestGetAll_Priority(t *testing.T) {
+	reg := New()
+	interfaceType := reflect.TypeOf((*testInterface)(nil)).Elem()
+	_ = reg.Register(&Binding{AbstractType: interfaceType})
+	_ = reg.RegisterNamed(&Binding{AbstractType: interfaceType, Name: "low", Priority: -1, Tags: []string{"t"}})
+	_ = reg.RegisterNamed(&Binding{AbstractType: interfaceType, Name: "plain", Tags: []string{"t"}})
+	_ = reg.RegisterNamed(&Binding{AbstractType: interfaceType, Name: "high", Priority: 10, Tags: []string{"t"}})
+
+	var got []string
+	for _, b := range reg.GetAll(interfaceType) {
+		got = append(got, b.Name)
+	}
+	if want := []string{"high", "", "plain", "low"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetAll() order = %q, want %q", got, want)
+	}
+
+	got = nil
+	for _, b := range reg.GetByTag("t") {
+		got = append(got, b.Name)
+	}
+	if want := []string{"high", "plain", "low"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetByTag() order = %q, want %q", got, want)
+	}
+}
+
+func TestQuery(t *testing.T) {
+	reg := New()
+	interfaceType := reflect.TypeOf((*testInterface)(nil)).Elem()
+	_ = reg.RegisterNamed(&Binding{AbstractType: interfaceType, Name: "v1", Meta: Metadata{"version": "1"}})
+	_ = reg.RegisterNamed(&Binding{AbstractType: interfaceType, Name: "v2", Meta: Metadata{"version": "2", "beta": "true"}})
+	_ = reg.RegisterNamed(&Binding{AbstractType: interfaceType, Name: "v2-stable", Meta: Metadata{"version": "2"}})
+	_ = reg.Register(&Binding{AbstractType: interfaceType})
+
+	var got []string
+	for _, b := range reg.Query(func(meta Metadata) bool { return meta["version"] == "2" }) {
+		got = append(got, b.Name)
+	}
+	if want := []string{"v2", "v2-stable"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Query(version=2) = %q, want %q", got, want)
+	}
+
+	got = nil
+	for _, b := range reg.Query(func(meta Metadata) bool { return meta["beta"] == "" }) {
+		got = append(got, b.Name)
+	}
+	if want := []string{"v1", "v2-stable", ""}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Query(!beta) = %q, want %q", got, want)
+	}
+}
+
+func TestQuery_IsolatesMetadata(t *testing.T) {
+	reg := New()
+	interfaceType := reflect.TypeOf((*testInterface)(nil)).Elem()
+	_ = reg.RegisterNamed(&Binding{AbstractType: interfaceType, Name: "v1", Meta: Metadata{"version": "1"}})
+	_ = reg.Register(&Binding{AbstractType: interfaceType})
+
+	reg.Query(func(meta Metadata) bool {
+		meta["version"] = "tampered"
+		// The lock is not held, so calling back into the registry is safe
+		_ = reg.GetAllTypes()
+		return false
+	})
+
+	named, _ := reg.GetNamed(interfaceType, "v1")
+	unnamed, _ := reg.Get(interfaceType)
+	if named.Meta["version"] != "1" || unnamed.Meta != nil {
+		t.Errorf("Query callbacks changed metadata: %v, %v", named.Meta, unnamed.Meta)
+	}
+	if got := reg.Query(func(meta Metadata) bool { return meta["version"] == "tampered" }); len(got) != 0 {
+		t.Errorf("Query() = %v, want no binding with tampered metadata", got)
+	}
+}
+
+func TestRemoveNamed(t *testing.T) {
+	reg := New()
+	interfaceType := reflect.TypeOf((*testInterface)(nil)).Elem()
+	_ = reg.RegisterNamed(&Binding{AbstractType: interfaceType, Name: "a", Tags: []string{"t"}})
+
+	if reg.RemoveNamed(interfaceType, "missing") != nil {
+		t.Error("RemoveNamed() of a missing binding should return nil")
+	}
+	if removed := reg.RemoveNamed(interfaceType, "a"); removed == nil || removed.Name != "a" {
+		t.Fatalf("RemoveNamed() = %v, want the binding", removed)
+	}
+	if _, err := reg.GetNamed(interfaceType, "a"); err == nil {
+		t.Error("binding should be gone")
+	}
+	if len(reg.GetByTag("t")) != 0 || len(reg.GetAllTypes()) != 0 {
+		t.Error("RemoveNamed() should drop the binding from listings")
+	}
+}
+
+func TestLockStats(t *testing.T) {
+	reg := New()
+	interfaceType := reflect.TypeOf((*testInterface)(nil)).Elem()
+
+	_ = reg.Register(&Binding{AbstractType: interfaceType})
+	if reg.LockStats().Acquisitions != 0 {
+		t.Error("locks should not be counted before EnableLockStats()")
+	}
+
+	reg.EnableLockStats()
+	_, _ = reg.Get(interfaceType)
+	_ = reg.Has(interfaceType)
+	if got := reg.LockStats().Acquisitions; got != 2 {
+		t.Errorf("Acquisitions = %d, want 2", got)
+	}
+}
+
+func TestListings_Sorted(t *testing.T) {
+	reg := New()
+	interfaceType := reflect.TypeOf((*testInterface)(nil)).Elem()
+	types := []reflect.Type{
+		reflect.TypeOf((*fmt.Stringer)(nil)).Elem(),
+		interfaceType,
+		reflect.TypeOf((*error)(nil)).Elem(),
+	}
+	for _, typ := range types {
+		_ = reg.Register(&Binding{AbstractType: typ})
+	}
+	for _, name := range []string{"zeta", "alpha", "mid"} {
+		_ = reg.RegisterNamed(&Binding{AbstractType: interfaceType, Name: name})
+	}
+
+	for i := 0; i < 5; i++ {
+		got := reg.GetAllTypes()
+		for j := 1; j < len(got); j++ {
+			if !TypeLess(got[j-1], got[j]) {
+				t.Fatalf("GetAllTypes not sorted: %v", got)
+			}
+		}
+		if names := fmt.Sprint(reg.GetAllNamedFor(interfaceType)); names != "[alpha mid zeta]" {
+			t.Fatalf("GetAllNamedFor = %s, want sorted names", names)
+		}
+	}
+}
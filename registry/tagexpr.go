@@ -0,0 +1,196 @@
+package registry
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TagExpr is a parsed tag expression selecting bindings by their tags,
+// such as "plugin && enabled && !deprecated". Tags combine with && (and),
+// || (or), ! (not) and parentheses; && binds tighter than ||.
+type TagExpr struct {
+	source string
+	root   tagNode
+}
+
+// tagNode is a node of a parsed tag expression. It evaluates to the set of
+// bindings it selects, given the registry's tag index.
+type tagNode interface {
+	eval(r *Registry) map[*Binding]struct{}
+}
+
+type (
+	tagLeaf struct{ tag string }
+	tagNot  struct{ operand tagNode }
+	tagAnd  struct{ left, right tagNode }
+	tagOr   struct{ left, right tagNode }
+)
+
+func (n tagLeaf) eval(r *Registry) map[*Binding]struct{} {
+	set := make(map[*Binding]struct{}, len(r.tags[n.tag]))
+	for binding := range r.tags[n.tag] {
+		set[binding] = struct{}{}
+	}
+	return set
+}
+
+func (n tagNot) eval(r *Registry) map[*Binding]struct{} {
+	excluded := n.operand.eval(r)
+	set := make(map[*Binding]struct{})
+	for binding := range r.order {
+		if _, ok := excluded[binding]; !ok {
+			set[binding] = struct{}{}
+		}
+	}
+	return set
+}
+
+func (n tagAnd) eval(r *Registry) map[*Binding]struct{} {
+	left, right := n.left.eval(r), n.right.eval(r)
+	if len(right) < len(left) {
+		left, right = right, left
+	}
+	for binding := range left {
+		if _, ok := right[binding]; !ok {
+			delete(left, binding)
+		}
+	}
+	return left
+}
+
+func (n tagOr) eval(r *Registry) map[*Binding]struct{} {
+	left := n.left.eval(r)
+	for binding := range n.right.eval(r) {
+		left[binding] = struct{}{}
+	}
+	return left
+}
+
+// ParseTagExpr parses a tag expression. Tags are runs of characters other
+// than whitespace, parentheses, '&', '|' and '!'.
+//
+// Example:
+//
+//	expr, err := registry.ParseTagExpr("(plugin || extension) && !deprecated")
+func ParseTagExpr(source string) (*TagExpr, error) {
+	p := &tagParser{source: source}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.skipSpace(); p.pos < len(p.source) {
+		return nil, p.errorf("unexpected %q", p.source[p.pos:])
+	}
+	return &TagExpr{source: source, root: root}, nil
+}
+
+// String returns the expression as it was written.
+func (e *TagExpr) String() string {
+	return e.source
+}
+
+// tagParser is a recursive descent parser over a tag expression.
+type tagParser struct {
+	source string
+	pos    int
+}
+
+func (p *tagParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("invalid tag expression %q at offset %d: %s", p.source, p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *tagParser) skipSpace() {
+	for p.pos < len(p.source) && strings.ContainsRune(" \t\n\r", rune(p.source[p.pos])) {
+		p.pos++
+	}
+}
+
+// consume skips whitespace and the operator op if it comes next.
+func (p *tagParser) consume(op string) bool {
+	p.skipSpace()
+	if strings.HasPrefix(p.source[p.pos:], op) {
+		p.pos += len(op)
+		return true
+	}
+	return false
+}
+
+func (p *tagParser) parseOr() (tagNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.consume("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = tagOr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *tagParser) parseAnd() (tagNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.consume("&&") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = tagAnd{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *tagParser) parseUnary() (tagNode, error) {
+	if p.consume("!") {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return tagNot{operand: operand}, nil
+	}
+	if p.consume("(") {
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.consume(")") {
+			return nil, p.errorf("missing )")
+		}
+		return inner, nil
+	}
+
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.source) && !strings.ContainsRune(" \t\n\r()&|!", rune(p.source[p.pos])) {
+		p.pos++
+	}
+	if p.pos == start {
+		if p.pos == len(p.source) {
+			return nil, p.errorf("expected a tag")
+		}
+		return nil, p.errorf("expected a tag, got %q", p.source[p.pos])
+	}
+	return tagLeaf{tag: p.source[start:p.pos]}, nil
+}
+
+// GetByTagExpr returns the bindings whose tags satisfy expr, ordered like
+// GetByTag. Tags are looked up in the registry's tag index rather than by
+// scanning every binding.
+//
+// This method is goroutine-safe.
+func (r *Registry) GetByTagExpr(expr *TagExpr) []*Binding {
+	defer r.rlock()()
+
+	set := expr.root.eval(r)
+	result := make([]*Binding, 0, len(set))
+	for binding := range set {
+		result = append(result, binding)
+	}
+	r.sortByOrder(result)
+	return result
+}
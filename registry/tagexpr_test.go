@@ -0,0 +1,92 @@
+package registry
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTagExpr(t *testing.T) {
+	valid := []string{
+		"plugin",
+		"plugin && enabled && !deprecated",
+		"(a || b) && !(c || d)",
+		"!!a",
+		"  spaced   &&tight ",
+		"http.v2 || grpc-v1",
+	}
+	for _, source := range valid {
+		expr, err := ParseTagExpr(source)
+		if err != nil {
+			t.Errorf("ParseTagExpr(%q) error = %v", source, err)
+			continue
+		}
+		if expr.String() != source {
+			t.Errorf("String() = %q, want %q", expr.String(), source)
+		}
+	}
+
+	invalid := []string{"", "a &&", "a & b", "(a || b", "a b", "!", "a || )"}
+	for _, source := range invalid {
+		if _, err := ParseTagExpr(source); err == nil {
+			t.Errorf("ParseTagExpr(%q) should fail", source)
+		}
+	}
+}
+
+func TestGetByTagExpr(t *testing.T) {
+	reg := New()
+	interfaceType := reflect.TypeOf((*testInterface)(nil)).Elem()
+	register := func(name string, tags ...string) {
+		_ = reg.RegisterNamed(&Binding{AbstractType: interfaceType, Name: name, Tags: tags})
+	}
+	register("auth", "plugin", "enabled")
+	register("legacy", "plugin", "enabled", "deprecated")
+	register("metrics", "plugin")
+	register("cache", "extension", "enabled")
+	_ = reg.Register(&Binding{AbstractType: interfaceType})
+
+	tests := []struct {
+		expr string
+		want []string
+	}{
+		{"plugin && enabled && !deprecated", []string{"auth"}},
+		{"plugin || extension", []string{"auth", "legacy", "metrics", "cache"}},
+		{"(plugin || extension) && enabled", []string{"auth", "legacy", "cache"}},
+		{"plugin && enabled || extension", []string{"auth", "legacy", "cache"}},
+		{"!plugin", []string{"cache", ""}},
+		{"missing", nil},
+	}
+	for _, tt := range tests {
+		expr, err := ParseTagExpr(tt.expr)
+		if err != nil {
+			t.Fatalf("ParseTagExpr(%q) error = %v", tt.expr, err)
+		}
+		var got []string
+		for _, b := range reg.GetByTagExpr(expr) {
+			got = append(got, b.Name)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("GetByTagExpr(%q) = %q, want %q", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestTagIndex_ReplaceAndRemove(t *testing.T) {
+	reg := New()
+	interfaceType := reflect.TypeOf((*testInterface)(nil)).Elem()
+	_ = reg.Register(&Binding{AbstractType: interfaceType, Tags: []string{"old"}})
+
+	_, _ = reg.Replace(&Binding{AbstractType: interfaceType, Tags: []string{"new"}})
+	if len(reg.GetByTag("old")) != 0 || len(reg.GetByTag("new")) != 1 {
+		t.Error("Replace() should re-index the binding's tags")
+	}
+
+	clone := reg.Clone()
+	reg.Remove(interfaceType)
+	if len(reg.GetByTag("new")) != 0 {
+		t.Error("Remove() should drop the binding from the tag index")
+	}
+	if len(clone.GetByTag("new")) != 1 {
+		t.Error("Clone() should copy the tag index")
+	}
+}
@@ -1,10 +1,14 @@
 package nasc
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/toutaio/toutago-nasc-dependency-injector/internal/lockstat"
 	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
 )
 
@@ -23,8 +27,9 @@ type Disposable interface {
 }
 
 // Initializable represents a service that requires initialization.
-// Services implementing this interface will have Initialize called
-// after being created.
+// Services implementing this interface will have Initialize called once
+// after being created and injected, whatever their lifetime, unless they
+// come from a factory function. See also InitializableWithContainer.
 //
 // Example:
 //
@@ -49,22 +54,59 @@ type Initializable interface {
 //	uow := scope.Make((*UnitOfWork)(nil)).(UnitOfWork)
 type Scope struct {
 	parent        *Nasc
+	name          string    // see ScopeName
+	created       time.Time // creation time, reported by LiveScopes
+	seq           uint64    // creation order among tracked scopes
 	instances     map[reflect.Type]interface{}
 	creationOrder []interface{} // Track order for reverse disposal
+	overrides     map[reflect.Type]interface{}
+	bindings      map[reflect.Type]*registry.Binding // see Scope.Bind
+	named         map[namedKey]interface{}           // scoped instances of named and tagged bindings
+	tagGroups     map[string][]interface{}           // see WithScopeTagCache
+	ctx           context.Context
+	values        map[string]interface{}
 	children      []*Scope
+	disposeHooks  []func()       // see OnDispose
+	ownTransients bool           // see TrackTransients
+	inherit       []reflect.Type // see InheritScoped
 	disposed      bool
-	mu            sync.RWMutex
+	mu            lockstat.RWMutex
+
+	// inflight counts work registered with Track; idle is closed when it
+	// drops back to zero
+	inflight int
+	idle     chan struct{}
+	trackMu  sync.Mutex
+
+	// budget limits the scope's work; resolutions and slowest measure it
+	budget                  ScopeBudget
+	resolutions             atomic.Int64
+	slowest                 atomic.Int64
+	reportedResolutions     atomic.Bool
+	reportedConstructorTime atomic.Bool
 }
 
 // newScope creates a new scope with the given parent container.
 func newScope(parent *Nasc) *Scope {
-	return &Scope{
+	s := &Scope{
 		parent:        parent,
+		created:       time.Now(),
 		instances:     make(map[reflect.Type]interface{}),
 		creationOrder: make([]interface{}, 0),
+		overrides:     make(map[reflect.Type]interface{}),
+		bindings:      make(map[reflect.Type]*registry.Binding),
+		named:         make(map[namedKey]interface{}),
+		tagGroups:     make(map[string][]interface{}),
+		ctx:           context.Background(),
+		values:        make(map[string]interface{}),
 		children:      make([]*Scope, 0),
 		disposed:      false,
+		budget:        parent.scopeBudget,
 	}
+	if parent.lockMetrics != nil {
+		s.mu.Observe(&parent.lockMetrics.scopes)
+	}
+	return s
 }
 
 // Make resolves an instance within this scope.
@@ -78,6 +120,17 @@ func (s *Scope) Make(abstractType interface{}) interface{} {
 	if abstractType == nil {
 		panic("cannot resolve nil type")
 	}
+	if !s.parent.hasMiddleware() {
+		return s.makeDirect(abstractType)
+	}
+	return s.parent.mustIntercept(Resolution{Type: typeOfToken(abstractType), Scope: s, Context: s.Context()})
+}
+
+// makeDirect is Make without resolution middleware.
+func (s *Scope) makeDirect(abstractType interface{}) interface{} {
+	if abstractType == nil {
+		panic("cannot resolve nil type")
+	}
 
 	s.mu.RLock()
 	if s.disposed {
@@ -92,12 +145,53 @@ func (s *Scope) Make(abstractType interface{}) interface{} {
 		abstractT = abstractT.Elem()
 	}
 
+	s.spendResolution(abstractT)
+
+	// Singleton overrides and instances bound to this scope take precedence
+	s.mu.RLock()
+	override, overridden := s.overrides[abstractT]
+	s.mu.RUnlock()
+	if overridden {
+		return override
+	}
+
+	// Scope bindings shadow the parent's
+	s.mu.RLock()
+	binding, local := s.bindings[abstractT]
+	s.mu.RUnlock()
+
 	// Get binding from parent
-	binding, err := s.parent.registry.Get(abstractT)
+	var err error
+	if !local {
+		if lerr := s.parent.loadLazyProvider(abstractT); lerr != nil {
+			panic(fmt.Sprintf("failed to resolve type %v: %v", abstractT, lerr))
+		}
+		binding, err = s.parent.registry.Get(abstractT)
+	}
 	if err != nil {
+		if instance, ok := s.ambient(abstractT); ok {
+			return instance
+		}
+		if implicit, ok, ierr := s.parent.implicitBindingFor(abstractT); ok {
+			if ierr != nil {
+				panic(fmt.Sprintf("failed to resolve type %v: %v", abstractT, ierr))
+			}
+			return makeImplicit(implicit, s.Make, s.MakeNamed)
+		}
+		if instance, ok, ferr := s.parent.resolveUnbound(abstractT, ""); ok {
+			if ferr != nil {
+				panic(fmt.Sprintf("failed to resolve type %v: %v", abstractT, ferr))
+			}
+			return instance
+		}
 		panic(fmt.Sprintf("binding not found for type %v: %v", abstractT, err))
 	}
 
+	// Cached instances are shared with the parent, like singletons
+	if binding.TTL > 0 {
+		return s.parent.makeDirect(abstractType)
+	}
+
 	// Handle based on lifetime
 	switch Lifetime(binding.Lifetime) {
 	case LifetimeScoped:
@@ -110,44 +204,37 @@ func (s *Scope) Make(abstractType interface{}) interface{} {
 			return instance
 		}
 
-		// Create new instance for this scope
+		// Create new instance for this scope outside the lock, since its
+		// constructor may resolve further dependencies from this scope
+		created := s.createInstance(binding, abstractT)
+
 		s.mu.Lock()
 		// Double-check after acquiring write lock
 		instance, exists = s.instances[abstractT]
 		if !exists {
-			instance = s.createInstance(binding, abstractT)
+			instance = created
 			s.instances[abstractT] = instance
 			s.creationOrder = append(s.creationOrder, instance)
 		}
 		s.mu.Unlock()
 
-		// Initialize if implements Initializable
-		if initializable, ok := instance.(Initializable); ok {
-			if err := initializable.Initialize(); err != nil {
-				panic(fmt.Sprintf("failed to initialize instance of type %v: %v", abstractT, err))
-			}
+		if exists {
+			s.discard(created, instance)
 		}
-
 		return instance
 
 	case LifetimeSingleton:
 		// Delegate to parent for singleton
-		return s.parent.Make(abstractType)
+		return s.parent.makeDirect(abstractType)
 
 	case LifetimeFactory:
 		// Delegate to parent for factory
-		return s.parent.Make(abstractType)
+		return s.parent.makeDirect(abstractType)
 
 	case LifetimeTransient:
 		// Create new instance (don't cache)
 		instance := s.createInstance(binding, abstractT)
-
-		// Initialize if implements Initializable
-		if initializable, ok := instance.(Initializable); ok {
-			if err := initializable.Initialize(); err != nil {
-				panic(fmt.Sprintf("failed to initialize instance of type %v: %v", abstractT, err))
-			}
-		}
+		s.trackTransient(instance)
 
 		return instance
 
@@ -156,18 +243,113 @@ func (s *Scope) Make(abstractType interface{}) interface{} {
 	}
 }
 
-// createInstance creates a new instance from a binding
+// createInstance creates a new instance from a binding, charging the time
+// taken to the scope's budget.
 func (s *Scope) createInstance(binding *registry.Binding, abstractT reflect.Type) interface{} {
+	start := time.Now()
+	instance := s.buildInstance(binding, abstractT)
+	if err := s.spendConstructorTime(abstractT, time.Since(start)); err != nil {
+		if disposable, ok := instance.(Disposable); ok {
+			_ = disposable.Dispose()
+		}
+		panic(err)
+	}
+	s.parent.mustInitialize(instance, abstractT)
+	instance = s.parent.mustDecorate(binding, instance)
+	s.parent.stamp(instance, binding, s, nil)
+	return instance
+}
+
+// discard disposes created, a scoped instance that lost a race with a
+// concurrent resolution which cached kept first, so the constructor that
+// ran twice does not leak what it acquired.
+func (s *Scope) discard(created, kept interface{}) {
+	if containsInstance([]interface{}{kept}, created) {
+		return
+	}
+
+	s.mu.RLock()
+	resolver := newTeardownResolver(s.parent, s)
+	s.mu.RUnlock()
+	if err := disposeInstance(context.Background(), created, resolver); err != nil {
+		s.parent.reportError("dispose", reflect.TypeOf(created), err)
+	}
+}
+
+// buildInstance creates a new instance from a binding
+func (s *Scope) buildInstance(binding *registry.Binding, abstractT reflect.Type) interface{} {
 	if binding.Constructor != nil {
 		info := binding.Constructor.(*constructorInfo)
-		instance, err := s.parent.invokeConstructor(info)
+		instance, err := s.parent.invokeConstructorWith(info, abstractT, s)
 		if err != nil {
 			panic(fmt.Sprintf("failed to invoke constructor for type %v: %v", abstractT, err))
 		}
+		if err := s.injectScopeValues(instance); err != nil {
+			panic(fmt.Sprintf("failed to inject scope values for type %v: %v", abstractT, err))
+		}
 		return instance
 	}
-	instance := reflect.New(binding.ConcreteType.Elem())
-	return instance.Interface()
+	instance := reflect.New(binding.ConcreteType.Elem()).Interface()
+	if binding.AutoWireEnabled {
+		if err := s.parent.autoWireWith(instance, abstractT, s); err != nil {
+			panic(fmt.Sprintf("failed to auto-wire instance for type %v: %v", abstractT, err))
+		}
+	}
+	if err := s.injectScopeValues(instance); err != nil {
+		panic(fmt.Sprintf("failed to inject scope values for type %v: %v", abstractT, err))
+	}
+	return instance
+}
+
+// OverrideSingleton replaces a singleton binding with instance for this scope
+// only. The container's singleton cache and other scopes are unaffected, so
+// it suits per-request experiments and per-test time control.
+//
+// The override applies to Make on this scope and to constructor dependencies
+// of instances the scope creates. Child scopes created afterwards inherit it.
+// The scope does not dispose override instances.
+//
+// Returns an InvalidBindingError if abstractType is not bound as a singleton
+// or instance does not satisfy it.
+//
+// Example:
+//
+//	scope := container.CreateScope()
+//	defer scope.Dispose()
+//
+//	scope.OverrideSingleton((*Clock)(nil), fixedClock)
+func (s *Scope) OverrideSingleton(abstractType, instance interface{}) error {
+	if abstractType == nil {
+		return &InvalidBindingError{Reason: "abstract type cannot be nil"}
+	}
+	if instance == nil {
+		return &InvalidBindingError{Reason: "override instance cannot be nil"}
+	}
+
+	abstractT := typeOfToken(abstractType)
+	binding, err := s.parent.registry.Get(abstractT)
+	if err != nil {
+		return err
+	}
+	if Lifetime(binding.Lifetime) != LifetimeSingleton {
+		return &InvalidBindingError{
+			Reason: fmt.Sprintf("%v is bound as %s, only singletons can be overridden per scope", abstractT, binding.Lifetime),
+		}
+	}
+	if !reflect.TypeOf(instance).AssignableTo(abstractT) {
+		return &InvalidBindingError{
+			Reason: fmt.Sprintf("%T is not assignable to %v", instance, abstractT),
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.disposed {
+		return fmt.Errorf("cannot override singleton in disposed scope")
+	}
+	s.overrides[abstractT] = instance
+	return nil
 }
 
 // CreateChildScope creates a child scope that inherits parent registrations.
@@ -180,7 +362,21 @@ func (s *Scope) createInstance(binding *registry.Binding, abstractT reflect.Type
 //
 //	childScope := parentScope.CreateChildScope()
 //	// Child will be disposed with parent
-func (s *Scope) CreateChildScope() *Scope {
+//
+//	nested := parentScope.CreateChildScope(nasc.InheritScoped((*UnitOfWork)(nil)))
+func (s *Scope) CreateChildScope(options ...ScopeOption) *Scope {
+	child := s.newChildScope()
+	for _, option := range options {
+		option(child)
+	}
+	s.shareInherited(child)
+	s.parent.scopeCreated(child)
+	return child
+}
+
+// newChildScope creates a child scope inheriting this scope's overrides,
+// bindings, context and values.
+func (s *Scope) newChildScope() *Scope {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -189,51 +385,119 @@ func (s *Scope) CreateChildScope() *Scope {
 	}
 
 	child := newScope(s.parent)
+	for t, instance := range s.overrides {
+		child.overrides[t] = instance
+	}
+	for t, binding := range s.bindings {
+		child.bindings[t] = binding
+	}
+	child.ctx = s.ctx
+	child.budget = s.budget
+	child.ownTransients = s.ownTransients
+	for key, value := range s.values {
+		child.values[key] = value
+	}
 	s.children = append(s.children, child)
 	return child
 }
 
+// Track marks the start of in-flight work that uses this scope and returns
+// the function that marks its end. DisposeAllScopes waits for tracked work
+// before disposing the scope. Calling the returned function more than once
+// has no effect.
+//
+// Example:
+//
+//	done := scope.Track()
+//	defer done()
+func (s *Scope) Track() func() {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.disposed {
+		panic("cannot track work in disposed scope")
+	}
+
+	s.trackMu.Lock()
+	if s.inflight == 0 {
+		s.idle = make(chan struct{})
+	}
+	s.inflight++
+	s.trackMu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.trackMu.Lock()
+			defer s.trackMu.Unlock()
+
+			s.inflight--
+			if s.inflight == 0 {
+				close(s.idle)
+			}
+		})
+	}
+}
+
+// idleChan returns a channel that is closed once no tracked work is in flight.
+func (s *Scope) idleChan() <-chan struct{} {
+	s.trackMu.Lock()
+	defer s.trackMu.Unlock()
+
+	if s.inflight == 0 {
+		idle := make(chan struct{})
+		close(idle)
+		return idle
+	}
+	return s.idle
+}
+
 // Dispose releases resources held by this scope.
 // Calls Dispose() on all instances implementing Disposable interface
-// in reverse creation order (dependencies disposed before dependents).
-// Also disposes all child scopes first.
+// in reverse creation order (dependencies disposed before dependents),
+// Dispose(context.Background()) on those implementing DisposableWithContext,
+// or Teardown() on those implementing TeardownDisposable.
+// Child scopes are disposed first, then OnDispose hooks run.
 //
 // Example:
 //
 //	scope := container.CreateScope()
 //	defer scope.Dispose()
 func (s *Scope) Dispose() error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	if s.disposed {
-		return nil // Already disposed
-	}
-
 	var errors []error
 
 	// First, dispose all child scopes
-	for _, child := range s.children {
+	s.mu.Lock()
+	children := s.children
+	s.children = nil
+	s.mu.Unlock()
+	for _, child := range children {
 		if err := child.Dispose(); err != nil {
 			errors = append(errors, fmt.Errorf("child scope disposal error: %w", err))
 		}
 	}
-	s.children = nil
 
-	// Dispose instances in reverse creation order
+	// Dispose hooks run unlocked so they can still resolve services
+	s.runDisposeHooks()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.disposed {
+		return nil // Already disposed
+	}
+
+	// Dispose instances in reverse creation order. Teardown hooks may look
+	// up instances that still exist but cannot create new ones.
+	resolver := newTeardownResolver(s.parent, s)
 	for i := len(s.creationOrder) - 1; i >= 0; i-- {
 		instance := s.creationOrder[i]
-		if disposable, ok := instance.(Disposable); ok {
-			if err := disposable.Dispose(); err != nil {
-				errors = append(errors, fmt.Errorf("disposal error for %T: %w", instance, err))
-			}
+		if err := disposeInstance(context.Background(), instance, resolver); err != nil {
+			errors = append(errors, fmt.Errorf("disposal error for %T: %w", instance, err))
 		}
 	}
 
-	// Clear instance cache and creation order
-	s.instances = make(map[reflect.Type]interface{})
-	s.creationOrder = nil
-	s.disposed = true
+	s.markDisposed()
 
 	if len(errors) > 0 {
 		return fmt.Errorf("scope disposal encountered %d error(s): %v", len(errors), errors)
@@ -241,3 +505,14 @@ func (s *Scope) Dispose() error {
 
 	return nil
 }
+
+// markDisposed clears the instance cache and creation order and marks the
+// scope disposed. The caller must hold s.mu.
+func (s *Scope) markDisposed() {
+	s.instances = make(map[reflect.Type]interface{})
+	s.named = make(map[namedKey]interface{})
+	s.tagGroups = make(map[string][]interface{})
+	s.creationOrder = nil
+	s.disposed = true
+	s.parent.untrackScope(s)
+}
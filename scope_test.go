@@ -3,7 +3,10 @@ package nasc
 import (
 	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 // Test types for scoping and cleanup
@@ -332,3 +335,100 @@ func TestScoped_PanicsFromRootContainer(t *testing.T) {
 
 	container.Make((*disposableService)(nil))
 }
+
+func TestScope_OverrideSingleton(t *testing.T) {
+	container := New()
+	_ = container.Singleton((*Logger)(nil), &ConsoleLogger{})
+	_ = container.ScopedConstructor((*ConstructorService)(nil), NewServiceWithLogger)
+	global := container.Make((*Logger)(nil))
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+
+	fixed := &FileLogger{}
+	if err := scope.OverrideSingleton((*Logger)(nil), fixed); err != nil {
+		t.Fatalf("OverrideSingleton failed: %v", err)
+	}
+
+	if scope.Make((*Logger)(nil)) != fixed {
+		t.Error("scope should resolve the override")
+	}
+	svc := scope.Make((*ConstructorService)(nil)).(*ConstructorServiceImpl)
+	if svc.Logger != fixed {
+		t.Error("constructor dependencies created by the scope should receive the override")
+	}
+	if scope.CreateChildScope().Make((*Logger)(nil)) != fixed {
+		t.Error("child scope should inherit the override")
+	}
+
+	if container.Make((*Logger)(nil)) != global {
+		t.Error("override must not affect the container")
+	}
+	other := container.CreateScope()
+	defer other.Dispose()
+	if other.Make((*Logger)(nil)) != global {
+		t.Error("override must not affect other scopes")
+	}
+}
+
+func TestScope_OverrideSingletonRejectsNonSingletons(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+
+	if err := scope.OverrideSingleton((*Logger)(nil), &ConsoleLogger{}); err == nil {
+		t.Error("expected error overriding a transient binding")
+	}
+	if err := scope.OverrideSingleton((*Database)(nil), &MockDB{}); err == nil {
+		t.Error("expected error overriding an unbound type")
+	}
+}
+
+// racedService sleeps while initializing so concurrent resolutions overlap.
+type racedService struct {
+	disposed *atomic.Int64
+}
+
+func (r *racedService) Initialize() error {
+	time.Sleep(time.Millisecond)
+	return nil
+}
+
+func (r *racedService) Dispose() error {
+	r.disposed.Add(1)
+	return nil
+}
+
+func TestScoped_RacingResolutionsDisposeLosers(t *testing.T) {
+	var built, disposed atomic.Int64
+	container := New()
+	_ = container.ScopedConstructor((*racedService)(nil), func() *racedService {
+		return &racedService{disposed: &disposed}
+	})
+	container.Subscribe(InstanceCreated, func(e Event) {
+		built.Add(1)
+	})
+
+	scope := container.CreateScope()
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			scope.Make((*racedService)(nil))
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	if err := scope.Dispose(); err != nil {
+		t.Fatalf("Dispose() error = %v", err)
+	}
+	if built.Load() != disposed.Load() {
+		t.Errorf("built %d instances but disposed %d", built.Load(), disposed.Load())
+	}
+}
@@ -0,0 +1,136 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// Bind registers a binding on this scope only, shadowing the container's
+// binding for abstractType for the scope's lifetime. The scope creates one
+// instance on first resolution and disposes it with the scope. Child
+// scopes created afterwards inherit the binding and create their own
+// instance.
+//
+// Scope bindings apply to Make on this scope and to constructor
+// dependencies of instances the scope creates; singletons resolved by the
+// container never see them.
+//
+// Example:
+//
+//	scope := container.CreateScope()
+//	defer scope.Dispose()
+//
+//	scope.Bind((*Logger)(nil), &AuditLogger{})
+func (s *Scope) Bind(abstractType, concreteType interface{}) error {
+	if abstractType == nil {
+		return &InvalidBindingError{Reason: "abstract type cannot be nil"}
+	}
+	if concreteType == nil {
+		return &InvalidBindingError{Reason: "concrete type cannot be nil"}
+	}
+
+	concreteT := reflect.TypeOf(concreteType)
+	if concreteT.Kind() != reflect.Ptr || concreteT.Elem().Kind() != reflect.Struct {
+		return &InvalidBindingError{
+			Reason: fmt.Sprintf("concrete type must be pointer to struct, got %v", concreteT),
+		}
+	}
+
+	return s.bind(&registry.Binding{
+		AbstractType: typeOfToken(abstractType),
+		ConcreteType: concreteT,
+		Lifetime:     string(LifetimeScoped),
+	})
+}
+
+// BindConstructor registers a constructor binding on this scope only (see
+// Bind). The constructor's parameters are resolved from the scope.
+//
+// Example:
+//
+//	scope.BindConstructor((*Logger)(nil), func() *TraceLogger {
+//	    return NewTraceLogger(traceID)
+//	})
+func (s *Scope) BindConstructor(abstractType interface{}, constructor ConstructorFunc) error {
+	if abstractType == nil {
+		return &InvalidBindingError{Reason: "abstract type cannot be nil"}
+	}
+
+	info, err := s.parent.reflectionCache.parseConstructor(constructor)
+	if err != nil {
+		return &InvalidBindingError{Reason: fmt.Sprintf("invalid constructor: %v", err)}
+	}
+
+	return s.bind(&registry.Binding{
+		AbstractType: typeOfToken(abstractType),
+		ConcreteType: info.returnType,
+		Lifetime:     string(LifetimeScoped),
+		Constructor:  info,
+	})
+}
+
+// BindInstance registers an existing instance on this scope only,
+// shadowing the container's binding (see Bind). The scope does not dispose
+// the instance.
+//
+// Example:
+//
+//	scope.BindInstance((*Logger)(nil), logger.With("trace_id", traceID))
+func (s *Scope) BindInstance(abstractType, instance interface{}) error {
+	if abstractType == nil {
+		return &InvalidBindingError{Reason: "abstract type cannot be nil"}
+	}
+	if instance == nil {
+		return &InvalidBindingError{Reason: "instance cannot be nil"}
+	}
+
+	abstractT := typeOfToken(abstractType)
+	if !reflect.TypeOf(instance).AssignableTo(abstractT) {
+		return &InvalidBindingError{
+			Reason: fmt.Sprintf("%T is not assignable to %v", instance, abstractT),
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkBindable(abstractT); err != nil {
+		return err
+	}
+	s.overrides[abstractT] = instance
+	return nil
+}
+
+// bind stores a scope binding.
+func (s *Scope) bind(binding *registry.Binding) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkBindable(binding.AbstractType); err != nil {
+		return err
+	}
+	s.bindings[binding.AbstractType] = binding
+	return nil
+}
+
+// checkBindable reports whether abstractT can still be bound on this scope.
+// Callers must hold s.mu.
+func (s *Scope) checkBindable(abstractT reflect.Type) error {
+	if s.disposed {
+		return fmt.Errorf("cannot bind in disposed scope")
+	}
+	if _, exists := s.bindings[abstractT]; exists {
+		return &BindingAlreadyExistsError{Type: abstractT}
+	}
+	if _, exists := s.overrides[abstractT]; exists {
+		return &BindingAlreadyExistsError{Type: abstractT}
+	}
+	if _, resolved := s.instances[abstractT]; resolved {
+		return &InvalidBindingError{
+			Reason: fmt.Sprintf("%v was already resolved in this scope", abstractT),
+		}
+	}
+	return nil
+}
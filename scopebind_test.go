@@ -0,0 +1,138 @@
+package nasc
+
+import (
+	"errors"
+	"testing"
+)
+
+type traceLogger struct {
+	disposed bool
+}
+
+func (l *traceLogger) Log(message string) {}
+
+func (l *traceLogger) Dispose() error {
+	l.disposed = true
+	return nil
+}
+
+type loggingService struct {
+	logger Logger
+}
+
+func newLoggingService(logger Logger) *loggingService {
+	return &loggingService{logger: logger}
+}
+
+func TestScopeBind_ShadowsParent(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+	_ = container.BindConstructor((*loggingService)(nil), newLoggingService)
+
+	scope := container.CreateScope()
+	if err := scope.Bind((*Logger)(nil), &traceLogger{}); err != nil {
+		t.Fatalf("Bind failed: %v", err)
+	}
+
+	logger := scope.Make((*Logger)(nil))
+	if _, ok := logger.(*traceLogger); !ok {
+		t.Fatalf("scope binding should shadow parent, got %T", logger)
+	}
+	if scope.Make((*Logger)(nil)) != logger {
+		t.Error("scope binding should create one instance per scope")
+	}
+	if svc := scope.Make((*loggingService)(nil)).(*loggingService); svc.logger != logger {
+		t.Error("constructor dependencies should use the scope binding")
+	}
+
+	if _, ok := container.Make((*Logger)(nil)).(*ConsoleLogger); !ok {
+		t.Error("container should be unaffected")
+	}
+	other := container.CreateScope()
+	defer other.Dispose()
+	if _, ok := other.Make((*Logger)(nil)).(*ConsoleLogger); !ok {
+		t.Error("other scopes should be unaffected")
+	}
+
+	_ = scope.Dispose()
+	if !logger.(*traceLogger).disposed {
+		t.Error("scope binding instance should be disposed with the scope")
+	}
+}
+
+func TestScopeBind_UnboundInParent(t *testing.T) {
+	container := New()
+	scope := container.CreateScope()
+	defer scope.Dispose()
+
+	if err := scope.BindConstructor((*Logger)(nil), func() *traceLogger { return &traceLogger{} }); err != nil {
+		t.Fatalf("BindConstructor failed: %v", err)
+	}
+	if _, ok := scope.Make((*Logger)(nil)).(*traceLogger); !ok {
+		t.Error("scope binding should resolve without a parent binding")
+	}
+}
+
+func TestScopeBindInstance(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	scope := container.CreateScope()
+	logger := &traceLogger{}
+	if err := scope.BindInstance((*Logger)(nil), logger); err != nil {
+		t.Fatalf("BindInstance failed: %v", err)
+	}
+
+	child := scope.CreateChildScope()
+	if child.Make((*Logger)(nil)) != logger {
+		t.Error("child scope should inherit bound instances")
+	}
+
+	_ = scope.Dispose()
+	if logger.disposed {
+		t.Error("bound instances should not be disposed by the scope")
+	}
+}
+
+func TestScopeBind_ChildGetsOwnInstance(t *testing.T) {
+	container := New()
+	scope := container.CreateScope()
+	defer scope.Dispose()
+	_ = scope.Bind((*Logger)(nil), &traceLogger{})
+
+	child := scope.CreateChildScope()
+	if child.Make((*Logger)(nil)) == scope.Make((*Logger)(nil)) {
+		t.Error("child scope should create its own instance")
+	}
+}
+
+func TestScopeBind_Errors(t *testing.T) {
+	container := New()
+	_ = container.Scoped((*Logger)(nil), &ConsoleLogger{})
+
+	scope := container.CreateScope()
+
+	var invalid *InvalidBindingError
+	if err := scope.Bind(nil, &traceLogger{}); !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidBindingError for nil type, got %v", err)
+	}
+	if err := scope.BindInstance((*Logger)(nil), "not a logger"); !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidBindingError for unassignable instance, got %v", err)
+	}
+
+	scope.Make((*Logger)(nil))
+	if err := scope.Bind((*Logger)(nil), &traceLogger{}); !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidBindingError after resolution, got %v", err)
+	}
+
+	_ = scope.Bind((*NotificationService)(nil), &SMSNotifier{})
+	var exists *BindingAlreadyExistsError
+	if err := scope.Bind((*NotificationService)(nil), &SMSNotifier{}); !errors.As(err, &exists) {
+		t.Errorf("expected BindingAlreadyExistsError, got %v", err)
+	}
+
+	_ = scope.Dispose()
+	if err := scope.Bind((*Database)(nil), &traceLogger{}); err == nil {
+		t.Error("expected error binding in disposed scope")
+	}
+}
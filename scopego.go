@@ -0,0 +1,48 @@
+package nasc
+
+// Go runs fn in a new goroutine with its own child scope, so background
+// fan-out work inside a request gets isolated scoped instances. The child
+// scope is disposed when fn returns, even if it panics; disposal errors
+// are reported through Errors and WithOnError.
+//
+// The work is tracked on this scope (see Track), so DisposeAllScopes waits
+// for it. Dispose does not wait: callers disposing the scope directly
+// should first wait for the work they started, for example with a
+// sync.WaitGroup. Options configure the child scope as in CreateChildScope.
+//
+// Panics if the scope is disposed.
+//
+// Example:
+//
+//	var wg sync.WaitGroup
+//	for _, id := range ids {
+//	    wg.Add(1)
+//	    scope.Go(func(s *nasc.Scope) {
+//	        defer wg.Done()
+//	        s.Make((*Indexer)(nil)).(Indexer).Index(id)
+//	    })
+//	}
+//	wg.Wait()
+func (s *Scope) Go(fn func(*Scope), options ...ScopeOption) {
+	done := s.Track()
+	created := false
+	defer func() {
+		// Release the work if the child's options panic
+		if !created {
+			done()
+		}
+	}()
+	child := s.CreateChildScope(options...)
+	created = true
+
+	go func() {
+		defer done()
+		defer func() {
+			if err := child.Dispose(); err != nil {
+				s.parent.reportError("dispose", nil, err)
+			}
+		}()
+
+		fn(child)
+	}()
+}
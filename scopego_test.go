@@ -0,0 +1,107 @@
+package nasc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestScope_Go(t *testing.T) {
+	container := New()
+	_ = container.Scoped((*UnitOfWork)(nil), &trackedUnitOfWork{})
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		units []*trackedUnitOfWork
+	)
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		scope.Go(func(s *Scope) {
+			defer wg.Done()
+			if s == scope {
+				t.Error("work should run in a child scope")
+			}
+			uow := s.Make((*UnitOfWork)(nil)).(*trackedUnitOfWork)
+			mu.Lock()
+			units = append(units, uow)
+			mu.Unlock()
+		})
+	}
+	wg.Wait()
+
+	// Disposal happens after fn returns; wait for the tracked work to finish
+	select {
+	case <-scope.idleChan():
+	case <-time.After(time.Second):
+		t.Fatal("tracked work did not finish")
+	}
+
+	seen := make(map[*trackedUnitOfWork]bool)
+	for _, uow := range units {
+		if seen[uow] {
+			t.Error("each goroutine should get its own scoped instances")
+		}
+		seen[uow] = true
+		if !uow.disposed {
+			t.Error("child scope should be disposed when the work returns")
+		}
+	}
+}
+
+type failingUnit struct{ id int }
+
+func (f *failingUnit) Commit() error  { return nil }
+func (f *failingUnit) Dispose() error { return errors.New("rollback failed") }
+
+func TestScope_Go_ReportsDisposalErrors(t *testing.T) {
+	reported := make(chan error, 1)
+	container := New(WithOnError(func(err error) { reported <- err }))
+	_ = container.Scoped((*UnitOfWork)(nil), &failingUnit{})
+
+	scope := container.CreateScope()
+	scope.Go(func(s *Scope) {
+		s.Make((*UnitOfWork)(nil))
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := container.DisposeAllScopes(ctx); err != nil {
+		t.Fatalf("DisposeAllScopes failed: %v", err)
+	}
+
+	select {
+	case err := <-reported:
+		var lifecycle *LifecycleError
+		if !errors.As(err, &lifecycle) || lifecycle.Phase != "dispose" {
+			t.Errorf("expected dispose LifecycleError, got %v", err)
+		}
+	default:
+		t.Error("disposal error should be reported once the work is done")
+	}
+}
+
+func TestScope_Go_ReleasesWorkWhenOptionsPanic(t *testing.T) {
+	container := New()
+	scope := container.CreateScope()
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected a panic inheriting an unbound type")
+			}
+		}()
+		scope.Go(func(*Scope) {}, InheritScoped((*UnitOfWork)(nil)))
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := container.DisposeAllScopes(ctx); err != nil {
+		t.Errorf("DisposeAllScopes() error = %v, want the failed work released", err)
+	}
+}
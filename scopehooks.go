@@ -0,0 +1,76 @@
+package nasc
+
+// OnScopeCreated registers a callback that runs for every new scope,
+// including child scopes, once the scope is ready for use. Callbacks run in
+// registration order on the goroutine that created the scope.
+//
+// Use it to attach request IDs, start spans or register OnDispose hooks
+// without wrapping every CreateScope call site.
+//
+// Example:
+//
+//	container.OnScopeCreated(func(s *nasc.Scope) {
+//	    ctx, span := tracer.Start(s.Context(), "scope")
+//	    s.OnDispose(func() { span.End() })
+//	    _ = ctx
+//	})
+func (n *Nasc) OnScopeCreated(fn func(*Scope)) {
+	if fn == nil {
+		return
+	}
+
+	n.scopeHookMu.Lock()
+	defer n.scopeHookMu.Unlock()
+
+	n.scopeHooks = append(n.scopeHooks, fn)
+}
+
+// scopeCreated runs the OnScopeCreated callbacks for a new scope.
+func (n *Nasc) scopeCreated(scope *Scope) {
+	n.scopeHookMu.Lock()
+	hooks := append([]func(*Scope){}, n.scopeHooks...)
+	n.scopeHookMu.Unlock()
+
+	for _, hook := range hooks {
+		hook(scope)
+	}
+	n.emit(Event{Kind: ScopeCreated, Scope: scope})
+}
+
+// OnDispose registers a callback that runs when the scope is disposed,
+// after its child scopes are disposed but before its own instances, so the
+// callback can still use scoped services. Callbacks run in reverse
+// registration order, like deferred calls.
+//
+// Panics if the scope is already disposed.
+//
+// Example:
+//
+//	buffer := scope.Make((*AuditBuffer)(nil)).(AuditBuffer)
+//	scope.OnDispose(func() { buffer.Flush() })
+func (s *Scope) OnDispose(fn func()) {
+	if fn == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.disposed {
+		panic("cannot register dispose hook on disposed scope")
+	}
+	s.disposeHooks = append(s.disposeHooks, fn)
+}
+
+// runDisposeHooks runs and clears the OnDispose callbacks. It must be
+// called without holding s.mu so callbacks can resolve from the scope.
+func (s *Scope) runDisposeHooks() {
+	s.mu.Lock()
+	hooks := s.disposeHooks
+	s.disposeHooks = nil
+	s.mu.Unlock()
+
+	for i := len(hooks) - 1; i >= 0; i-- {
+		hooks[i]()
+	}
+}
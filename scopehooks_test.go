@@ -0,0 +1,69 @@
+package nasc
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+type hookKey struct{}
+
+func TestOnScopeCreated(t *testing.T) {
+	container := New(WithScopeValue("request-id", ContextValue(hookKey{})))
+
+	var created []*Scope
+	var requestIDs []interface{}
+	container.OnScopeCreated(func(s *Scope) {
+		created = append(created, s)
+		id, _ := s.Value("request-id")
+		requestIDs = append(requestIDs, id)
+	})
+
+	plain := container.CreateScope()
+	defer plain.Dispose()
+	withCtx := container.CreateScopeWithContext(context.WithValue(context.Background(), hookKey{}, "req-7"))
+	defer withCtx.Dispose()
+	child := withCtx.CreateChildScope()
+
+	if want := []*Scope{plain, withCtx, child}; !reflect.DeepEqual(created, want) {
+		t.Fatalf("hook saw %d scopes, want 3", len(created))
+	}
+	if want := []interface{}{nil, "req-7", "req-7"}; !reflect.DeepEqual(requestIDs, want) {
+		t.Errorf("hook should see scope values, got %v", requestIDs)
+	}
+}
+
+func TestScope_OnDispose(t *testing.T) {
+	container := New()
+	_ = container.Scoped((*Logger)(nil), &ConsoleLogger{})
+
+	scope := container.CreateScope()
+	child := scope.CreateChildScope()
+
+	var order []string
+	child.OnDispose(func() { order = append(order, "child") })
+	scope.OnDispose(func() { order = append(order, "first") })
+	scope.OnDispose(func() {
+		// Scoped services are still available to hooks
+		scope.Make((*Logger)(nil)).(Logger).Log("flushing")
+		order = append(order, "second")
+	})
+
+	if err := scope.Dispose(); err != nil {
+		t.Fatalf("Dispose failed: %v", err)
+	}
+	if want := []string{"child", "second", "first"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("hook order = %v, want %v", order, want)
+	}
+
+	if err := scope.Dispose(); err != nil || len(order) != 3 {
+		t.Error("hooks should run only once")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("OnDispose on a disposed scope should panic")
+		}
+	}()
+	scope.OnDispose(func() {})
+}
@@ -0,0 +1,45 @@
+package nasc
+
+import "reflect"
+
+// InheritScoped makes a child scope share its parent's instances of the
+// given types instead of creating its own, so nested operations can reuse
+// the parent's transaction while every other scoped service stays
+// isolated. The parent creates the instance if it has not yet, and remains
+// responsible for disposing it. Grandchildren inherit shared instances.
+//
+// The option only applies to CreateChildScope; scopes created from the
+// container have no parent scope to share with.
+//
+// Example:
+//
+//	uow := request.Make((*UnitOfWork)(nil)).(UnitOfWork)
+//
+//	step := request.CreateChildScope(nasc.InheritScoped((*UnitOfWork)(nil)))
+//	defer step.Dispose()
+//	step.Make((*UnitOfWork)(nil)) // same instance as uow
+func InheritScoped(abstractTypes ...interface{}) ScopeOption {
+	types := make([]reflect.Type, 0, len(abstractTypes))
+	for _, abstractType := range abstractTypes {
+		if abstractType != nil {
+			types = append(types, typeOfToken(abstractType))
+		}
+	}
+
+	return func(s *Scope) {
+		s.inherit = append(s.inherit, types...)
+	}
+}
+
+// shareInherited resolves the child's inherited types from this scope and
+// installs them as overrides, which the child does not dispose.
+func (s *Scope) shareInherited(child *Scope) {
+	for _, t := range child.inherit {
+		instance := s.Make(reflect.Zero(reflect.PointerTo(t)).Interface())
+
+		child.mu.Lock()
+		child.overrides[t] = instance
+		child.mu.Unlock()
+	}
+	child.inherit = nil
+}
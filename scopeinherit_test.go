@@ -0,0 +1,62 @@
+package nasc
+
+import "testing"
+
+type UnitOfWork interface {
+	Commit() error
+}
+
+type trackedUnitOfWork struct {
+	disposed bool
+}
+
+func (u *trackedUnitOfWork) Commit() error { return nil }
+func (u *trackedUnitOfWork) Dispose() error {
+	u.disposed = true
+	return nil
+}
+
+func TestInheritScoped(t *testing.T) {
+	container := New()
+	_ = container.Scoped((*UnitOfWork)(nil), &trackedUnitOfWork{})
+	_ = container.Scoped((*Logger)(nil), &ConsoleLogger{})
+
+	parent := container.CreateScope()
+	child := parent.CreateChildScope(InheritScoped((*UnitOfWork)(nil)))
+	grandchild := child.CreateChildScope()
+
+	uow := parent.Make((*UnitOfWork)(nil))
+	if child.Make((*UnitOfWork)(nil)) != uow || grandchild.Make((*UnitOfWork)(nil)) != uow {
+		t.Error("inherited scoped instances should be shared with descendants")
+	}
+	if child.Make((*Logger)(nil)) == parent.Make((*Logger)(nil)) {
+		t.Error("types not inherited should stay isolated")
+	}
+
+	if err := child.Dispose(); err != nil {
+		t.Fatalf("child Dispose failed: %v", err)
+	}
+	if uow.(*trackedUnitOfWork).disposed {
+		t.Error("child scope should not dispose an inherited instance")
+	}
+
+	if err := parent.Dispose(); err != nil {
+		t.Fatalf("parent Dispose failed: %v", err)
+	}
+	if !uow.(*trackedUnitOfWork).disposed {
+		t.Error("parent scope should dispose the shared instance")
+	}
+}
+
+func TestInheritScoped_CreatesInParent(t *testing.T) {
+	container := New()
+	_ = container.Scoped((*UnitOfWork)(nil), &trackedUnitOfWork{})
+
+	parent := container.CreateScope()
+	defer parent.Dispose()
+
+	child := parent.CreateChildScope(InheritScoped((*UnitOfWork)(nil)))
+	if child.Make((*UnitOfWork)(nil)) != parent.Make((*UnitOfWork)(nil)) {
+		t.Error("the parent should create the shared instance on demand")
+	}
+}
@@ -0,0 +1,161 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// namedKey identifies a named or tagged binding within a scope.
+type namedKey struct {
+	t    reflect.Type
+	name string
+}
+
+// MakeNamed resolves a named binding within this scope. Scoped named
+// bindings are created once per scope; singleton and factory bindings are
+// delegated to the parent container.
+//
+// Example:
+//
+//	primary := scope.MakeNamed((*Database)(nil), "primary").(Database)
+func (s *Scope) MakeNamed(abstractType interface{}, name string) interface{} {
+	if abstractType == nil {
+		panic("cannot resolve nil type")
+	}
+	if name == "" {
+		panic("name cannot be empty")
+	}
+	if !s.parent.hasMiddleware() {
+		return s.makeNamedDirect(abstractType, name)
+	}
+	return s.parent.mustIntercept(Resolution{Type: typeOfToken(abstractType), Name: name, Scope: s, Context: s.Context()})
+}
+
+// makeNamedDirect is MakeNamed without resolution middleware.
+func (s *Scope) makeNamedDirect(abstractType interface{}, name string) interface{} {
+	if abstractType == nil {
+		panic("cannot resolve nil type")
+	}
+	if name == "" {
+		panic("name cannot be empty")
+	}
+	s.checkNotDisposed()
+
+	abstractT := typeOfToken(abstractType)
+	if err := s.parent.loadLazyProvider(abstractT); err != nil {
+		panic(fmt.Sprintf("failed to resolve named binding '%s' for type %v: %v", name, abstractT, err))
+	}
+	binding, err := s.parent.registry.GetNamed(abstractT, name)
+	if err != nil {
+		if instance, ok, ferr := s.parent.resolveUnbound(abstractT, name); ok {
+			if ferr != nil {
+				panic(fmt.Sprintf("failed to resolve named binding '%s' for type %v: %v", name, abstractT, ferr))
+			}
+			return instance
+		}
+		panic(fmt.Sprintf("named binding '%s' not found for type %v: %v", name, abstractT, err))
+	}
+	return s.makeBinding(binding)
+}
+
+// MakeAll resolves every implementation of abstractType within this
+// scope, in the order of the container's MakeAll. The default binding is
+// resolved as Make on this scope would, so scope bindings and overrides
+// apply to it.
+//
+// Example:
+//
+//	for _, h := range scope.MakeAll((*Handler)(nil)) {
+//	    h.(Handler).Handle(req)
+//	}
+func (s *Scope) MakeAll(abstractType interface{}) []interface{} {
+	if abstractType == nil {
+		panic("cannot resolve nil type")
+	}
+	s.checkNotDisposed()
+
+	abstractT := typeOfToken(abstractType)
+	if err := s.parent.loadLazyProvider(abstractT); err != nil {
+		panic(fmt.Sprintf("failed to resolve type %v: %v", abstractT, err))
+	}
+	bindings := s.parent.registry.GetAll(abstractT)
+	instances := make([]interface{}, 0, len(bindings)+1)
+
+	s.mu.RLock()
+	_, local := s.bindings[abstractT]
+	_, overridden := s.overrides[abstractT]
+	s.mu.RUnlock()
+
+	hasDefault := false
+	for _, binding := range bindings {
+		if binding.Name == "" {
+			hasDefault = true
+		}
+	}
+	if !hasDefault && (local || overridden) {
+		instances = append(instances, s.Make(abstractType))
+	}
+	for _, binding := range bindings {
+		if binding.Name == "" {
+			instances = append(instances, s.Make(abstractType))
+		} else {
+			instances = append(instances, s.makeBinding(binding))
+		}
+	}
+	return instances
+}
+
+// checkNotDisposed panics if the scope has been disposed.
+func (s *Scope) checkNotDisposed() {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.disposed {
+		panic("cannot resolve from disposed scope")
+	}
+}
+
+// makeBinding resolves a named or tagged binding within this scope.
+// Scoped instances are cached by type and name.
+func (s *Scope) makeBinding(binding *registry.Binding) interface{} {
+	abstractT := binding.AbstractType
+	s.spendResolution(abstractT)
+
+	var instance interface{}
+	switch Lifetime(binding.Lifetime) {
+	case LifetimeScoped:
+		key := namedKey{t: abstractT, name: binding.Name}
+
+		s.mu.RLock()
+		existing, exists := s.named[key]
+		s.mu.RUnlock()
+		if exists {
+			return existing
+		}
+
+		created := s.createInstance(binding, abstractT)
+
+		s.mu.Lock()
+		instance, exists = s.named[key]
+		if !exists {
+			instance = created
+			s.named[key] = instance
+			s.creationOrder = append(s.creationOrder, instance)
+		}
+		s.mu.Unlock()
+		if exists {
+			s.discard(created, instance)
+		}
+
+	case LifetimeTransient:
+		instance = s.createInstance(binding, abstractT)
+		s.trackTransient(instance)
+
+	default:
+		return s.parent.createInstanceFromBinding(binding, abstractT)
+	}
+
+	return instance
+}
@@ -0,0 +1,166 @@
+package nasc
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestScopeMakeNamed_Scoped(t *testing.T) {
+	container := New()
+	_ = As[Logger, middlewareA](container, WithName("request"), WithLifetime(LifetimeScoped))
+	_ = As[Logger, middlewareB](container, WithName("audit"), WithLifetime(LifetimeScoped))
+
+	scope := container.CreateScope()
+	request := scope.MakeNamed((*Logger)(nil), "request")
+	if scope.MakeNamed((*Logger)(nil), "request") != request {
+		t.Error("scoped named binding should be cached per scope")
+	}
+	if _, ok := scope.MakeNamed((*Logger)(nil), "audit").(*middlewareB); !ok {
+		t.Error("names should be cached separately")
+	}
+
+	other := container.CreateScope()
+	defer other.Dispose()
+	if other.MakeNamed((*Logger)(nil), "request") == request {
+		t.Error("scoped named binding should not be shared between scopes")
+	}
+
+	_ = scope.Dispose()
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic resolving from disposed scope")
+		}
+	}()
+	scope.MakeNamed((*Logger)(nil), "request")
+}
+
+func TestScopeMakeNamed_DelegatesSingletons(t *testing.T) {
+	container := New()
+	_ = As[Logger, middlewareA](container, WithName("shared"), WithLifetime(LifetimeSingleton))
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+
+	if scope.MakeNamed((*Logger)(nil), "shared") != container.MakeNamed((*Logger)(nil), "shared") {
+		t.Error("named singletons should come from the parent")
+	}
+}
+
+func TestScopeMakeNamed_NotFound(t *testing.T) {
+	scope := New().CreateScope()
+	defer scope.Dispose()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for missing named binding")
+		}
+	}()
+	scope.MakeNamed((*Logger)(nil), "missing")
+}
+
+func TestScopeMakeAll(t *testing.T) {
+	container := New()
+	_ = container.Scoped((*Logger)(nil), &ConsoleLogger{})
+	_ = As[Logger, middlewareA](container, WithName("request"), WithLifetime(LifetimeScoped))
+	_ = As[Logger, middlewareB](container, WithTags("extra"))
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+
+	all := scope.MakeAll((*Logger)(nil))
+	if len(all) != 3 {
+		t.Fatalf("expected 3 loggers, got %d", len(all))
+	}
+	if all[0] != scope.Make((*Logger)(nil)) {
+		t.Error("default binding should share the scope's instance")
+	}
+	if all[1] != scope.MakeNamed((*Logger)(nil), "request") {
+		t.Error("named binding should share the scope's instance")
+	}
+}
+
+func TestScopeMakeAll_ScopeBinding(t *testing.T) {
+	container := New()
+	_ = As[Logger, middlewareA](container, WithName("request"))
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+	_ = scope.Bind((*Logger)(nil), &traceLogger{})
+
+	all := scope.MakeAll((*Logger)(nil))
+	if len(all) != 2 {
+		t.Fatalf("expected 2 loggers, got %d", len(all))
+	}
+	if _, ok := all[0].(*traceLogger); !ok {
+		t.Errorf("scope binding should come first, got %T", all[0])
+	}
+}
+
+func TestScopeMakeAll_PrioritizedNamedBinding(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+	_ = As[Logger, middlewareA](container, WithName("first"), WithPriority(10))
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+
+	all := scope.MakeAll((*Logger)(nil))
+	if len(all) != len(container.MakeAll((*Logger)(nil))) || len(all) != 2 {
+		t.Fatalf("expected the container's 2 loggers, got %d", len(all))
+	}
+	if _, ok := all[0].(*middlewareA); !ok {
+		t.Errorf("higher-priority named binding should come first, got %T", all[0])
+	}
+	if _, ok := all[1].(*ConsoleLogger); !ok {
+		t.Errorf("default binding should follow it, got %T", all[1])
+	}
+}
+
+// racedNamedDisposals counts disposals of racedNamed instances.
+var racedNamedDisposals atomic.Int64
+
+// racedNamed sleeps while initializing so concurrent resolutions overlap.
+type racedNamed struct{ _ int }
+
+func (r *racedNamed) Initialize() error {
+	time.Sleep(time.Millisecond)
+	return nil
+}
+
+func (r *racedNamed) Dispose() error {
+	racedNamedDisposals.Add(1)
+	return nil
+}
+
+func TestScopeMakeNamed_RacingResolutionsDisposeLosers(t *testing.T) {
+	racedNamedDisposals.Store(0)
+	var built atomic.Int64
+	container := New()
+	_ = As[Disposable, racedNamed](container, WithName("raced"), WithLifetime(LifetimeScoped))
+	container.Subscribe(InstanceCreated, func(e Event) {
+		built.Add(1)
+	})
+
+	scope := container.CreateScope()
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			scope.MakeNamed((*Disposable)(nil), "raced")
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	if err := scope.Dispose(); err != nil {
+		t.Fatalf("Dispose() error = %v", err)
+	}
+	if built.Load() != racedNamedDisposals.Load() {
+		t.Errorf("built %d instances but disposed %d", built.Load(), racedNamedDisposals.Load())
+	}
+}
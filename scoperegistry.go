@@ -0,0 +1,130 @@
+package nasc
+
+import (
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// ScopeOption configures a scope created by CreateScope or
+// CreateScopeWithContext.
+type ScopeOption func(*Scope)
+
+// ScopeName names a scope so it can be found with FindScope and identified
+// in LiveScopes. Names should be unique among live scopes, such as a
+// request or connection ID.
+//
+// Example:
+//
+//	scope := container.CreateScope(nasc.ScopeName("request-42"))
+func ScopeName(name string) ScopeOption {
+	return func(s *Scope) {
+		s.name = name
+	}
+}
+
+// Name returns the name given with ScopeName, or "" for unnamed scopes.
+func (s *Scope) Name() string {
+	return s.name
+}
+
+// ScopeInfo describes a live scope.
+type ScopeInfo struct {
+	// Name is the scope name; empty for unnamed scopes
+	Name string
+
+	// Created is when the scope was created
+	Created time.Time
+
+	// Instances lists the types of the scoped instances the scope holds, sorted
+	Instances []reflect.Type
+
+	// Children is the number of child scopes created from the scope
+	Children int
+}
+
+// FindScope returns the live scope created with the given name. If several
+// live scopes share the name, the most recently created one is returned.
+// Child scopes are not tracked and cannot be found.
+//
+// Example:
+//
+//	if scope, ok := container.FindScope("request-42"); ok {
+//	    log.Println(scope.Context().Err())
+//	}
+func (n *Nasc) FindScope(name string) (*Scope, bool) {
+	n.scopesMu.Lock()
+	defer n.scopesMu.Unlock()
+
+	var found *Scope
+	for scope := range n.openScopes {
+		if scope.name == name && (found == nil || scope.seq > found.seq) {
+			found = scope
+		}
+	}
+	return found, found != nil
+}
+
+// LiveScopes describes every scope created by CreateScope that has not
+// been disposed yet, oldest first.
+//
+// Example:
+//
+//	for _, info := range container.LiveScopes() {
+//	    fmt.Printf("%s open for %v, holding %v\n", info.Name, time.Since(info.Created), info.Instances)
+//	}
+func (n *Nasc) LiveScopes() []ScopeInfo {
+	n.scopesMu.Lock()
+	scopes := make([]*Scope, 0, len(n.openScopes))
+	for scope := range n.openScopes {
+		scopes = append(scopes, scope)
+	}
+	n.scopesMu.Unlock()
+
+	sort.Slice(scopes, func(i, j int) bool {
+		return scopes[i].seq < scopes[j].seq
+	})
+
+	infos := make([]ScopeInfo, 0, len(scopes))
+	for _, scope := range scopes {
+		if info, ok := scope.info(); ok {
+			infos = append(infos, info)
+		}
+	}
+	return infos
+}
+
+// info describes the scope, reporting false once it is disposed.
+func (s *Scope) info() (ScopeInfo, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.disposed {
+		return ScopeInfo{}, false
+	}
+
+	seen := make(map[reflect.Type]bool)
+	for t := range s.instances {
+		seen[t] = true
+	}
+	for key := range s.named {
+		seen[key.t] = true
+	}
+
+	types := make([]reflect.Type, 0, len(seen))
+	for t := range seen {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return registry.TypeLess(types[i], types[j])
+	})
+
+	return ScopeInfo{
+		Name:      s.name,
+		Created:   s.created,
+		Instances: types,
+		Children:  len(s.children),
+	}, true
+}
@@ -0,0 +1,74 @@
+package nasc
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestCreateScope_Named(t *testing.T) {
+	container := New()
+
+	unnamed := container.CreateScope()
+	defer unnamed.Dispose()
+	first := container.CreateScope(ScopeName("request-42"))
+	second := container.CreateScopeWithContext(context.Background(), ScopeName("request-42"))
+
+	if unnamed.Name() != "" || first.Name() != "request-42" {
+		t.Errorf("names = %q, %q", unnamed.Name(), first.Name())
+	}
+
+	if found, ok := container.FindScope("request-42"); !ok || found != second {
+		t.Error("FindScope should return the most recent scope with the name")
+	}
+
+	_ = second.Dispose()
+	if found, ok := container.FindScope("request-42"); !ok || found != first {
+		t.Error("FindScope should skip disposed scopes")
+	}
+
+	_ = first.Dispose()
+	if _, ok := container.FindScope("request-42"); ok {
+		t.Error("FindScope should not find disposed scopes")
+	}
+}
+
+func TestLiveScopes(t *testing.T) {
+	container := New()
+	_ = container.Scoped((*Logger)(nil), &ConsoleLogger{})
+	_ = container.Scoped((*Database)(nil), &MockDB{})
+
+	idle := container.CreateScope(ScopeName("idle"))
+	defer idle.Dispose()
+	busy := container.CreateScope(ScopeName("busy"))
+	busy.Make((*Logger)(nil))
+	busy.Make((*Database)(nil))
+	busy.CreateChildScope()
+
+	disposed := container.CreateScope(ScopeName("gone"))
+	_ = disposed.Dispose()
+
+	infos := container.LiveScopes()
+	if len(infos) != 2 {
+		t.Fatalf("expected 2 live scopes, got %d", len(infos))
+	}
+	if infos[0].Name != "idle" || infos[1].Name != "busy" {
+		t.Errorf("scopes should be listed oldest first, got %q, %q", infos[0].Name, infos[1].Name)
+	}
+	if len(infos[0].Instances) != 0 {
+		t.Errorf("idle scope should hold nothing, got %v", infos[0].Instances)
+	}
+
+	want := []reflect.Type{reflect.TypeOf((*Database)(nil)).Elem(), reflect.TypeOf((*Logger)(nil)).Elem()}
+	if !reflect.DeepEqual(infos[1].Instances, want) {
+		t.Errorf("busy scope instances = %v, want %v", infos[1].Instances, want)
+	}
+	if infos[1].Children != 1 {
+		t.Errorf("busy scope children = %d, want 1", infos[1].Children)
+	}
+	if infos[1].Created.Before(infos[0].Created) {
+		t.Error("creation times should be recorded")
+	}
+
+	_ = busy.Dispose()
+}
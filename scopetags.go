@@ -0,0 +1,121 @@
+package nasc
+
+import (
+	"fmt"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// WithScopeTagCache makes scopes memoize Scope.MakeWithTag, so a tag group
+// such as a middleware or plugin chain is materialized once per scope and
+// reused until the scope is disposed. Without tags every tag group is
+// cached; otherwise only the listed tags are.
+//
+// Example:
+//
+//	container := nasc.New(nasc.WithScopeTagCache("middleware"))
+func WithScopeTagCache(tags ...string) Option {
+	return func(n *Nasc) error {
+		n.scopeTagCache = &tagCachePolicy{tags: make(map[string]bool, len(tags))}
+		for _, tag := range tags {
+			if tag == "" {
+				return fmt.Errorf("cached tag cannot be empty")
+			}
+			n.scopeTagCache.tags[tag] = true
+		}
+		return nil
+	}
+}
+
+// tagCachePolicy selects the tag groups scopes memoize.
+type tagCachePolicy struct {
+	tags map[string]bool // empty means every tag
+}
+
+// caches reports whether the policy memoizes a tag group. A nil policy
+// memoizes nothing.
+func (p *tagCachePolicy) caches(tag string) bool {
+	return p != nil && (len(p.tags) == 0 || p.tags[tag])
+}
+
+// MakeWithTag resolves every binding with the given tag within this scope.
+// Scoped bindings in the group are created once per scope; singleton and
+// factory bindings are delegated to the parent container. With
+// WithScopeTagCache, the whole group is memoized for the scope's lifetime.
+// Concurrent first calls may each build the group, but all of them return
+// the instances stored by the first to finish.
+//
+// Example:
+//
+//	for _, m := range scope.MakeWithTag("middleware") {
+//	    handler = m.(Middleware).Wrap(handler)
+//	}
+func (s *Scope) MakeWithTag(tag string) []interface{} {
+	if tag == "" {
+		panic("tag cannot be empty")
+	}
+
+	cache := s.parent.scopeTagCache.caches(tag)
+
+	s.mu.RLock()
+	if s.disposed {
+		s.mu.RUnlock()
+		panic("cannot resolve from disposed scope")
+	}
+	group, cached := s.tagGroups[tag]
+	s.mu.RUnlock()
+
+	if !cached {
+		bindings := s.parent.registry.GetByTag(tag)
+		group = make([]interface{}, 0, len(bindings))
+		for _, binding := range bindings {
+			group = append(group, s.makeBinding(binding))
+		}
+
+		if cache {
+			s.mu.Lock()
+			if existing, ok := s.tagGroups[tag]; ok {
+				group = existing
+			} else {
+				s.tagGroups[tag] = group
+			}
+			s.mu.Unlock()
+		}
+	}
+
+	// Callers may reorder or filter the result without affecting the cache
+	return append([]interface{}(nil), group...)
+}
+
+// MakeWithTagExpr resolves every binding whose tags satisfy a tag
+// expression within this scope, like Nasc.MakeWithTagExpr. Scoped bindings
+// in the group are created once per scope. Groups selected by expression
+// are never memoized.
+//
+// Panics if the expression is invalid.
+//
+// Example:
+//
+//	for _, m := range scope.MakeWithTagExpr("middleware && !debug") {
+//	    handler = m.(Middleware).Wrap(handler)
+//	}
+func (s *Scope) MakeWithTagExpr(expr string) []interface{} {
+	parsed, err := registry.ParseTagExpr(expr)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	s.mu.RLock()
+	disposed := s.disposed
+	s.mu.RUnlock()
+	if disposed {
+		panic("cannot resolve from disposed scope")
+	}
+
+	bindings := s.parent.registry.GetByTagExpr(parsed)
+	group := make([]interface{}, 0, len(bindings))
+	for _, binding := range bindings {
+		group = append(group, s.makeBinding(binding))
+	}
+	return group
+}
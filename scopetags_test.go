@@ -0,0 +1,132 @@
+package nasc
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+type middlewareA struct{ calls int }
+
+func (m *middlewareA) Log(message string) {}
+
+type middlewareB struct{ calls int }
+
+func (m *middlewareB) Log(message string) {}
+
+func TestScopeMakeWithTag_Uncached(t *testing.T) {
+	container := New()
+	_ = As[Logger, middlewareA](container, WithTags("middleware"))
+	_ = As[Logger, middlewareB](container, WithTags("middleware"), WithLifetime(LifetimeScoped))
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+
+	first := scope.MakeWithTag("middleware")
+	second := scope.MakeWithTag("middleware")
+	if len(first) != 2 || len(second) != 2 {
+		t.Fatalf("expected 2 instances, got %d and %d", len(first), len(second))
+	}
+	if first[0] == second[0] {
+		t.Error("transients should be re-created without tag caching")
+	}
+	if first[1] != second[1] {
+		t.Error("scoped bindings in a tag group should be created once per scope")
+	}
+
+	other := container.CreateScope()
+	defer other.Dispose()
+	if other.MakeWithTag("middleware")[1] == first[1] {
+		t.Error("scoped bindings in a tag group should not be shared between scopes")
+	}
+}
+
+func TestScopeMakeWithTag_Cached(t *testing.T) {
+	container := New(WithScopeTagCache())
+	_ = As[Logger, middlewareA](container, WithTags("middleware"))
+
+	scope := container.CreateScope()
+	first := scope.MakeWithTag("middleware")
+	second := scope.MakeWithTag("middleware")
+	if first[0] != second[0] {
+		t.Error("tag group should be memoized for the scope")
+	}
+
+	first[0] = nil
+	if scope.MakeWithTag("middleware")[0] == nil {
+		t.Error("modifying the result should not affect the cache")
+	}
+
+	other := container.CreateScope()
+	defer other.Dispose()
+	if other.MakeWithTag("middleware")[0] == second[0] {
+		t.Error("each scope should materialize its own group")
+	}
+
+	_ = scope.Dispose()
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic resolving from disposed scope")
+		}
+	}()
+	scope.MakeWithTag("middleware")
+}
+
+func TestScopeMakeWithTag_SelectedTags(t *testing.T) {
+	container := New(WithScopeTagCache("middleware"))
+	_ = As[Logger, middlewareA](container, WithTags("middleware"))
+	_ = As[Logger, middlewareB](container, WithTags("plugin"))
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+
+	if scope.MakeWithTag("middleware")[0] != scope.MakeWithTag("middleware")[0] {
+		t.Error("listed tag should be memoized")
+	}
+	if scope.MakeWithTag("plugin")[0] == scope.MakeWithTag("plugin")[0] {
+		t.Error("unlisted tag should not be memoized")
+	}
+}
+
+func TestScopeMakeWithTag_Singletons(t *testing.T) {
+	container := New()
+	_ = As[Logger, middlewareA](container, WithTags("middleware"), WithLifetime(LifetimeSingleton))
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+
+	if scope.MakeWithTag("middleware")[0] != container.MakeWithTag("middleware")[0] {
+		t.Error("singletons in a tag group should come from the parent")
+	}
+}
+
+func TestScopeMakeWithTag_RacingResolutionsDisposeLosers(t *testing.T) {
+	racedNamedDisposals.Store(0)
+	var built atomic.Int64
+	container := New(WithScopeTagCache("raced"))
+	_ = As[Disposable, racedNamed](container, WithName("raced"), WithTags("raced"), WithLifetime(LifetimeScoped))
+	container.Subscribe(InstanceCreated, func(e Event) {
+		built.Add(1)
+	})
+
+	scope := container.CreateScope()
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			scope.MakeWithTag("raced")
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	if err := scope.Dispose(); err != nil {
+		t.Fatalf("Dispose() error = %v", err)
+	}
+	if built.Load() != racedNamedDisposals.Load() {
+		t.Errorf("built %d instances but disposed %d", built.Load(), racedNamedDisposals.Load())
+	}
+}
@@ -0,0 +1,133 @@
+package nasc
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// ScopeValueExtractor reads one value from the context a scope is created
+// with, reporting false if the context does not carry it.
+type ScopeValueExtractor func(ctx context.Context) (interface{}, bool)
+
+// WithScopeValue registers a value extracted from the context of every
+// scope created with CreateScopeWithContext. Services created by the scope
+// receive it in fields tagged `inject:"scope=<key>"`, so correlation IDs
+// such as trace, span or request IDs reach every scoped service without
+// manual plumbing.
+//
+// Example:
+//
+//	container := nasc.New(
+//	    nasc.WithScopeValue("traceID", nasc.ContextValue(traceIDKey{})),
+//	)
+//
+//	type AuditLog struct {
+//	    TraceID string `inject:"scope=traceID"`
+//	}
+func WithScopeValue(key string, extract ScopeValueExtractor) Option {
+	return func(n *Nasc) error {
+		if key == "" {
+			return fmt.Errorf("scope value key cannot be empty")
+		}
+		if extract == nil {
+			return fmt.Errorf("scope value extractor for %q cannot be nil", key)
+		}
+		if n.scopeValues == nil {
+			n.scopeValues = make(map[string]ScopeValueExtractor)
+		}
+		n.scopeValues[key] = extract
+		return nil
+	}
+}
+
+// ContextValue returns an extractor reading ctx.Value(ctxKey).
+func ContextValue(ctxKey interface{}) ScopeValueExtractor {
+	return func(ctx context.Context) (interface{}, bool) {
+		value := ctx.Value(ctxKey)
+		return value, value != nil
+	}
+}
+
+// CreateScopeWithContext creates a scope for work driven by ctx, typically
+// an incoming request. Values registered with WithScopeValue are extracted
+// from ctx and injected into services the scope creates.
+//
+// Example:
+//
+//	func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+//	    scope := h.container.CreateScopeWithContext(r.Context())
+//	    defer scope.Dispose()
+//	    // ...
+//	}
+func (n *Nasc) CreateScopeWithContext(ctx context.Context, options ...ScopeOption) *Scope {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	scope := newScope(n)
+	for _, option := range options {
+		option(scope)
+	}
+	scope.ctx = ctx
+	for key, extract := range n.scopeValues {
+		if value, ok := extract(ctx); ok {
+			scope.values[key] = value
+		}
+	}
+	n.trackScope(scope)
+	n.scopeCreated(scope)
+	return scope
+}
+
+// Context returns the context the scope was created with, or
+// context.Background() for scopes created by CreateScope.
+func (s *Scope) Context() context.Context {
+	return s.ctx
+}
+
+// Value returns a scope value extracted by WithScopeValue.
+func (s *Scope) Value(key string) (interface{}, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	value, ok := s.values[key]
+	return value, ok
+}
+
+// injectScopeValues sets the `inject:"scope=<key>"` fields of an instance
+// created by this scope. Fields whose value the scope's context did not
+// carry are left untouched; a key never registered with WithScopeValue is
+// an error unless the field is optional.
+func (s *Scope) injectScopeValues(instance interface{}) error {
+	value := reflect.ValueOf(instance)
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+
+	for _, field := range s.parent.getInjectableFields(value) {
+		key := field.options.scopeKey
+		if key == "" {
+			continue
+		}
+		if _, registered := s.parent.scopeValues[key]; !registered && !field.options.optional {
+			return fmt.Errorf("field %s: scope value %q is not registered with WithScopeValue", field.field.Name, key)
+		}
+
+		scopeValue, ok := s.Value(key)
+		if !ok {
+			continue
+		}
+
+		v := reflect.ValueOf(scopeValue)
+		switch {
+		case v.Type().AssignableTo(field.fieldType):
+			field.fieldValue.Set(v)
+		case v.Type().ConvertibleTo(field.fieldType) && v.Kind() == field.fieldType.Kind():
+			field.fieldValue.Set(v.Convert(field.fieldType))
+		default:
+			return fmt.Errorf("field %s: scope value %q of type %T is not assignable to %v", field.field.Name, key, scopeValue, field.fieldType)
+		}
+	}
+	return nil
+}
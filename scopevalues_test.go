@@ -0,0 +1,78 @@
+package nasc
+
+import (
+	"context"
+	"testing"
+)
+
+type traceIDKey struct{}
+
+type TraceID string
+
+type auditLog struct {
+	TraceID   string  `inject:"scope=traceID"`
+	Typed     TraceID `inject:"scope=traceID"`
+	RequestID string  `inject:"scope=requestID"`
+}
+
+func newTracingContainer() *Nasc {
+	container := New(
+		WithScopeValue("traceID", ContextValue(traceIDKey{})),
+		WithScopeValue("requestID", func(ctx context.Context) (interface{}, bool) {
+			return "req-42", true
+		}),
+	)
+	_ = container.Scoped((*auditLog)(nil), &auditLog{})
+	return container
+}
+
+func TestScopeValues_InjectedIntoScopedServices(t *testing.T) {
+	container := newTracingContainer()
+
+	ctx := context.WithValue(context.Background(), traceIDKey{}, "trace-1")
+	scope := container.CreateScopeWithContext(ctx)
+	defer scope.Dispose()
+
+	log := scope.Make((*auditLog)(nil)).(*auditLog)
+	if log.TraceID != "trace-1" || log.Typed != "trace-1" {
+		t.Errorf("trace ID not injected: %+v", log)
+	}
+	if log.RequestID != "req-42" {
+		t.Errorf("request ID not injected: %+v", log)
+	}
+	if scope.Context() != ctx {
+		t.Error("Context() should return the scope's context")
+	}
+
+	child := scope.CreateChildScope()
+	if value, _ := child.Value("traceID"); value != "trace-1" {
+		t.Error("child scopes should inherit scope values")
+	}
+}
+
+func TestScopeValues_MissingFromContext(t *testing.T) {
+	container := newTracingContainer()
+
+	scope := container.CreateScopeWithContext(context.Background())
+	defer scope.Dispose()
+
+	log := scope.Make((*auditLog)(nil)).(*auditLog)
+	if log.TraceID != "" {
+		t.Errorf("TraceID = %q, want empty", log.TraceID)
+	}
+}
+
+func TestScopeValues_UnregisteredKeyPanics(t *testing.T) {
+	container := New()
+	_ = container.Scoped((*auditLog)(nil), &auditLog{})
+
+	scope := container.CreateScope()
+	defer scope.Dispose()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for unregistered scope value")
+		}
+	}()
+	scope.Make((*auditLog)(nil))
+}
@@ -0,0 +1,135 @@
+package nasc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// RegistrationStats counts a container's bindings by the registration
+// features they use, as a quick overview of how the application is wired.
+// Field names double as JSON keys for dashboards that track wiring
+// complexity over time.
+type RegistrationStats struct {
+	// Bindings is the number of bindings; Types the number of distinct abstract types
+	Bindings int `json:"bindings"`
+	Types    int `json:"types"`
+
+	// Bindings per lifetime
+	Transient int `json:"transient"`
+	Singleton int `json:"singleton"`
+	Scoped    int `json:"scoped"`
+	Factory   int `json:"factory"`
+	Cached    int `json:"cached"`
+
+	// Bindings per registration feature; a binding may count towards several
+	Constructor int `json:"constructor"`
+	Named       int `json:"named"`
+	Tagged      int `json:"tagged"`
+	AutoWired   int `json:"autowired"`
+	Eager       int `json:"eager"`
+
+	// Contextual counts contextual bindings (see When); Providers registered providers
+	Contextual int `json:"contextual"`
+	Providers  int `json:"providers"`
+
+	// ByOrigin counts bindings per registering module; "" is the application
+	ByOrigin map[string]int `json:"by_origin"`
+}
+
+// String renders the statistics as a short multi-line summary.
+func (s RegistrationStats) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d bindings for %d types\n", s.Bindings, s.Types)
+	fmt.Fprintf(&b, "  lifetimes: %d transient, %d singleton, %d scoped, %d factory, %d cached\n",
+		s.Transient, s.Singleton, s.Scoped, s.Factory, s.Cached)
+	fmt.Fprintf(&b, "  features:  %d constructor, %d named, %d tagged, %d auto-wired, %d eager\n",
+		s.Constructor, s.Named, s.Tagged, s.AutoWired, s.Eager)
+	fmt.Fprintf(&b, "  %d contextual bindings, %d providers\n", s.Contextual, s.Providers)
+
+	origins := make([]string, 0, len(s.ByOrigin))
+	for origin := range s.ByOrigin {
+		origins = append(origins, origin)
+	}
+	sort.Strings(origins)
+	for _, origin := range origins {
+		name := origin
+		if name == "" {
+			name = "(application)"
+		}
+		fmt.Fprintf(&b, "  origin %s: %d\n", name, s.ByOrigin[origin])
+	}
+	return b.String()
+}
+
+// RegistrationStats counts the container's current bindings.
+//
+// Example:
+//
+//	stats := container.RegistrationStats()
+//	metrics.Gauge("di.bindings", stats.Bindings)
+func (n *Nasc) RegistrationStats() RegistrationStats {
+	stats := RegistrationStats{
+		Contextual: n.contextual.count(),
+		Providers:  len(n.snapshotProviders()),
+		ByOrigin:   make(map[string]int),
+	}
+
+	for _, t := range n.registry.GetAllTypes() {
+		stats.Types++
+		for _, b := range n.registry.GetAll(t) {
+			stats.Bindings++
+			stats.ByOrigin[b.Origin]++
+
+			lifetime := Lifetime(b.Lifetime)
+			switch lifetime {
+			case LifetimeTransient:
+				stats.Transient++
+			case LifetimeSingleton:
+				stats.Singleton++
+			case LifetimeScoped:
+				stats.Scoped++
+			case LifetimeFactory:
+				stats.Factory++
+			default:
+				if _, ok := cachedTTL(lifetime); ok {
+					stats.Cached++
+				}
+			}
+
+			if b.Constructor != nil {
+				stats.Constructor++
+			}
+			if len(b.Tags) > 0 {
+				stats.Tagged++
+			} else if b.Name != "" {
+				stats.Named++
+			}
+			if b.AutoWireEnabled {
+				stats.AutoWired++
+			}
+			if b.Eager {
+				stats.Eager++
+			}
+		}
+	}
+	return stats
+}
+
+// WithSealingReport calls report with the container's RegistrationStats
+// when Freeze seals it.
+//
+// Example:
+//
+//	container := nasc.New(nasc.WithSealingReport(func(stats nasc.RegistrationStats) {
+//	    log.Printf("container sealed:\n%s", stats)
+//	}))
+func WithSealingReport(report func(RegistrationStats)) Option {
+	return func(n *Nasc) error {
+		if report == nil {
+			return fmt.Errorf("sealing report callback cannot be nil")
+		}
+		n.sealingReport = report
+		return nil
+	}
+}
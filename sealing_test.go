@@ -0,0 +1,56 @@
+package nasc
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRegistrationStats(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+	_ = container.BindNamed((*Logger)(nil), &FileLogger{}, "file")
+	_ = container.BindWithTags((*NotificationService)(nil), &SMSNotifier{}, []string{"notify"})
+	_ = container.SingletonConstructor((*rotatingClient)(nil), func() *rotatingClient { return &rotatingClient{} })
+	_ = container.Scoped((*Database)(nil), &MockDB{})
+	_ = container.Cached((*frozenConfig)(nil), &frozenConfig{}, time.Minute)
+	_ = container.Factory((*Application)(nil), func(*Nasc) (interface{}, error) { return nil, nil })
+	_ = container.When((*loggingService)(nil)).Needs((*Logger)(nil)).Give((*FileLogger)(nil))
+
+	stats := container.RegistrationStats()
+	want := RegistrationStats{
+		Bindings: 7, Types: 6,
+		Transient: 3, Singleton: 1, Scoped: 1, Factory: 1, Cached: 1,
+		Constructor: 1, Named: 1, Tagged: 1,
+		Contextual: 1,
+	}
+	if stats.ByOrigin[""] != 7 {
+		t.Errorf("ByOrigin[\"\"] = %d, want 7", stats.ByOrigin[""])
+	}
+	stats.ByOrigin, want.ByOrigin = nil, nil
+	if !reflect.DeepEqual(stats, want) {
+		t.Errorf("stats = %+v\nwant    %+v", stats, want)
+	}
+}
+
+func TestWithSealingReport(t *testing.T) {
+	var reports []RegistrationStats
+	container := New(WithSealingReport(func(stats RegistrationStats) {
+		reports = append(reports, stats)
+	}))
+	_ = container.Singleton((*Logger)(nil), &ConsoleLogger{})
+
+	container.Freeze()
+	container.Freeze()
+
+	if len(reports) != 1 {
+		t.Fatalf("expected one report, got %d", len(reports))
+	}
+	if reports[0].Singleton != 1 {
+		t.Errorf("report should count the singleton, got %+v", reports[0])
+	}
+	if s := reports[0].String(); !strings.Contains(s, "1 bindings for 1 types") || !strings.Contains(s, "origin (application): 1") {
+		t.Errorf("unexpected summary:\n%s", s)
+	}
+}
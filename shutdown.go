@@ -0,0 +1,121 @@
+package nasc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Stoppable is implemented by long-running services, such as queue
+// consumers and servers, that must drain before the resources they use are
+// closed. Shutdown calls Stop on every created singleton and cached
+// instance before anything is disposed.
+//
+// Example:
+//
+//	func (c *Consumer) Stop(ctx context.Context) error {
+//	    close(c.quit)
+//	    select {
+//	    case <-c.done:
+//	        return nil
+//	    case <-ctx.Done():
+//	        return ctx.Err()
+//	    }
+//	}
+type Stoppable interface {
+	Stop(ctx context.Context) error
+}
+
+// Shutdown stops and disposes everything the container created, bounded
+// by ctx. It runs in three phases:
+//
+//  1. Running hosted services are stopped (see StopHostedServices), then
+//     Stop is called on the other Stoppable singletons and cached instances,
+//     in reverse creation order so dependents stop before their dependencies,
+//     and on Stoppable providers in reverse registration order.
+//  2. Open scopes are drained and disposed (see DisposeAllScopes).
+//  3. Singletons and cached instances are disposed, again in reverse
+//     creation order, and the caches are cleared.
+//
+// Every phase runs even if an earlier one fails; errors are combined with
+// errors.Join. The container should not be used to resolve services after
+// Shutdown.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+//	defer cancel()
+//	if err := container.Shutdown(ctx); err != nil {
+//	    log.Printf("shutdown: %v", err)
+//	}
+func (n *Nasc) Shutdown(ctx context.Context) error {
+	end := n.trace(TimelineShutdown, "container")
+	err := n.shutdown(ctx)
+	end(err)
+	return err
+}
+
+// shutdown stops and disposes everything the container holds, for Shutdown.
+func (n *Nasc) shutdown(ctx context.Context) error {
+	singletons := n.singletonCache.createdInOrder()
+	cached := n.cached.drain()
+
+	// Cached instances are refreshed over time, so they are treated as
+	// newer than every singleton
+	instances := make([]interface{}, 0, len(singletons)+len(cached))
+	for _, entry := range singletons {
+		instances = append(instances, entry.value)
+	}
+	instances = append(instances, cached...)
+
+	var errs []error
+	hosted, err := n.stopHostedServices(ctx)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	for i := len(instances) - 1; i >= 0; i-- {
+		if containsInstance(hosted, instances[i]) {
+			continue
+		}
+		if stoppable, ok := instances[i].(Stoppable); ok {
+			end := n.trace(TimelineStop, fmt.Sprintf("%T", instances[i]))
+			err := stoppable.Stop(ctx)
+			end(err)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("stop error for %T: %w", instances[i], err))
+			}
+		}
+	}
+	providers := n.snapshotProviders()
+	for i := len(providers) - 1; i >= 0; i-- {
+		if stoppable, ok := providers[i].provider.(Stoppable); ok {
+			if err := stoppable.Stop(ctx); err != nil {
+				errs = append(errs, fmt.Errorf("stop error for provider %T: %w", providers[i].provider, err))
+			}
+		}
+	}
+
+	if err := n.DisposeAllScopes(ctx); err != nil {
+		errs = append(errs, err)
+	}
+
+	// Dispose while the singletons are still cached, so teardown hooks
+	// can look up the ones disposed later
+	resolver := newTeardownResolver(n, nil)
+	for i := len(instances) - 1; i >= 0; i-- {
+		if !isDisposable(instances[i]) {
+			continue
+		}
+		end := n.trace(TimelineDispose, fmt.Sprintf("%T", instances[i]))
+		err := disposeInstance(ctx, instances[i], resolver)
+		end(err)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("disposal error for %T: %w", instances[i], err))
+		}
+	}
+	for _, entry := range singletons {
+		n.singletonCache.remove(entry.key)
+	}
+
+	return errors.Join(errs...)
+}
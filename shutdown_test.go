@@ -0,0 +1,105 @@
+package nasc
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// lifecycleLog records lifecycle calls across services.
+type lifecycleLog struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (l *lifecycleLog) add(call string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.calls = append(l.calls, call)
+}
+
+type Broker interface{ Publish(string) }
+
+type Consumer interface{ Running() bool }
+
+type recordingBroker struct{ log *lifecycleLog }
+
+func (b *recordingBroker) Publish(string) {}
+func (b *recordingBroker) Stop(ctx context.Context) error {
+	b.log.add("stop broker")
+	return nil
+}
+func (b *recordingBroker) Dispose() error {
+	b.log.add("dispose broker")
+	return nil
+}
+
+type recordingConsumer struct {
+	log    *lifecycleLog
+	broker Broker
+}
+
+func (c *recordingConsumer) Running() bool { return true }
+func (c *recordingConsumer) Stop(ctx context.Context) error {
+	c.log.add("stop consumer")
+	return nil
+}
+func (c *recordingConsumer) Dispose() error {
+	c.log.add("dispose consumer")
+	return nil
+}
+
+func TestShutdown_Order(t *testing.T) {
+	log := &lifecycleLog{}
+	container := New()
+	_ = container.SingletonConstructor((*Broker)(nil), func() *recordingBroker {
+		return &recordingBroker{log: log}
+	})
+	_ = container.SingletonConstructor((*Consumer)(nil), func(b Broker) *recordingConsumer {
+		return &recordingConsumer{log: log, broker: b}
+	})
+	_ = container.Scoped((*UnitOfWork)(nil), &trackedUnitOfWork{})
+
+	container.Make((*Consumer)(nil))
+	scope := container.CreateScope()
+	uow := scope.Make((*UnitOfWork)(nil)).(*trackedUnitOfWork)
+
+	if err := container.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown failed: %v", err)
+	}
+
+	want := []string{"stop consumer", "stop broker", "dispose consumer", "dispose broker"}
+	if !reflect.DeepEqual(log.calls, want) {
+		t.Errorf("lifecycle calls = %v, want %v", log.calls, want)
+	}
+	if !uow.disposed || container.OpenScopes() != 0 {
+		t.Error("open scopes should be disposed during shutdown")
+	}
+	if len(container.Singletons()) != 0 {
+		t.Error("singleton cache should be cleared after shutdown")
+	}
+}
+
+type stubbornService struct{ id int }
+
+func (s *stubbornService) Log(string) {}
+func (s *stubbornService) Stop(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestShutdown_StopRespectsDeadline(t *testing.T) {
+	container := New()
+	_ = container.Singleton((*Logger)(nil), &stubbornService{})
+	container.Make((*Logger)(nil))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if err := container.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline error from Stop, got %v", err)
+	}
+}
@@ -0,0 +1,77 @@
+package nasc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// WithShutdownTimeout bounds the shutdown RunUntilSignal performs, and the
+// stop timeout of an App created with this option. The default is
+// DefaultStopTimeout.
+//
+// Example:
+//
+//	container := nasc.New(nasc.WithShutdownTimeout(30 * time.Second))
+func WithShutdownTimeout(timeout time.Duration) Option {
+	return func(n *Nasc) error {
+		if timeout <= 0 {
+			return fmt.Errorf("shutdown timeout must be positive, got %v", timeout)
+		}
+		n.shutdownTimeout = timeout
+		return nil
+	}
+}
+
+// stopTimeout returns the configured shutdown timeout or the default.
+func (n *Nasc) stopTimeout() time.Duration {
+	if n.shutdownTimeout > 0 {
+		return n.shutdownTimeout
+	}
+	return DefaultStopTimeout
+}
+
+// RunUntilSignal blocks until ctx is done, the process receives one of
+// signals (SIGINT and SIGTERM if none are given) or a hosted service fails
+// (see HostedServiceErrors), and then shuts the container down within the
+// shutdown timeout (see WithShutdownTimeout). Shutdown stops hosted
+// services, Stoppable instances and providers, drains and disposes open
+// scopes and disposes singletons; a hosted service failure is returned
+// along with every shutdown error.
+//
+// Example:
+//
+//	container.BootProviders()
+//	container.StartHostedServices(ctx)
+//
+//	if err := container.RunUntilSignal(ctx); err != nil {
+//	    log.Fatal(err)
+//	}
+func (n *Nasc) RunUntilSignal(ctx context.Context, signals ...os.Signal) error {
+	failure := n.waitForStop(ctx, signals)
+
+	stopCtx, cancel := context.WithTimeout(context.Background(), n.stopTimeout())
+	defer cancel()
+	return errors.Join(failure, n.Shutdown(stopCtx))
+}
+
+// waitForStop blocks until ctx is done, a signal arrives or a hosted
+// service fails, returning the failure.
+func (n *Nasc) waitForStop(ctx context.Context, signals []os.Signal) error {
+	if len(signals) == 0 {
+		signals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+	}
+	signalCtx, stopSignals := signal.NotifyContext(ctx, signals...)
+	defer stopSignals()
+
+	select {
+	case <-signalCtx.Done():
+		return nil
+	case err := <-n.HostedServiceErrors():
+		return err
+	}
+}
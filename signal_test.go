@@ -0,0 +1,41 @@
+package nasc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunUntilSignal_ContextAndFailure(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := New().RunUntilSignal(ctx); err != nil {
+		t.Errorf("RunUntilSignal() error = %v, want a clean shutdown when ctx is done", err)
+	}
+
+	container := New()
+	worker := newQueueWorker()
+	worker.fail = errors.New("broker unreachable")
+	_ = container.BindInstanceAs(worker, (*Worker)(nil))
+	_ = container.AddHostedService((*Worker)(nil))
+	_ = container.StartHostedServices(context.Background())
+
+	err := container.RunUntilSignal(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "broker unreachable") {
+		t.Errorf("RunUntilSignal() error = %v, want the hosted service failure", err)
+	}
+}
+
+func TestWithShutdownTimeout(t *testing.T) {
+	if got := NewApp(WithShutdownTimeout(time.Minute)).stopTimeout; got != time.Minute {
+		t.Errorf("app stop timeout = %v, want the container's shutdown timeout", got)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("New should panic on a non-positive shutdown timeout")
+		}
+	}()
+	New(WithShutdownTimeout(0))
+}
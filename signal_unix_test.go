@@ -0,0 +1,48 @@
+//go:build unix
+
+package nasc
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestRunUntilSignal(t *testing.T) {
+	container := New(WithShutdownTimeout(time.Second))
+	worker := newQueueWorker()
+	_ = container.BindInstanceAs(worker, (*Worker)(nil))
+	_ = container.AddHostedService((*Worker)(nil))
+	_ = container.StartHostedServices(context.Background())
+	<-worker.started
+
+	// Keep SIGUSR1 from terminating the test binary before RunUntilSignal
+	// installs its handler
+	caught := make(chan os.Signal, 1)
+	signal.Notify(caught, syscall.SIGUSR1)
+	defer signal.Stop(caught)
+
+	done := make(chan error)
+	go func() { done <- container.RunUntilSignal(context.Background(), syscall.SIGUSR1) }()
+
+	deadline := time.After(5 * time.Second)
+	for {
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatalf("RunUntilSignal() error = %v", err)
+			}
+			if got := worker.Events(); got != "start,stop,done" {
+				t.Errorf("events = %s, want the hosted service stopped", got)
+			}
+			return
+		case <-deadline:
+			t.Fatal("RunUntilSignal should return after the signal")
+		case <-time.After(10 * time.Millisecond):
+			_ = syscall.Kill(syscall.Getpid(), syscall.SIGUSR1)
+		}
+	}
+}
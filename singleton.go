@@ -2,7 +2,12 @@ package nasc
 
 import (
 	"reflect"
+	"sort"
 	"sync"
+	"sync/atomic"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/internal/lockstat"
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
 )
 
 // singletonInstance holds a singleton value and ensures it's created only once.
@@ -10,18 +15,62 @@ type singletonInstance struct {
 	value interface{}
 	err   error
 	once  sync.Once
+
+	// created is set once value and err are final
+	created atomic.Bool
+
+	// seq orders successfully created instances by creation time
+	seq uint64
+}
+
+// singletonKey identifies a singleton in the cache: the abstract type and
+// the binding name ("" for the default binding).
+type singletonKey struct {
+	t    reflect.Type
+	name string
+}
+
+// keyFor returns the cache key of a singleton.
+func keyFor(t reflect.Type, name string) singletonKey {
+	return singletonKey{t: t, name: name}
+}
+
+// String renders the key as the type, followed by "#name" for named
+// singletons.
+func (k singletonKey) String() string {
+	if k.name == "" {
+		return k.t.String()
+	}
+	return k.t.String() + "#" + k.name
+}
+
+// singletonKeyLess orders keys by type, then name.
+func singletonKeyLess(a, b singletonKey) bool {
+	if a.t != b.t {
+		return registry.TypeLess(a.t, b.t)
+	}
+	return a.name < b.name
 }
 
 // singletonCache manages singleton instances with thread-safe lazy initialization.
 type singletonCache struct {
-	instances map[reflect.Type]*singletonInstance
-	mu        sync.RWMutex
+	instances map[singletonKey]*singletonInstance
+	mu        lockstat.RWMutex
+
+	// onCreate observes each successfully created instance (see WithImmutabilityChecks)
+	onCreate func(singletonKey, interface{})
+
+	// onHit observes each lookup served by an existing instance (see SingletonHit)
+	onHit func(singletonKey, interface{})
+
+	// seq numbers created instances (see createdInOrder)
+	seq atomic.Uint64
 }
 
 // newSingletonCache creates a new singleton cache.
 func newSingletonCache() *singletonCache {
 	return &singletonCache{
-		instances: make(map[reflect.Type]*singletonInstance),
+		instances: make(map[singletonKey]*singletonInstance),
 	}
 }
 
@@ -29,28 +78,157 @@ func newSingletonCache() *singletonCache {
 // The factory is called exactly once per type, even under concurrent access.
 //
 // This method is goroutine-safe.
-func (sc *singletonCache) getOrCreate(abstractType reflect.Type, factory func() (interface{}, error)) (interface{}, error) {
+func (sc *singletonCache) getOrCreate(key singletonKey, factory func() (interface{}, error)) (interface{}, error) {
 	// Fast path: check if instance exists (read lock)
 	sc.mu.RLock()
-	instance, exists := sc.instances[abstractType]
+	instance, exists := sc.instances[key]
 	sc.mu.RUnlock()
 
 	if !exists {
 		// Slow path: create instance holder (write lock)
 		sc.mu.Lock()
 		// Double-check after acquiring write lock (another goroutine might have created it)
-		instance, exists = sc.instances[abstractType]
+		instance, exists = sc.instances[key]
 		if !exists {
 			instance = &singletonInstance{}
-			sc.instances[abstractType] = instance
+			sc.instances[key] = instance
 		}
 		sc.mu.Unlock()
 	}
 
 	// Use sync.Once to ensure factory is called exactly once
+	created := false
 	instance.once.Do(func() {
+		created = true
 		instance.value, instance.err = factory()
+		instance.seq = sc.seq.Add(1)
+		if instance.err == nil && sc.onCreate != nil {
+			sc.onCreate(key, instance.value)
+		}
+		instance.created.Store(true)
 	})
 
+	if !created && instance.err == nil && sc.onHit != nil {
+		sc.onHit(key, instance.value)
+	}
 	return instance.value, instance.err
 }
+
+// remove evicts a cached singleton so the next resolution creates a new instance.
+//
+// This method is goroutine-safe.
+func (sc *singletonCache) remove(key singletonKey) {
+	sc.take(key)
+}
+
+// take evicts and returns the cache entry for a type (nil if absent).
+//
+// This method is goroutine-safe.
+func (sc *singletonCache) take(key singletonKey) *singletonInstance {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	instance := sc.instances[key]
+	delete(sc.instances, key)
+	return instance
+}
+
+// store caches value as an already created singleton, so that it is
+// stopped and disposed with the others, unless key is already cached.
+//
+// This method is goroutine-safe.
+func (sc *singletonCache) store(key singletonKey, value interface{}) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	if _, exists := sc.instances[key]; exists {
+		return
+	}
+	instance := &singletonInstance{value: value, seq: sc.seq.Add(1)}
+	instance.once.Do(func() {})
+	instance.created.Store(true)
+	sc.instances[key] = instance
+}
+
+// put restores a cache entry previously returned by take.
+// A nil entry clears the cache for the type.
+//
+// This method is goroutine-safe.
+func (sc *singletonCache) put(key singletonKey, instance *singletonInstance) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	if instance == nil {
+		delete(sc.instances, key)
+		return
+	}
+	sc.instances[key] = instance
+}
+
+// lookup returns a singleton if it has been successfully created, without
+// creating it.
+//
+// This method is goroutine-safe.
+func (sc *singletonCache) lookup(key singletonKey) (interface{}, bool) {
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+
+	instance, ok := sc.instances[key]
+	if !ok || !instance.created.Load() || instance.err != nil {
+		return nil, false
+	}
+	return instance.value, true
+}
+
+// created returns the singletons that have been successfully created so far.
+// Entries still being created are skipped.
+//
+// This method is goroutine-safe.
+func (sc *singletonCache) created() map[singletonKey]interface{} {
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+
+	instances := make(map[singletonKey]interface{}, len(sc.instances))
+	for key, instance := range sc.instances {
+		if instance.created.Load() && instance.err == nil {
+			instances[key] = instance.value
+		}
+	}
+	return instances
+}
+
+// singletonEntry is a created singleton and its cache key.
+type singletonEntry struct {
+	key   singletonKey
+	value interface{}
+}
+
+// createdInOrder returns the singletons that have been successfully
+// created so far, oldest first. Dependencies are created before the
+// singletons that use them, so reversing the order is safe for teardown.
+//
+// This method is goroutine-safe.
+func (sc *singletonCache) createdInOrder() []singletonEntry {
+	sc.mu.RLock()
+	type ordered struct {
+		singletonEntry
+		seq uint64
+	}
+	var all []ordered
+	for key, instance := range sc.instances {
+		if instance.created.Load() && instance.err == nil {
+			all = append(all, ordered{singletonEntry{key, instance.value}, instance.seq})
+		}
+	}
+	sc.mu.RUnlock()
+
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].seq < all[j].seq
+	})
+
+	entries := make([]singletonEntry, len(all))
+	for i, o := range all {
+		entries[i] = o.singletonEntry
+	}
+	return entries
+}
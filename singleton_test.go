@@ -0,0 +1,78 @@
+package nasc
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNamedSingletons_DistinctInstances(t *testing.T) {
+	container := New()
+	_ = As[Logger, middlewareA](container, WithName("a"), WithLifetime(LifetimeSingleton))
+	_ = As[Logger, middlewareB](container, WithName("b"), WithLifetime(LifetimeSingleton))
+	_ = As[Database, MockDB](container, WithName("a"), WithLifetime(LifetimeSingleton))
+
+	a := container.MakeNamed((*Logger)(nil), "a")
+	b := container.MakeNamed((*Logger)(nil), "b")
+	if _, ok := a.(*middlewareA); !ok {
+		t.Errorf("named singleton a = %T, want *middlewareA", a)
+	}
+	if _, ok := b.(*middlewareB); !ok {
+		t.Errorf("named singleton b = %T, want *middlewareB", b)
+	}
+	if _, ok := container.MakeNamed((*Database)(nil), "a").(*MockDB); !ok {
+		t.Error("same name on another type should be a separate singleton")
+	}
+	if container.MakeNamed((*Logger)(nil), "a") != a {
+		t.Error("named singleton should be reused")
+	}
+
+	safe, err := container.MakeNamedSafe((*Logger)(nil), "b")
+	if err != nil || safe != b {
+		t.Errorf("MakeNamedSafe should share the named singleton, got %v, %v", safe, err)
+	}
+}
+
+func TestSingletons_Introspection(t *testing.T) {
+	container := New()
+	_ = container.Singleton((*Logger)(nil), &ConsoleLogger{})
+	_ = As[Logger, middlewareA](container, WithName("a"), WithLifetime(LifetimeSingleton))
+	_ = container.Singleton((*Database)(nil), &MockDB{})
+
+	if len(container.Singletons()) != 0 {
+		t.Error("no singletons should be created yet")
+	}
+
+	def := container.Make((*Logger)(nil))
+	named := container.MakeNamed((*Logger)(nil), "a")
+
+	infos := container.Singletons()
+	if len(infos) != 2 {
+		t.Fatalf("expected 2 singletons, got %d", len(infos))
+	}
+	if infos[0].Name != "" || infos[0].Instance != def {
+		t.Errorf("first entry should be the default singleton, got %+v", infos[0])
+	}
+	if infos[1].Name != "a" || infos[1].Instance != named {
+		t.Errorf("second entry should be the named singleton, got %+v", infos[1])
+	}
+}
+
+func TestSnapshot_NamedSingletons(t *testing.T) {
+	before := New()
+	_ = As[priceCache, snapshotCache](before, WithName("us"), WithLifetime(LifetimeSingleton))
+	before.MakeNamed((*priceCache)(nil), "us").(*snapshotCache).prices = map[string]int{"apple": 3}
+
+	var buf bytes.Buffer
+	if err := before.SaveSnapshot(&buf); err != nil {
+		t.Fatalf("SaveSnapshot failed: %v", err)
+	}
+
+	after := New()
+	_ = As[priceCache, snapshotCache](after, WithName("us"), WithLifetime(LifetimeSingleton))
+	if err := after.LoadSnapshot(&buf); err != nil {
+		t.Fatalf("LoadSnapshot failed: %v", err)
+	}
+	if got := after.MakeNamed((*priceCache)(nil), "us").(priceCache).Price("apple"); got != 3 {
+		t.Errorf("named singleton state not restored, price = %d", got)
+	}
+}
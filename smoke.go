@@ -0,0 +1,64 @@
+package nasc
+
+import (
+	"errors"
+	"fmt"
+)
+
+// SmokeSafeTag marks test doubles that replace external-effect bindings
+// during smoke tests. Register doubles with BindWithTags and this tag; in the
+// smoke sandbox they become the default binding for their abstract type.
+//
+// Example:
+//
+//	container.Singleton((*Mailer)(nil), &SMTPMailer{})
+//	container.BindWithTags((*Mailer)(nil), &FakeMailer{}, []string{nasc.SmokeSafeTag})
+const SmokeSafeTag = "smoke-safe"
+
+// SmokeTest proves the container's wiring constructs end-to-end without
+// touching external systems. It validates a sandbox copy of the container in
+// which every binding with a SmokeSafeTag double is replaced by that double,
+// then resolves each root inside a sandbox scope.
+//
+// The container itself is never modified and no singleton is created on it.
+// See the nasctest package for a testing.TB wrapper.
+func (n *Nasc) SmokeTest(roots ...interface{}) error {
+	sandbox := n.Clone()
+
+	for _, double := range sandbox.registry.GetByTag(SmokeSafeTag) {
+		replacement := *double
+		replacement.Name = ""
+		replacement.Tags = nil
+		if _, err := sandbox.registry.Replace(&replacement); err != nil {
+			return err
+		}
+	}
+
+	var errs []error
+	if err := sandbox.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	scope := sandbox.CreateScope()
+	defer scope.Dispose()
+
+	for _, root := range roots {
+		if err := smokeResolve(scope, root); err != nil {
+			errs = append(errs, fmt.Errorf("smoke %v: %w", typeOfToken(root), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// smokeResolve resolves a root in the scope, converting panics into errors.
+func smokeResolve(scope *Scope, root interface{}) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	scope.Make(root)
+	return nil
+}
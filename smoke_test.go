@@ -0,0 +1,64 @@
+package nasc
+
+import (
+	"strings"
+	"testing"
+)
+
+type Mailer interface {
+	Send(to string) error
+}
+
+type smtpMailer struct {
+	Logger Logger
+}
+
+func (m *smtpMailer) Send(to string) error { return nil }
+
+type fakeMailer struct{}
+
+func (m *fakeMailer) Send(to string) error { return nil }
+
+func TestSmokeTest_ReplacesSmokeSafeBindings(t *testing.T) {
+	constructed := false
+	container := New()
+	_ = container.SingletonConstructor((*Mailer)(nil), func(logger Logger) *smtpMailer {
+		constructed = true
+		return &smtpMailer{Logger: logger}
+	})
+	_ = container.BindWithTags((*Mailer)(nil), &fakeMailer{}, []string{SmokeSafeTag})
+
+	if err := container.SmokeTest((*Mailer)(nil)); err != nil {
+		t.Fatalf("SmokeTest failed: %v", err)
+	}
+	if constructed {
+		t.Error("the production binding should have been replaced by the double")
+	}
+
+	// The original container is untouched
+	if _, err := container.MakeSafe((*Mailer)(nil)); err == nil {
+		t.Error("production binding should still require a Logger")
+	}
+}
+
+func TestSmokeTest_ReportsBrokenWiring(t *testing.T) {
+	container := New()
+	_ = container.BindConstructor((*ConstructorService)(nil), NewServiceWithDeps)
+
+	err := container.SmokeTest((*ConstructorService)(nil), (*Logger)(nil))
+	if err == nil {
+		t.Fatal("expected smoke test failure")
+	}
+	if !strings.Contains(err.Error(), "smoke nasc.Logger") {
+		t.Errorf("expected failing root to be reported, got %v", err)
+	}
+}
+
+func TestSmokeTest_ScopedRoots(t *testing.T) {
+	container := New()
+	_ = container.Scoped((*Logger)(nil), &ConsoleLogger{})
+
+	if err := container.SmokeTest((*Logger)(nil)); err != nil {
+		t.Errorf("scoped roots should resolve in the sandbox scope: %v", err)
+	}
+}
@@ -0,0 +1,156 @@
+package nasc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Snapshotable is an optional interface for singletons whose state is worth
+// keeping across process restarts, such as warm caches or expensive
+// precomputed data. See SaveSnapshot and LoadSnapshot.
+//
+// Example:
+//
+//	func (c *PriceCache) SaveState() ([]byte, error) {
+//	    return json.Marshal(c.prices)
+//	}
+//
+//	func (c *PriceCache) LoadState(data []byte) error {
+//	    return json.Unmarshal(data, &c.prices)
+//	}
+type Snapshotable interface {
+	SaveState() ([]byte, error)
+	LoadState(data []byte) error
+}
+
+// snapshotFile is the serialized form written by SaveSnapshot.
+type snapshotFile struct {
+	Version int               `json:"version"`
+	States  map[string][]byte `json:"states"`
+}
+
+// snapshotVersion is the current snapshot format version.
+const snapshotVersion = 1
+
+// SaveSnapshot writes the state of every created singleton implementing
+// Snapshotable to w. Singletons that have not been created yet are skipped.
+// Named singletons are saved under "Type#name".
+//
+// Example:
+//
+//	f, _ := os.Create("state.snapshot")
+//	defer f.Close()
+//	container.SaveSnapshot(f)
+func (n *Nasc) SaveSnapshot(w io.Writer) error {
+	snapshot := snapshotFile{Version: snapshotVersion, States: make(map[string][]byte)}
+
+	var errs []error
+	for key, instance := range n.singletonCache.created() {
+		snapshotable, ok := instance.(Snapshotable)
+		if !ok {
+			continue
+		}
+		data, err := snapshotable.SaveState()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%v: %w", key, err))
+			continue
+		}
+		snapshot.States[key.String()] = data
+	}
+	if len(errs) > 0 {
+		sortErrors(errs)
+		return fmt.Errorf("failed to save snapshot: %w", errors.Join(errs...))
+	}
+
+	if err := json.NewEncoder(w).Encode(snapshot); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return nil
+}
+
+// LoadSnapshot reads state written by SaveSnapshot. Singletons that already
+// exist load their state immediately; the others load it right after they
+// are created, before they are returned to any caller.
+//
+// Example:
+//
+//	if f, err := os.Open("state.snapshot"); err == nil {
+//	    defer f.Close()
+//	    container.LoadSnapshot(f)
+//	}
+func (n *Nasc) LoadSnapshot(r io.Reader) error {
+	var snapshot snapshotFile
+	if err := json.NewDecoder(r).Decode(&snapshot); err != nil {
+		return fmt.Errorf("failed to read snapshot: %w", err)
+	}
+	if snapshot.Version != snapshotVersion {
+		return fmt.Errorf("unsupported snapshot version %d", snapshot.Version)
+	}
+
+	n.pendingStateMu.Lock()
+	if n.pendingStates == nil {
+		n.pendingStates = make(map[string][]byte)
+	}
+	for key, data := range snapshot.States {
+		n.pendingStates[key] = data
+	}
+	n.pendingStateMu.Unlock()
+
+	var errs []error
+	for key, instance := range n.singletonCache.created() {
+		if err := n.restoreState(key, instance); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		sortErrors(errs)
+		return fmt.Errorf("failed to load snapshot: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// restoreFactory wraps a singleton factory so the created instance loads
+// any pending snapshot state before it is cached.
+func (n *Nasc) restoreFactory(key singletonKey, factory func() (interface{}, error)) func() (interface{}, error) {
+	return func() (interface{}, error) {
+		instance, err := factory()
+		if err != nil {
+			return instance, err
+		}
+		if err := n.restoreState(key, instance); err != nil {
+			return nil, err
+		}
+		return instance, nil
+	}
+}
+
+// restoreState loads and consumes the pending state for a singleton, if any.
+func (n *Nasc) restoreState(key singletonKey, instance interface{}) error {
+	snapshotable, ok := instance.(Snapshotable)
+	if !ok {
+		return nil
+	}
+
+	n.pendingStateMu.Lock()
+	data, ok := n.pendingStates[key.String()]
+	delete(n.pendingStates, key.String())
+	n.pendingStateMu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	if err := snapshotable.LoadState(data); err != nil {
+		return fmt.Errorf("failed to load state of %v: %w", key, err)
+	}
+	return nil
+}
+
+// sortErrors orders errors by message for deterministic output.
+func sortErrors(errs []error) {
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].Error() < errs[j].Error()
+	})
+}
@@ -0,0 +1,89 @@
+package nasc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+type priceCache interface {
+	Price(item string) int
+}
+
+type snapshotCache struct {
+	prices map[string]int
+	fail   bool
+}
+
+func (c *snapshotCache) Price(item string) int { return c.prices[item] }
+
+func (c *snapshotCache) SaveState() ([]byte, error) {
+	if c.fail {
+		return nil, errors.New("disk full")
+	}
+	return json.Marshal(c.prices)
+}
+
+func (c *snapshotCache) LoadState(data []byte) error {
+	return json.Unmarshal(data, &c.prices)
+}
+
+func TestSnapshot_RoundTrip(t *testing.T) {
+	before := New()
+	_ = before.Singleton((*priceCache)(nil), &snapshotCache{})
+	_ = before.Singleton((*Logger)(nil), &ConsoleLogger{})
+	before.Make((*priceCache)(nil)).(*snapshotCache).prices = map[string]int{"apple": 3}
+	before.Make((*Logger)(nil))
+
+	var buf bytes.Buffer
+	if err := before.SaveSnapshot(&buf); err != nil {
+		t.Fatalf("SaveSnapshot failed: %v", err)
+	}
+
+	after := New()
+	_ = after.Singleton((*priceCache)(nil), &snapshotCache{})
+	if err := after.LoadSnapshot(&buf); err != nil {
+		t.Fatalf("LoadSnapshot failed: %v", err)
+	}
+
+	// State is applied when the singleton is first created
+	if price := after.Make((*priceCache)(nil)).(priceCache).Price("apple"); price != 3 {
+		t.Errorf("restored price = %d, want 3", price)
+	}
+}
+
+func TestSnapshot_LoadIntoExistingSingleton(t *testing.T) {
+	container := New()
+	_ = container.Singleton((*priceCache)(nil), &snapshotCache{})
+	cache := container.Make((*priceCache)(nil)).(*snapshotCache)
+
+	snapshot := `{"version":1,"states":{"nasc.priceCache":"eyJwZWFyIjo1fQ=="}}` // {"pear":5}
+	if err := container.LoadSnapshot(strings.NewReader(snapshot)); err != nil {
+		t.Fatalf("LoadSnapshot failed: %v", err)
+	}
+	if cache.Price("pear") != 5 {
+		t.Error("existing singleton should load its state immediately")
+	}
+}
+
+func TestSnapshot_Errors(t *testing.T) {
+	container := New()
+	_ = container.Singleton((*priceCache)(nil), &snapshotCache{})
+	container.Make((*priceCache)(nil)).(*snapshotCache).fail = true
+
+	if err := container.SaveSnapshot(&bytes.Buffer{}); err == nil || !strings.Contains(err.Error(), "disk full") {
+		t.Errorf("expected SaveState error, got %v", err)
+	}
+	if err := container.LoadSnapshot(strings.NewReader(`{"version":99}`)); err == nil {
+		t.Error("expected error for unsupported version")
+	}
+
+	fresh := New()
+	_ = fresh.Singleton((*priceCache)(nil), &snapshotCache{})
+	_ = fresh.LoadSnapshot(strings.NewReader(`{"version":1,"states":{"nasc.priceCache":"YmFk"}}`)) // bad
+	if _, err := fresh.MakeSafe((*priceCache)(nil)); err == nil {
+		t.Error("expected error creating a singleton with corrupt state")
+	}
+}
@@ -0,0 +1,111 @@
+package nasc
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// TeardownDisposable is implemented by services whose cleanup needs other
+// services, such as flushing buffered events through a shared client. It
+// is called instead of Dispose with a TeardownResolver that reaches the
+// instances that still exist while the scope or cache is torn down.
+//
+// Example:
+//
+//	func (o *Outbox) Teardown(r *nasc.TeardownResolver) error {
+//	    if client, ok := r.Lookup((*QueueClient)(nil)); ok {
+//	        return o.flush(client.(QueueClient))
+//	    }
+//	    return nil
+//	}
+type TeardownDisposable interface {
+	Teardown(r *TeardownResolver) error
+}
+
+// TeardownResolver gives read-only access to instances that already exist
+// during teardown. It never constructs anything: a lookup that would need
+// a new instance fails instead, so cleanup code cannot resurrect services
+// that were already disposed or were never needed.
+type TeardownResolver struct {
+	container *Nasc
+	scope     *Scope
+
+	// instances and overrides are snapshots of the scope being disposed,
+	// taken because the scope holds its lock during disposal
+	instances map[reflect.Type]interface{}
+	overrides map[reflect.Type]interface{}
+}
+
+// newTeardownResolver creates a resolver over the container's existing
+// instances and, if scope is not nil, the scope's. Callers disposing a
+// scope must hold its lock.
+func newTeardownResolver(container *Nasc, scope *Scope) *TeardownResolver {
+	r := &TeardownResolver{container: container, scope: scope}
+	if scope != nil {
+		r.instances = make(map[reflect.Type]interface{}, len(scope.instances))
+		for t, instance := range scope.instances {
+			r.instances[t] = instance
+		}
+		r.overrides = make(map[reflect.Type]interface{}, len(scope.overrides))
+		for t, instance := range scope.overrides {
+			r.overrides[t] = instance
+		}
+	}
+	return r
+}
+
+// Lookup returns the existing instance for abstractType's default binding:
+// a scope override or scoped instance, a created singleton, or an
+// unexpired cached instance. It reports false if none exists.
+func (r *TeardownResolver) Lookup(abstractType interface{}) (interface{}, bool) {
+	if abstractType == nil {
+		return nil, false
+	}
+	abstractT := typeOfToken(abstractType)
+
+	if instance, ok := r.overrides[abstractT]; ok {
+		return instance, true
+	}
+	if instance, ok := r.instances[abstractT]; ok {
+		return instance, true
+	}
+	if instance, ok := r.container.singletonCache.lookup(keyFor(abstractT, "")); ok {
+		return instance, true
+	}
+
+	binding, err := r.container.registry.Get(abstractT)
+	if err != nil {
+		return nil, false
+	}
+	return r.container.cached.lookup(binding)
+}
+
+// Make returns the existing instance for abstractType like Lookup, and
+// panics if there is none rather than constructing one.
+func (r *TeardownResolver) Make(abstractType interface{}) interface{} {
+	instance, ok := r.Lookup(abstractType)
+	if !ok {
+		panic(fmt.Sprintf("teardown resolver cannot construct %v: no instance exists", typeOfToken(abstractType)))
+	}
+	return instance
+}
+
+// disposeInstance releases an instance, preferring TeardownDisposable over
+// DisposableWithContext and Disposable. Instances implementing none of
+// them are left alone. Failures are emitted as DisposeFailed events.
+func disposeInstance(ctx context.Context, instance interface{}, r *TeardownResolver) error {
+	var err error
+	switch d := instance.(type) {
+	case TeardownDisposable:
+		err = d.Teardown(r)
+	case DisposableWithContext:
+		err = d.Dispose(ctx)
+	case Disposable:
+		err = d.Dispose()
+	}
+	if err != nil {
+		r.container.emit(Event{Kind: DisposeFailed, Type: reflect.TypeOf(instance), Instance: instance, Scope: r.scope, Err: err})
+	}
+	return err
+}
@@ -0,0 +1,111 @@
+package nasc
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+type outbox struct {
+	flushedTo Logger
+	missing   bool
+	panicked  bool
+}
+
+func (o *outbox) Log(message string) {}
+
+func (o *outbox) Teardown(r *TeardownResolver) error {
+	if logger, ok := r.Lookup((*Logger)(nil)); ok {
+		o.flushedTo = logger.(Logger)
+	}
+	if _, ok := r.Lookup((*Database)(nil)); !ok {
+		o.missing = true
+	}
+	func() {
+		defer func() { o.panicked = recover() != nil }()
+		r.Make((*Database)(nil))
+	}()
+	return nil
+}
+
+func TestTeardown_ScopeInstances(t *testing.T) {
+	container := New()
+	_ = container.Scoped((*Logger)(nil), &ConsoleLogger{})
+	_ = container.Bind((*Database)(nil), &MockDB{})
+	_ = container.Scoped((*NotificationService)(nil), &SMSNotifier{})
+
+	scope := container.CreateScope()
+	logger := scope.Make((*Logger)(nil))
+	box := &outbox{}
+	_ = scope.BindConstructor((*outbox)(nil), func() *outbox { return box })
+	scope.Make((*outbox)(nil))
+
+	if err := scope.Dispose(); err != nil {
+		t.Fatalf("Dispose failed: %v", err)
+	}
+	if box.flushedTo != logger {
+		t.Error("teardown should see the scope's existing logger")
+	}
+	if !box.missing || !box.panicked {
+		t.Error("teardown resolver should not construct new instances")
+	}
+}
+
+func TestTeardown_Singletons(t *testing.T) {
+	container := New()
+	_ = container.Singleton((*Logger)(nil), &ConsoleLogger{})
+
+	scope := container.CreateScope()
+	box := &outbox{}
+	_ = scope.BindInstance((*Database)(nil), &MockDB{})
+	_ = scope.BindConstructor((*outbox)(nil), func() *outbox { return box })
+	scope.Make((*outbox)(nil))
+
+	_ = scope.Dispose()
+	if box.flushedTo != nil {
+		t.Error("singleton that was never created should not be constructed")
+	}
+	if box.missing {
+		t.Error("scope instance bindings should be visible")
+	}
+
+	singleton := container.Make((*Logger)(nil))
+	scope = container.CreateScope()
+	box = &outbox{}
+	_ = scope.BindConstructor((*outbox)(nil), func() *outbox { return box })
+	scope.Make((*outbox)(nil))
+	_ = scope.Dispose()
+	if box.flushedTo != singleton {
+		t.Error("created singletons should be visible")
+	}
+}
+
+type failingTeardown struct{ id int }
+
+func (f *failingTeardown) Teardown(r *TeardownResolver) error {
+	return errors.New("flush failed")
+}
+
+func TestTeardown_CachedExpiry(t *testing.T) {
+	reported := make(chan error, 1)
+	container := New(WithOnError(func(err error) { reported <- err }))
+	next := 0
+	_ = container.CachedConstructor((*failingTeardown)(nil), func() *failingTeardown {
+		next++
+		return &failingTeardown{id: next}
+	}, 10*time.Millisecond)
+
+	container.Make((*failingTeardown)(nil))
+	time.Sleep(20 * time.Millisecond)
+	container.Make((*failingTeardown)(nil))
+
+	select {
+	case err := <-reported:
+		var lifecycle *LifecycleError
+		if !errors.As(err, &lifecycle) || lifecycle.Phase != "dispose" {
+			t.Errorf("expected dispose LifecycleError, got %v", err)
+		}
+	default:
+		t.Error("expected teardown failure to be reported")
+	}
+}
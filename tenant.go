@@ -0,0 +1,103 @@
+package nasc
+
+import (
+	"sort"
+	"sync"
+)
+
+// tenantScopes caches one long-lived scope per tenant key.
+type tenantScopes struct {
+	mu     sync.Mutex
+	scopes map[string]*Scope
+}
+
+// TenantScope returns the long-lived scope for a tenant, creating it on
+// first use. Scoped bindings resolved from it are created once per tenant,
+// giving tenant-specific services such as database pools and caches
+// per-tenant singleton semantics, while real singletons stay shared across
+// tenants.
+//
+// Tenant scopes are named "tenant:" + key (see ScopeName) and live until
+// EvictTenant or DisposeAllScopes disposes them; a disposed tenant scope is
+// replaced on the next call. Request scopes for a tenant are child scopes
+// of its tenant scope, inheriting the tenant's services with InheritScoped.
+//
+// Example:
+//
+//	tenant := container.TenantScope(tenantID)
+//	db := tenant.Make((*Database)(nil)).(Database) // one pool per tenant
+//
+//	request := tenant.CreateChildScope(nasc.InheritScoped((*Database)(nil)))
+//	defer request.Dispose()
+func (n *Nasc) TenantScope(key string) *Scope {
+	n.tenants.mu.Lock()
+	scope, ok := n.tenants.scopes[key]
+	n.tenants.mu.Unlock()
+	if ok && !scope.isDisposed() {
+		return scope
+	}
+
+	// Create outside the lock, since OnScopeCreated hooks may use tenants
+	created := n.CreateScope(ScopeName("tenant:" + key))
+
+	n.tenants.mu.Lock()
+	scope, ok = n.tenants.scopes[key]
+	if !ok || scope.isDisposed() {
+		if n.tenants.scopes == nil {
+			n.tenants.scopes = make(map[string]*Scope)
+		}
+		n.tenants.scopes[key] = created
+		n.tenants.mu.Unlock()
+		return created
+	}
+	n.tenants.mu.Unlock()
+
+	// Another goroutine created the tenant first
+	_ = created.Dispose()
+	return scope
+}
+
+// EvictTenant disposes a tenant's scope and forgets it, for example when a
+// tenant is offboarded or its configuration changes. The next TenantScope
+// call for the key creates a fresh scope. Evicting an unknown tenant is a
+// no-op.
+//
+// Example:
+//
+//	if err := container.EvictTenant(tenantID); err != nil {
+//	    log.Printf("evicting tenant %s: %v", tenantID, err)
+//	}
+func (n *Nasc) EvictTenant(key string) error {
+	n.tenants.mu.Lock()
+	scope, ok := n.tenants.scopes[key]
+	delete(n.tenants.scopes, key)
+	n.tenants.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+	return scope.Dispose()
+}
+
+// Tenants returns the keys of the tenants with a live scope, sorted.
+func (n *Nasc) Tenants() []string {
+	n.tenants.mu.Lock()
+	defer n.tenants.mu.Unlock()
+
+	keys := make([]string, 0, len(n.tenants.scopes))
+	for key, scope := range n.tenants.scopes {
+		if !scope.isDisposed() {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// isDisposed reports whether the scope has been disposed.
+func (s *Scope) isDisposed() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.disposed
+}
@@ -0,0 +1,90 @@
+package nasc
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestTenantScope(t *testing.T) {
+	container := New()
+	_ = container.Scoped((*UnitOfWork)(nil), &trackedUnitOfWork{})
+	_ = container.Singleton((*Logger)(nil), &ConsoleLogger{})
+
+	acme := container.TenantScope("acme")
+	if container.TenantScope("acme") != acme {
+		t.Fatal("TenantScope should cache the scope per key")
+	}
+	if acme.Name() != "tenant:acme" {
+		t.Errorf("tenant scope name = %q", acme.Name())
+	}
+	globex := container.TenantScope("globex")
+
+	acmeUnit := acme.Make((*UnitOfWork)(nil))
+	if acme.Make((*UnitOfWork)(nil)) != acmeUnit {
+		t.Error("scoped services should be created once per tenant")
+	}
+	if globex.Make((*UnitOfWork)(nil)) == acmeUnit {
+		t.Error("tenants should not share scoped services")
+	}
+	if acme.Make((*Logger)(nil)) != globex.Make((*Logger)(nil)) {
+		t.Error("singletons should be shared across tenants")
+	}
+
+	if got := container.Tenants(); !reflect.DeepEqual(got, []string{"acme", "globex"}) {
+		t.Errorf("Tenants() = %v", got)
+	}
+
+	if err := container.EvictTenant("acme"); err != nil {
+		t.Fatalf("EvictTenant failed: %v", err)
+	}
+	if !acmeUnit.(*trackedUnitOfWork).disposed {
+		t.Error("evicting a tenant should dispose its services")
+	}
+	if fresh := container.TenantScope("acme"); fresh == acme {
+		t.Error("an evicted tenant should get a new scope")
+	}
+	if err := container.EvictTenant("unknown"); err != nil {
+		t.Errorf("evicting an unknown tenant should be a no-op, got %v", err)
+	}
+}
+
+func TestTenantScope_ReplacesDisposedScope(t *testing.T) {
+	container := New()
+
+	acme := container.TenantScope("acme")
+	if err := container.DisposeAllScopes(context.Background()); err != nil {
+		t.Fatalf("DisposeAllScopes failed: %v", err)
+	}
+	if len(container.Tenants()) != 0 {
+		t.Error("disposed tenant scopes should not be listed")
+	}
+	if container.TenantScope("acme") == acme {
+		t.Error("a disposed tenant scope should be replaced")
+	}
+}
+
+func TestTenantScope_Concurrent(t *testing.T) {
+	container := New()
+
+	var wg sync.WaitGroup
+	scopes := make([]*Scope, 8)
+	for i := range scopes {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			scopes[i] = container.TenantScope("acme")
+		}(i)
+	}
+	wg.Wait()
+
+	for _, scope := range scopes {
+		if scope != scopes[0] {
+			t.Fatal("concurrent callers should get the same tenant scope")
+		}
+	}
+	if container.OpenScopes() != 1 {
+		t.Errorf("losing scopes should be disposed, %d open", container.OpenScopes())
+	}
+}
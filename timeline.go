@@ -0,0 +1,205 @@
+package nasc
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// Timeline event categories recorded by the container.
+const (
+	TimelineProviderRegister = "provider.register"
+	TimelineProviderBoot     = "provider.boot"
+	TimelineSingleton        = "singleton.create"
+	TimelineHostedStart      = "hosted.start"
+	TimelineHostedStop       = "hosted.stop"
+	TimelineShutdown         = "container.shutdown"
+	TimelineStop             = "instance.stop"
+	TimelineDispose          = "instance.dispose"
+	TimelineScopeDispose     = "scope.dispose"
+)
+
+// TimelineEvent is a single recorded startup or shutdown step.
+type TimelineEvent struct {
+	Category string
+	Name     string
+	Start    time.Time
+	Duration time.Duration
+	Error    string
+
+	// track is the trace thread the event is exported on
+	track int
+}
+
+// timeline records lifecycle steps when enabled with WithTimeline.
+type timeline struct {
+	mu     sync.Mutex
+	origin time.Time
+	events []TimelineEvent
+
+	// busy marks the tracks held by open spans
+	busy []bool
+}
+
+// newTimeline creates an empty timeline starting now.
+func newTimeline() *timeline {
+	return &timeline{origin: time.Now()}
+}
+
+// record appends a completed event.
+func (tl *timeline) record(event TimelineEvent) {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	tl.events = append(tl.events, event)
+}
+
+// acquire returns the lowest track no open span holds and holds it.
+// Spans open at the same time, such as those of concurrent resolutions or
+// workers, therefore never share a track.
+func (tl *timeline) acquire() int {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	for i, busy := range tl.busy {
+		if !busy {
+			tl.busy[i] = true
+			return i
+		}
+	}
+	tl.busy = append(tl.busy, true)
+	return len(tl.busy) - 1
+}
+
+// release frees a track held by a span that has ended.
+func (tl *timeline) release(track int) {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	tl.busy[track] = false
+}
+
+// snapshot returns a copy of all recorded events.
+func (tl *timeline) snapshot() []TimelineEvent {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	events := make([]TimelineEvent, len(tl.events))
+	copy(events, tl.events)
+	return events
+}
+
+// WithTimeline enables recording of provider register/boot, singleton
+// creation, hosted service start/stop, shutdown, and other lifecycle
+// steps. Retrieve the recording with TimelineEvents or ExportTimeline.
+//
+// Example:
+//
+//	container := nasc.New(nasc.WithTimeline())
+func WithTimeline() Option {
+	return func(n *Nasc) error {
+		n.timeline = newTimeline()
+		return nil
+	}
+}
+
+// trace starts a timeline span and returns the function that ends it.
+// It is a no-op when the timeline is disabled.
+func (n *Nasc) trace(category, name string) func(error) {
+	if n.timeline == nil {
+		return func(error) {}
+	}
+
+	track := n.timeline.acquire()
+	start := time.Now()
+	return func(err error) {
+		event := TimelineEvent{
+			Category: category,
+			Name:     name,
+			Start:    start,
+			Duration: time.Since(start),
+			track:    track,
+		}
+		if err != nil {
+			event.Error = err.Error()
+		}
+		n.timeline.record(event)
+		n.timeline.release(track)
+	}
+}
+
+// traceFactory wraps an instance factory so its execution is recorded.
+func (n *Nasc) traceFactory(category, name string, factory func() (interface{}, error)) func() (interface{}, error) {
+	if n.timeline == nil {
+		return factory
+	}
+
+	return func() (interface{}, error) {
+		end := n.trace(category, name)
+		instance, err := factory()
+		end(err)
+		return instance, err
+	}
+}
+
+// TimelineEvents returns the recorded lifecycle events in completion order.
+// Returns nil when the container was created without WithTimeline.
+func (n *Nasc) TimelineEvents() []TimelineEvent {
+	if n.timeline == nil {
+		return nil
+	}
+	return n.timeline.snapshot()
+}
+
+// traceEvent is a Chrome trace-event "complete" event.
+type traceEvent struct {
+	Name      string            `json:"name"`
+	Category  string            `json:"cat"`
+	Phase     string            `json:"ph"`
+	Timestamp int64             `json:"ts"`
+	Duration  int64             `json:"dur"`
+	PID       int               `json:"pid"`
+	TID       int               `json:"tid"`
+	Args      map[string]string `json:"args,omitempty"`
+}
+
+// ExportTimeline writes the recorded timeline as Chrome trace-event JSON,
+// which can be loaded in chrome://tracing or Perfetto. Steps that overlap
+// in time, including a step and the steps it triggers, are written to
+// separate threads, so concurrent work is never shown nested.
+//
+// Example:
+//
+//	f, _ := os.Create("startup.json")
+//	defer f.Close()
+//	container.ExportTimeline(f)
+func (n *Nasc) ExportTimeline(w io.Writer) error {
+	var origin time.Time
+	if n.timeline != nil {
+		origin = n.timeline.origin
+	}
+
+	events := n.TimelineEvents()
+	traceEvents := make([]traceEvent, 0, len(events))
+	for _, event := range events {
+		te := traceEvent{
+			Name:      event.Name,
+			Category:  event.Category,
+			Phase:     "X",
+			Timestamp: event.Start.Sub(origin).Microseconds(),
+			Duration:  event.Duration.Microseconds(),
+			PID:       1,
+			TID:       event.track + 1,
+		}
+		if event.Error != "" {
+			te.Args = map[string]string{"error": event.Error}
+		}
+		traceEvents = append(traceEvents, te)
+	}
+
+	return json.NewEncoder(w).Encode(struct {
+		TraceEvents     []traceEvent `json:"traceEvents"`
+		DisplayTimeUnit string       `json:"displayTimeUnit"`
+	}{traceEvents, "ms"})
+}
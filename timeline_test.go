@@ -0,0 +1,142 @@
+package nasc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestTimeline_RecordsProvidersAndSingletons(t *testing.T) {
+	container := New(WithTimeline())
+
+	_ = container.Singleton((*Logger)(nil), &ConsoleLogger{})
+	_ = container.RegisterProvider(&DatabaseProvider{})
+	_ = container.BootProviders()
+	container.Make((*Logger)(nil))
+	container.Make((*Logger)(nil))
+
+	counts := map[string]int{}
+	for _, event := range container.TimelineEvents() {
+		counts[event.Category]++
+	}
+
+	if counts[TimelineProviderRegister] != 1 {
+		t.Errorf("expected 1 provider register event, got %d", counts[TimelineProviderRegister])
+	}
+	if counts[TimelineProviderBoot] != 1 {
+		t.Errorf("expected 1 provider boot event, got %d", counts[TimelineProviderBoot])
+	}
+	// Booting creates the Database singleton and its Logger dependency once
+	if counts[TimelineSingleton] != 2 {
+		t.Errorf("expected 2 singleton events, got %d", counts[TimelineSingleton])
+	}
+}
+
+func TestTimeline_RecordsShutdown(t *testing.T) {
+	container := New(WithTimeline())
+	_ = container.BindInstanceAs(newQueueWorker(), (*Worker)(nil))
+	_ = container.AddHostedService((*Worker)(nil))
+	_ = container.Singleton((*Logger)(nil), &traceLogger{})
+	container.Make((*Logger)(nil))
+	container.CreateScope()
+
+	if err := container.StartHostedServices(context.Background()); err != nil {
+		t.Fatalf("StartHostedServices() error = %v", err)
+	}
+	if err := container.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+
+	counts := map[string]int{}
+	for _, event := range container.TimelineEvents() {
+		counts[event.Category]++
+	}
+	for _, category := range []string{
+		TimelineHostedStart, TimelineHostedStop, TimelineScopeDispose, TimelineDispose, TimelineShutdown,
+	} {
+		if counts[category] != 1 {
+			t.Errorf("expected 1 %s event, got %d", category, counts[category])
+		}
+	}
+}
+
+func TestTimeline_Disabled(t *testing.T) {
+	container := New()
+	_ = container.RegisterProvider(&LoggingProvider{})
+
+	if events := container.TimelineEvents(); events != nil {
+		t.Errorf("expected no events without WithTimeline, got %d", len(events))
+	}
+}
+
+func TestExportTimeline_ChromeTraceFormat(t *testing.T) {
+	container := New(WithTimeline())
+	_ = container.RegisterProvider(&FailingProvider{})
+
+	var buf bytes.Buffer
+	if err := container.ExportTimeline(&buf); err != nil {
+		t.Fatalf("ExportTimeline failed: %v", err)
+	}
+
+	var trace struct {
+		TraceEvents []struct {
+			Name  string            `json:"name"`
+			Cat   string            `json:"cat"`
+			Phase string            `json:"ph"`
+			Args  map[string]string `json:"args"`
+		} `json:"traceEvents"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &trace); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+
+	if len(trace.TraceEvents) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(trace.TraceEvents))
+	}
+	event := trace.TraceEvents[0]
+	if event.Phase != "X" || event.Cat != TimelineProviderRegister {
+		t.Errorf("unexpected event: %+v", event)
+	}
+	if event.Args["error"] == "" {
+		t.Error("failed step should carry the error")
+	}
+}
+
+func TestExportTimeline_OverlappingStepsUseSeparateThreads(t *testing.T) {
+	container := New(WithTimeline())
+
+	// Two steps open at once, as with concurrent resolutions or workers
+	endFirst := container.trace(TimelineSingleton, "first")
+	endSecond := container.trace(TimelineSingleton, "second")
+	endFirst(nil)
+	endSecond(nil)
+
+	// A later step reuses the freed thread
+	container.trace(TimelineSingleton, "third")(nil)
+
+	var buf bytes.Buffer
+	if err := container.ExportTimeline(&buf); err != nil {
+		t.Fatalf("ExportTimeline failed: %v", err)
+	}
+	var trace struct {
+		TraceEvents []struct {
+			Name string `json:"name"`
+			TID  int    `json:"tid"`
+		} `json:"traceEvents"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &trace); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+
+	tids := map[string]int{}
+	for _, event := range trace.TraceEvents {
+		tids[event.Name] = event.TID
+	}
+	if tids["first"] == tids["second"] {
+		t.Errorf("overlapping steps share thread %d", tids["first"])
+	}
+	if tids["third"] != 1 {
+		t.Errorf("later step got thread %d, want 1", tids["third"])
+	}
+}
@@ -0,0 +1,47 @@
+package nasc
+
+// TrackTransients makes the scope own the disposable transient instances
+// it creates, including transient constructor dependencies of its scoped
+// services, and dispose them with the scope like scoped instances.
+// Child scopes inherit the setting.
+//
+// Without it, transients are never tracked and their cleanup is left to
+// the caller. Only instances implementing Disposable, DisposableWithContext
+// or TeardownDisposable are tracked, so a long-lived scope that creates
+// many transients holds on to them until it is disposed.
+//
+// Example:
+//
+//	scope := container.CreateScope(nasc.TrackTransients())
+//	defer scope.Dispose() // also closes every Connection made below
+//
+//	conn := scope.Make((*Connection)(nil)).(Connection)
+func TrackTransients() ScopeOption {
+	return func(s *Scope) {
+		s.ownTransients = true
+	}
+}
+
+// trackTransient registers a transient instance for disposal with the
+// scope if the scope owns its transients.
+func (s *Scope) trackTransient(instance interface{}) {
+	if !s.ownTransients || !isDisposable(instance) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.disposed {
+		s.creationOrder = append(s.creationOrder, instance)
+	}
+}
+
+// isDisposable reports whether disposeInstance would release instance.
+func isDisposable(instance interface{}) bool {
+	switch instance.(type) {
+	case TeardownDisposable, DisposableWithContext, Disposable:
+		return true
+	}
+	return false
+}
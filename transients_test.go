@@ -0,0 +1,59 @@
+package nasc
+
+import "testing"
+
+type Connection interface {
+	Closed() bool
+}
+
+type trackedConnection struct{ closed bool }
+
+func (c *trackedConnection) Closed() bool { return c.closed }
+func (c *trackedConnection) Dispose() error {
+	c.closed = true
+	return nil
+}
+
+func TestTrackTransients(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Connection)(nil), &trackedConnection{})
+	var dependency *trackedConnection
+	_ = container.ScopedConstructor((*Logger)(nil), func(conn Connection) *ConsoleLogger {
+		dependency = conn.(*trackedConnection)
+		return &ConsoleLogger{}
+	})
+
+	scope := container.CreateScope(TrackTransients())
+	first := scope.Make((*Connection)(nil)).(*trackedConnection)
+	second := scope.Make((*Connection)(nil)).(*trackedConnection)
+	if first == second {
+		t.Fatal("transient bindings should create new instances")
+	}
+	scope.Make((*Logger)(nil))
+
+	child := scope.CreateChildScope()
+	inChild := child.Make((*Connection)(nil)).(*trackedConnection)
+
+	if err := scope.Dispose(); err != nil {
+		t.Fatalf("Dispose failed: %v", err)
+	}
+	if !first.closed || !second.closed || !inChild.closed || !dependency.closed {
+		t.Error("tracked transients should be disposed with their scope")
+	}
+	if n := len(scope.creationOrder); n != 0 {
+		t.Errorf("creation order should be cleared, has %d entries", n)
+	}
+}
+
+func TestTrackTransients_Disabled(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Connection)(nil), &trackedConnection{})
+
+	scope := container.CreateScope()
+	conn := scope.Make((*Connection)(nil)).(*trackedConnection)
+	_ = scope.Dispose()
+
+	if conn.closed {
+		t.Error("transients should not be disposed unless the scope tracks them")
+	}
+}
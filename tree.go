@@ -0,0 +1,291 @@
+package nasc
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// treeDependency is one edge of the dependency tree printed by PrintTree.
+type treeDependency struct {
+	t    reflect.Type
+	name string
+
+	// tag is set for `inject:"tag=..."` edges, which receive the tag's bindings
+	tag string
+
+	// note qualifies the edge, e.g. "lazy" or "optional"
+	note string
+
+	// collection marks []T and map[string]T edges, which receive many bindings
+	collection bool
+
+	// optional marks edges that may be left unresolved
+	optional bool
+}
+
+// TreeOption configures PrintTree.
+type TreeOption func(*treePrinter)
+
+// WithTreeColor colors lifetimes and problems with ANSI escape codes.
+func WithTreeColor() TreeOption {
+	return func(p *treePrinter) {
+		p.color = true
+	}
+}
+
+// PrintTree writes the dependency tree rooted at rootType to w, one
+// indented line per dependency with its lifetime and name. It is a quick
+// terminal-friendly view of the graph during development.
+//
+// Dependencies come from constructor parameters and auto-wired fields.
+// Factory bindings are leaves, since their dependencies are not declared.
+// Missing bindings and cycles are marked rather than reported as errors.
+//
+// Example:
+//
+//	container.PrintTree(os.Stdout, (*App)(nil), nasc.WithTreeColor())
+//
+//	// nasc.App (singleton) *main.app
+//	// ├── nasc.Logger (singleton) *main.consoleLogger
+//	// └── nasc.Database [primary] (transient) *main.postgres
+//	//     └── nasc.Logger (singleton) *main.consoleLogger
+func (n *Nasc) PrintTree(w io.Writer, rootType interface{}, opts ...TreeOption) error {
+	if rootType == nil {
+		return &InvalidBindingError{Reason: "root type cannot be nil"}
+	}
+
+	p := &treePrinter{n: n, w: w, path: make(map[treeDependency]bool)}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	p.print(treeDependency{t: typeOfToken(rootType)}, "", "")
+	return p.err
+}
+
+// treePrinter renders a dependency tree.
+type treePrinter struct {
+	n     *Nasc
+	w     io.Writer
+	color bool
+	err   error
+
+	// path holds the dependencies on the current branch, to detect cycles
+	path map[treeDependency]bool
+}
+
+// ANSI escape codes used by WithTreeColor.
+const (
+	ansiReset = "\x1b[0m"
+	ansiDim   = "\x1b[2m"
+	ansiCyan  = "\x1b[36m"
+	ansiRed   = "\x1b[31m"
+)
+
+// paint wraps s in an ANSI code when color is enabled.
+func (p *treePrinter) paint(code, s string) string {
+	if !p.color {
+		return s
+	}
+	return code + s + ansiReset
+}
+
+// print writes dep and its dependencies. prefix is written before dep's own
+// line, indent before the lines of its children.
+func (p *treePrinter) print(dep treeDependency, prefix, indent string) {
+	line := dep.t.String()
+	if dep.name != "" {
+		line += fmt.Sprintf(" [%s]", dep.name)
+	}
+	note := dep.note
+	if dep.optional && note != "optional" {
+		note = strings.TrimPrefix(note+",optional", ",")
+	}
+	if note != "" {
+		line += " " + p.paint(ansiDim, "<"+note+">")
+	}
+
+	if dep.collection {
+		line += " " + p.paint(ansiCyan, fmt.Sprintf("(%d bindings)", p.n.collectionSize(dep)))
+		if p.err == nil {
+			_, p.err = fmt.Fprintln(p.w, prefix+line)
+		}
+		return
+	}
+
+	binding, ok := p.binding(dep)
+	switch {
+	case !ok && dep.note == "ambient":
+		// Supplied by the resolution itself
+	case !ok && dep.optional:
+		line += " " + p.paint(ansiDim, "(not bound)")
+	case !ok:
+		line += " " + p.paint(ansiRed, "(missing)")
+	case p.path[dep]:
+		line += " " + p.paint(ansiRed, "(cycle)")
+	default:
+		line += " " + p.paint(ansiCyan, "("+binding.Lifetime+")")
+		if binding.ConcreteType != nil {
+			line += " " + binding.ConcreteType.String()
+		}
+	}
+
+	if p.err == nil {
+		_, p.err = fmt.Fprintln(p.w, prefix+line)
+	}
+	if !ok || p.path[dep] {
+		return
+	}
+
+	p.path[dep] = true
+	defer delete(p.path, dep)
+
+	children := p.n.treeDependencies(binding)
+	for i, child := range children {
+		if i == len(children)-1 {
+			p.print(child, indent+"└── ", indent+"    ")
+		} else {
+			p.print(child, indent+"├── ", indent+"│   ")
+		}
+	}
+}
+
+// binding looks up the binding for a dependency.
+func (p *treePrinter) binding(dep treeDependency) (*registry.Binding, bool) {
+	var binding *registry.Binding
+	var err error
+	if dep.name != "" {
+		binding, err = p.n.registry.GetNamed(dep.t, dep.name)
+	} else {
+		binding, err = p.n.registry.Get(dep.t)
+	}
+	return binding, err == nil
+}
+
+// treeDependencies lists the declared dependencies of a binding.
+func (n *Nasc) treeDependencies(binding *registry.Binding) []treeDependency {
+	var deps []treeDependency
+
+	if binding.Constructor != nil {
+		info := binding.Constructor.(*constructorInfo)
+		for i, paramType := range info.paramTypes {
+			deps = append(deps, n.paramDependencies(paramType, info.paramName(i))...)
+		}
+		return deps
+	}
+
+	if binding.AutoWireEnabled && binding.ConcreteType != nil {
+		deps = n.fieldDependencies(binding.ConcreteType, map[reflect.Type]bool{})
+	}
+	return deps
+}
+
+// fieldDependencies lists the dependencies of a struct's tagged fields,
+// including those of nested `inject:"wire"` structs.
+func (n *Nasc) fieldDependencies(t reflect.Type, seen map[reflect.Type]bool) []treeDependency {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if seen[t] {
+		return nil
+	}
+	seen[t] = true
+
+	var deps []treeDependency
+	for _, field := range n.reflectionCache.getFieldInfo(t) {
+		tag, tagged := field.tag.Lookup("inject")
+		opts := parseInjectTag(tag)
+		if !tagged || opts.skip || opts.scopeKey != "" {
+			continue
+		}
+		if !field.isInjectable && !n.unexportedInjection && !(field.embedded && opts.wire) {
+			continue
+		}
+		if opts.wire {
+			deps = append(deps, n.fieldDependencies(field.typ, seen)...)
+			continue
+		}
+		if opts.tag != "" && field.typ.Kind() == reflect.Slice {
+			deps = append(deps, treeDependency{
+				t:          field.typ.Elem(),
+				tag:        opts.tag,
+				note:       "tag=" + opts.tag,
+				collection: true,
+				optional:   opts.optional,
+			})
+			continue
+		}
+		fieldDeps := n.paramDependencies(field.typ, opts.name)
+		for j := range fieldDeps {
+			fieldDeps[j].optional = fieldDeps[j].optional || opts.optional
+		}
+		deps = append(deps, fieldDeps...)
+	}
+	return deps
+}
+
+// paramDependencies maps a parameter or field type to the dependencies it
+// resolves, looking through injection wrappers such as *Lazy[T].
+func (n *Nasc) paramDependencies(t reflect.Type, name string) []treeDependency {
+	switch {
+	case t.Kind() == reflect.Ptr && t.Implements(lazyInjectableType):
+		return []treeDependency{{t: wrappedType(t.Elem()), name: name, note: "lazy"}}
+	case t.Kind() == reflect.Struct && reflect.PointerTo(t).Implements(optionalInjectableType):
+		return []treeDependency{{t: wrappedType(t), name: name, note: "optional", optional: true}}
+	case isAmbientType(t) && !n.registry.Has(t):
+		return []treeDependency{{t: t, note: "ambient"}}
+	case isInStruct(t):
+		var deps []treeDependency
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.Type == inType || field.PkgPath != "" {
+				continue
+			}
+			opts := parseInjectTag(field.Tag.Get("inject"))
+			if opts.skip {
+				continue
+			}
+			fieldDeps := n.paramDependencies(field.Type, opts.name)
+			for j := range fieldDeps {
+				fieldDeps[j].optional = fieldDeps[j].optional || opts.optional
+			}
+			deps = append(deps, fieldDeps...)
+		}
+		return deps
+	case isProviderFunc(t) && !n.registry.Has(t):
+		return []treeDependency{{t: t.Out(0), note: "provider"}}
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Interface && !n.registry.Has(t):
+		return []treeDependency{{t: t.Elem(), note: "all", collection: true}}
+	case isNamedMap(t) && !n.registry.Has(t):
+		return []treeDependency{{t: t.Elem(), note: "named", collection: true}}
+	default:
+		return []treeDependency{{t: t, name: name}}
+	}
+}
+
+// collectionSize counts the bindings a []T, map[string]T or tag edge receives.
+func (n *Nasc) collectionSize(dep treeDependency) int {
+	if dep.tag != "" {
+		return len(n.registry.GetByTag(dep.tag))
+	}
+	if dep.note == "all" {
+		return len(n.registry.GetAll(dep.t))
+	}
+	count := 0
+	for _, name := range n.registry.GetAllNamedFor(dep.t) {
+		if !isTagBindingName(name) {
+			count++
+		}
+	}
+	return count
+}
+
+// wrappedType returns the type T held by a generic wrapper such as Lazy[T].
+func wrappedType(wrapper reflect.Type) reflect.Type {
+	field, _ := wrapper.FieldByName("value")
+	return field.Type
+}
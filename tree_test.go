@@ -0,0 +1,67 @@
+package nasc
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestPrintTree(t *testing.T) {
+	container := New()
+	_ = container.Singleton((*Logger)(nil), &ConsoleLogger{})
+	_ = container.BindConstructor((*ConstructorService)(nil), NewServiceWithDeps)
+	_ = container.BindConstructor((*paramService)(nil), newParamService)
+
+	var buf bytes.Buffer
+	if err := container.PrintTree(&buf, (*ConstructorService)(nil)); err != nil {
+		t.Fatalf("PrintTree failed: %v", err)
+	}
+	want := `nasc.ConstructorService (transient) *nasc.ConstructorServiceImpl
+├── nasc.Logger (singleton) *nasc.ConsoleLogger
+└── nasc.Database (missing)
+`
+	if buf.String() != want {
+		t.Errorf("PrintTree output:\n%s\nwant:\n%s", buf.String(), want)
+	}
+
+	buf.Reset()
+	_ = container.PrintTree(&buf, (*paramService)(nil))
+	for _, line := range []string{
+		"├── nasc.Database <optional> (not bound)",
+		"├── nasc.NotificationService [sms] (missing)",
+		"└── nasc.Logger <all> (1 bindings)",
+	} {
+		if !strings.Contains(buf.String(), line) {
+			t.Errorf("parameter object tree should contain %q, got:\n%s", line, buf.String())
+		}
+	}
+}
+
+type treePing interface{ Ping() }
+type treePong interface{ Pong() }
+type treePingImpl struct{ treePong }
+type treePongImpl struct{ treePing }
+
+func (*treePingImpl) Ping() {}
+func (*treePongImpl) Pong() {}
+
+func TestPrintTree_Cycle(t *testing.T) {
+	container := New()
+	_ = container.BindConstructor((*treePing)(nil), func(p treePong) *treePingImpl { return &treePingImpl{p} })
+	_ = container.BindConstructor((*treePong)(nil), func(p treePing) *treePongImpl { return &treePongImpl{p} })
+
+	var buf bytes.Buffer
+	_ = container.PrintTree(&buf, (*treePing)(nil))
+	if !strings.Contains(buf.String(), "(cycle)") {
+		t.Errorf("cycle should be marked, got:\n%s", buf.String())
+	}
+}
+
+func TestPrintTree_Color(t *testing.T) {
+	container := New()
+	var buf bytes.Buffer
+	_ = container.PrintTree(&buf, (*Logger)(nil), WithTreeColor())
+	if !strings.Contains(buf.String(), ansiRed+"(missing)"+ansiReset) {
+		t.Errorf("missing binding should be colored, got %q", buf.String())
+	}
+}
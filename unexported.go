@@ -0,0 +1,37 @@
+package nasc
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// WithUnexportedInjection lets AutoWire and auto-wired bindings set
+// unexported fields tagged `inject`, so dependency fields need not be
+// exported for the container's sake. Fields are written through unsafe
+// pointers, bypassing the usual reflection checks; only tagged fields are
+// touched.
+//
+// Example:
+//
+//	type UserService struct {
+//	    repo   UserRepository `inject:""`
+//	    logger Logger         `inject:"optional"`
+//	}
+//
+//	container := nasc.New(nasc.WithUnexportedInjection())
+func WithUnexportedInjection() Option {
+	return func(n *Nasc) error {
+		n.unexportedInjection = true
+		return nil
+	}
+}
+
+// exposeField returns a settable view of an unexported struct field. The
+// field must be addressable, which it is when reached through a pointer to
+// its struct.
+func exposeField(field reflect.Value) reflect.Value {
+	if field.CanSet() || !field.CanAddr() {
+		return field
+	}
+	return reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem()
+}
@@ -0,0 +1,68 @@
+package nasc
+
+import (
+	"strings"
+	"testing"
+)
+
+type serviceWithPrivateDeps struct {
+	logger Logger   `inject:""`
+	db     Database `inject:"optional"`
+	cache  Logger
+	Public Logger `inject:""`
+}
+
+func TestWithUnexportedInjection(t *testing.T) {
+	c := New(WithUnexportedInjection())
+	c.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	service := &serviceWithPrivateDeps{}
+	if err := c.AutoWire(service); err != nil {
+		t.Fatalf("AutoWire() error = %v", err)
+	}
+	if service.logger == nil || service.Public == nil {
+		t.Error("tagged fields were not injected")
+	}
+	if service.db != nil {
+		t.Error("optional unbound field was set")
+	}
+	if service.cache != nil {
+		t.Error("untagged unexported field was set")
+	}
+}
+
+func TestWithUnexportedInjection_Disabled(t *testing.T) {
+	c := New()
+	c.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	service := &serviceWithPrivateDeps{}
+	if err := c.AutoWire(service); err != nil {
+		t.Fatalf("AutoWire() error = %v", err)
+	}
+	if service.logger != nil {
+		t.Error("unexported field was injected without WithUnexportedInjection")
+	}
+	if service.Public == nil {
+		t.Error("exported field was not injected")
+	}
+}
+
+func TestWithUnexportedInjection_Binding(t *testing.T) {
+	c := New(WithUnexportedInjection())
+	c.BindAutoWire((*serviceWithPrivateDeps)(nil), &serviceWithPrivateDeps{})
+
+	if _, err := c.MakeSafe((*serviceWithPrivateDeps)(nil)); err == nil || !strings.Contains(err.Error(), "logger") {
+		t.Errorf("MakeSafe() error = %v, want failure naming logger", err)
+	}
+
+	c.Bind((*Logger)(nil), &ConsoleLogger{})
+	service := c.Make((*serviceWithPrivateDeps)(nil)).(*serviceWithPrivateDeps)
+	if service.logger == nil {
+		t.Error("unexported field of an auto-wired binding was not injected")
+	}
+
+	clone := c.Clone()
+	if !clone.unexportedInjection {
+		t.Error("Clone() dropped WithUnexportedInjection")
+	}
+}
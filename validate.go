@@ -0,0 +1,153 @@
+package nasc
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sync"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// validationKey identifies a binding verified during a Validate run.
+type validationKey struct {
+	t    reflect.Type
+	name string
+}
+
+// validationResult is the outcome of resolving a binding during validation.
+type validationResult struct {
+	instance interface{}
+	err      error
+}
+
+// validationMemo records bindings already verified during a Validate run,
+// so subtrees shared between roots are only constructed once.
+//
+// Only completed results are stored. Workers that race on the same binding
+// may both construct it, but never block on each other, which keeps
+// validation deadlock-free even when the graph contains cycles.
+type validationMemo struct {
+	mu      sync.RWMutex
+	results map[validationKey]validationResult
+}
+
+// newValidationMemo creates an empty validation memo.
+func newValidationMemo() *validationMemo {
+	return &validationMemo{
+		results: make(map[validationKey]validationResult),
+	}
+}
+
+// get returns the memoized result for a binding, if any.
+func (m *validationMemo) get(key validationKey) (validationResult, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result, ok := m.results[key]
+	return result, ok
+}
+
+// put stores the result for a binding.
+func (m *validationMemo) put(key validationKey, result validationResult) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.results[key] = result
+}
+
+// Validate checks the container's bindings for potential issues.
+// Returns nil if validation passes, or ValidationError with all found issues.
+// Pending conditional bindings (see BindIf) are evaluated first.
+//
+// Issues are reported in a deterministic order, by binding type and then
+// name (see Bindings), so output is stable between runs.
+//
+// Every binding is resolved once using a pool of workers (see
+// WithValidationWorkers). Results are memoized for the duration of the run,
+// so dependencies shared by many bindings are only constructed once.
+// Singleton and scoped bindings are constructed in isolation and do not
+// populate the container's singleton cache.
+//
+// Example:
+//
+//	if err := container.Validate(); err != nil {
+//	   log.Fatalf("Container validation failed: %v", err)
+//	}
+func (n *Nasc) Validate() error {
+	var validationErrors []error
+
+	// Activate conditional bindings before inspecting the graph
+	if err := n.applyConditionalBindings(); err != nil {
+		validationErrors = append(validationErrors, err)
+	}
+
+	validationErrors = append(validationErrors, n.validateRoots(n.sortedBindings())...)
+
+	if len(validationErrors) > 0 {
+		return &ValidationError{Errors: validationErrors}
+	}
+
+	return nil
+}
+
+// validateRoots resolves each root binding once using a pool of workers
+// and returns the failures in root order.
+func (n *Nasc) validateRoots(roots []*registry.Binding) []error {
+	memo := newValidationMemo()
+	results := make([]error, len(roots))
+
+	workers := n.validationWorkers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(roots) {
+		workers = len(roots)
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = n.validateBinding(roots[i], memo)
+			}
+		}()
+	}
+	for i := range roots {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	var errs []error
+	for _, err := range results {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// validateBinding resolves a single root binding and reports any failure.
+func (n *Nasc) validateBinding(binding *registry.Binding, memo *validationMemo) (err error) {
+	label := binding.AbstractType.String()
+	if binding.Name != "" {
+		label = fmt.Sprintf("%s[%s]", label, binding.Name)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("binding %s: resolution panicked: %v", label, r)
+		}
+	}()
+
+	ctx := newResolutionContext()
+	ctx.memo = memo
+	if _, resolveErr := n.makeSafeWithContext(binding.AbstractType, binding.Name, ctx); resolveErr != nil {
+		return fmt.Errorf("binding %s: %w", label, resolveErr)
+	}
+	return nil
+}
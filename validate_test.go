@@ -0,0 +1,127 @@
+package nasc
+
+import (
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+type diamondTop struct{}
+type diamondLeft struct{}
+type diamondRight struct{}
+type diamondBottom struct{}
+
+func TestValidate_MemoizesSharedDependencies(t *testing.T) {
+	var bottomCalls int32
+
+	container := New(WithValidationWorkers(1))
+	_ = container.BindConstructor((**diamondBottom)(nil), func() *diamondBottom {
+		atomic.AddInt32(&bottomCalls, 1)
+		return &diamondBottom{}
+	})
+	_ = container.BindConstructor((**diamondLeft)(nil), func(*diamondBottom) *diamondLeft { return &diamondLeft{} })
+	_ = container.BindConstructor((**diamondRight)(nil), func(*diamondBottom) *diamondRight { return &diamondRight{} })
+	_ = container.BindConstructor((**diamondTop)(nil), func(*diamondLeft, *diamondRight) *diamondTop { return &diamondTop{} })
+
+	if err := container.Validate(); err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+
+	if calls := atomic.LoadInt32(&bottomCalls); calls != 1 {
+		t.Errorf("shared dependency constructed %d times, expected 1", calls)
+	}
+}
+
+func TestValidate_WorkerCountsAgree(t *testing.T) {
+	build := func(workers int) *Nasc {
+		c := New(WithValidationWorkers(workers))
+		_ = c.Bind((*Logger)(nil), &ConsoleLogger{})
+		_ = c.BindConstructor((*ConstructorService)(nil), NewServiceWithDeps) // Database missing
+		_ = c.BindConstructor((*CircularA)(nil), NewCircularA)
+		_ = c.BindConstructor((*CircularB)(nil), NewCircularB)
+		return c
+	}
+
+	serial := build(1).Validate()
+	parallel := build(8).Validate()
+
+	var serialErr, parallelErr *ValidationError
+	if !errors.As(serial, &serialErr) || !errors.As(parallel, &parallelErr) {
+		t.Fatalf("expected ValidationErrors, got %v and %v", serial, parallel)
+	}
+	if len(serialErr.Errors) != len(parallelErr.Errors) {
+		t.Errorf("serial found %d errors, parallel found %d", len(serialErr.Errors), len(parallelErr.Errors))
+	}
+	if serial.Error() != parallel.Error() {
+		t.Errorf("error output differs:\n%v\nvs\n%v", serial, parallel)
+	}
+}
+
+func TestValidate_DoesNotPopulateSingletonCache(t *testing.T) {
+	calls := 0
+	container := New()
+	_ = container.SingletonConstructor((*Logger)(nil), func() *ConsoleLogger {
+		calls++
+		return &ConsoleLogger{}
+	})
+
+	if err := container.Validate(); err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+
+	container.Make((*Logger)(nil))
+	container.Make((*Logger)(nil))
+
+	if calls != 2 {
+		t.Errorf("constructor called %d times, expected once for Validate and once for Make", calls)
+	}
+}
+
+func TestValidate_ScopedBinding(t *testing.T) {
+	container := New()
+	_ = container.Scoped((*Logger)(nil), &ConsoleLogger{})
+
+	if err := container.Validate(); err != nil {
+		t.Errorf("scoped binding should validate: %v", err)
+	}
+}
+
+func TestValidate_RecoversPanics(t *testing.T) {
+	container := New()
+	_ = container.BindConstructor((*Logger)(nil), func() *ConsoleLogger {
+		panic("boom")
+	})
+
+	err := container.Validate()
+	if err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Errorf("expected panic to be reported, got %v", err)
+	}
+}
+
+func TestWithValidationWorkers_Negative(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic for negative worker count")
+		}
+	}()
+	New(WithValidationWorkers(-1))
+}
+
+func TestValidate_DeterministicOrder(t *testing.T) {
+	build := func() *Nasc {
+		container := New()
+		_ = container.BindConstructor((*ConstructorService)(nil), NewServiceWithLogger)
+		_ = container.BindConstructor((*paramService)(nil), newParamService)
+		_ = container.BindNamed((*ConstructorService)(nil), &BasicConstructorService{}, "basic")
+		_ = container.BindWithTags((*Database)(nil), &MockDB{}, []string{"db"})
+		return container
+	}
+
+	want := build().Validate().Error()
+	for i := 0; i < 10; i++ {
+		if got := build().Validate().Error(); got != want {
+			t.Fatalf("Validate output changed between runs:\n%s\nvs\n%s", got, want)
+		}
+	}
+}
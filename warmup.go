@@ -0,0 +1,253 @@
+package nasc
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/toutaio/toutago-nasc-dependency-injector/registry"
+)
+
+// WarmUp constructs every singleton binding now instead of on first Make,
+// so configuration errors surface at startup and first-request latency is
+// predictable. Singletons are created after the singletons they depend
+// on, concurrently when WithWarmUpWorkers allows; already created
+// singletons are left alone.
+//
+// All singletons are attempted; the returned error lists every failure.
+//
+// Example:
+//
+//	if err := container.WarmUp(); err != nil {
+//	    log.Fatal(err)
+//	}
+func (n *Nasc) WarmUp() error {
+	return n.warmUp(func(*registry.Binding) bool { return true })
+}
+
+// Eager marks the default singleton binding of abstractType for eager
+// creation: it is constructed by BootProviders and Builder.Build, without
+// an explicit WarmUp.
+//
+// Returns an InvalidBindingError if abstractType is not bound as a singleton.
+//
+// Example:
+//
+//	container.Singleton((*Database)(nil), &PostgresDB{})
+//	container.Eager((*Database)(nil))
+func (n *Nasc) Eager(abstractType interface{}) error {
+	if abstractType == nil {
+		return &InvalidBindingError{Reason: "abstract type cannot be nil"}
+	}
+
+	abstractT := typeOfToken(abstractType)
+	binding, err := n.registry.Get(abstractT)
+	if err != nil {
+		return err
+	}
+	if Lifetime(binding.Lifetime) != LifetimeSingleton {
+		return &InvalidBindingError{
+			Reason: fmt.Sprintf("%v is bound as %s, only singletons can be eager", abstractT, binding.Lifetime),
+		}
+	}
+
+	eager := *binding
+	eager.Eager = true
+	if _, err := n.registry.Replace(&eager); err != nil {
+		return err
+	}
+	n.emitRegistered(&eager)
+	return nil
+}
+
+// warmUpEager constructs the singletons marked with Eager.
+func (n *Nasc) warmUpEager() error {
+	return n.warmUp(func(b *registry.Binding) bool { return b.Eager })
+}
+
+// warmUp constructs the singleton bindings selected by include, in
+// dependency order, using up to the configured number of workers (see
+// WithWarmUpWorkers). A singleton starts once every selected singleton it
+// depends on has been attempted; singletons caught in a cycle are
+// attempted last, one at a time.
+func (n *Nasc) warmUp(include func(*registry.Binding) bool) error {
+	var selected []*registry.Binding
+	index := make(map[*registry.Binding]int)
+	for _, binding := range n.sortedBindings() {
+		if Lifetime(binding.Lifetime) == LifetimeSingleton && include(binding) {
+			index[binding] = len(selected)
+			selected = append(selected, binding)
+		}
+	}
+
+	// Count unmet dependencies and record who waits on whom
+	pending := make([]int, len(selected))
+	dependents := make([][]int, len(selected))
+	for i, binding := range selected {
+		for dep := range n.singletonDependencies(binding) {
+			if j, ok := index[dep]; ok && j != i {
+				pending[i]++
+				dependents[j] = append(dependents[j], i)
+			}
+		}
+	}
+
+	workers := n.warmUpWorkers
+	if workers < 1 {
+		workers = 1
+	}
+
+	results := make([]error, len(selected))
+	jobs := make(chan int)
+	done := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = n.warmUpBinding(selected[i])
+				done <- i
+			}
+		}()
+	}
+
+	var ready []int
+	for i := range selected {
+		if pending[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+	started := make([]bool, len(selected))
+	running := 0
+	for len(ready) > 0 || running > 0 {
+		// Hand out ready work while waiting for completions
+		var send chan int
+		var next int
+		if len(ready) > 0 {
+			send, next = jobs, ready[0]
+		}
+		select {
+		case send <- next:
+			started[next] = true
+			ready = ready[1:]
+			running++
+		case i := <-done:
+			running--
+			for _, dependent := range dependents[i] {
+				pending[dependent]--
+				if pending[dependent] == 0 {
+					ready = append(ready, dependent)
+				}
+			}
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	// Whatever never became ready is part of a cycle
+	for i, binding := range selected {
+		if !started[i] {
+			results[i] = n.warmUpBinding(binding)
+		}
+	}
+
+	var errs []error
+	for _, err := range results {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("warm-up failed for %d singleton(s): %w", len(errs), errors.Join(errs...))
+	}
+	return nil
+}
+
+// singletonDependencies returns the singleton bindings a binding needs
+// while it is constructed, looking through the non-singleton bindings in
+// between. Lazy and provider dependencies are resolved later, so they do
+// not count.
+func (n *Nasc) singletonDependencies(binding *registry.Binding) map[*registry.Binding]bool {
+	found := make(map[*registry.Binding]bool)
+	visited := map[*registry.Binding]bool{binding: true}
+
+	var visit func(*registry.Binding)
+	visit = func(b *registry.Binding) {
+		for _, dep := range n.treeDependencies(b) {
+			if dep.note == "lazy" || dep.note == "provider" {
+				continue
+			}
+			for _, target := range n.dependencyBindings(dep) {
+				if visited[target] {
+					continue
+				}
+				visited[target] = true
+				if Lifetime(target.Lifetime) == LifetimeSingleton {
+					found[target] = true
+					continue
+				}
+				visit(target)
+			}
+		}
+	}
+	visit(binding)
+	return found
+}
+
+// dependencyBindings returns the bindings a dependency edge resolves.
+func (n *Nasc) dependencyBindings(dep treeDependency) []*registry.Binding {
+	switch {
+	case dep.tag != "":
+		return n.registry.GetByTag(dep.tag)
+	case dep.note == "all":
+		return n.registry.GetAll(dep.t)
+	case dep.note == "named":
+		var bindings []*registry.Binding
+		for _, name := range n.registry.GetAllNamedFor(dep.t) {
+			if binding, err := n.registry.GetNamed(dep.t, name); err == nil && !isTagBindingName(name) {
+				bindings = append(bindings, binding)
+			}
+		}
+		return bindings
+	case dep.name != "":
+		if binding, err := n.registry.GetNamed(dep.t, dep.name); err == nil {
+			return []*registry.Binding{binding}
+		}
+	default:
+		if binding, err := n.registry.Get(dep.t); err == nil {
+			return []*registry.Binding{binding}
+		}
+	}
+	return nil
+}
+
+// WithWarmUpWorkers sets how many singletons WarmUp, BootProviders and
+// Builder.Build may construct concurrently. Independent singletons are
+// built in parallel; a singleton is only built after the singletons it
+// depends on. A value of 0 (the default) builds singletons one at a time.
+//
+// Example:
+//
+//	container := nasc.New(nasc.WithWarmUpWorkers(runtime.GOMAXPROCS(0)))
+func WithWarmUpWorkers(workers int) Option {
+	return func(n *Nasc) error {
+		if workers < 0 {
+			return fmt.Errorf("warm-up workers cannot be negative, got %d", workers)
+		}
+		n.warmUpWorkers = workers
+		return nil
+	}
+}
+
+// warmUpBinding constructs one singleton, converting panics to errors.
+func (n *Nasc) warmUpBinding(binding *registry.Binding) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &ResolutionError{Type: binding.AbstractType, Name: binding.Name, Context: fmt.Sprintf("panic: %v", r)}
+		}
+	}()
+
+	_, err = n.makeSafeWithContext(binding.AbstractType, binding.Name, newResolutionContext())
+	return err
+}
@@ -0,0 +1,184 @@
+package nasc
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWarmUp_CreatesSingletons(t *testing.T) {
+	calls := 0
+	container := New()
+	_ = container.SingletonConstructor((*ConstructorService)(nil), func() *BasicConstructorService {
+		calls++
+		return &BasicConstructorService{}
+	})
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	if err := container.WarmUp(); err != nil {
+		t.Fatalf("WarmUp failed: %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("singleton constructor called %d times, want 1", calls)
+	}
+
+	container.Make((*ConstructorService)(nil))
+	if calls != 1 {
+		t.Error("Make should reuse the warmed-up singleton")
+	}
+}
+
+func TestWarmUp_ReportsAllFailures(t *testing.T) {
+	container := New()
+	_ = container.SingletonConstructor((*ConstructorService)(nil), NewServiceThatFails)
+	_ = container.SingletonConstructor((*NotificationService)(nil), func() (*SMSNotifier, error) {
+		return nil, errors.New("smtp down")
+	})
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	err := container.WarmUp()
+	if err == nil {
+		t.Fatal("expected warm-up error")
+	}
+	for _, want := range []string{"2 singleton(s)", "constructor failed", "smtp down"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q should contain %q", err, want)
+		}
+	}
+}
+
+func TestEager_CreatedOnBoot(t *testing.T) {
+	calls := 0
+	container := New()
+	_ = container.SingletonConstructor((*ConstructorService)(nil), func() *BasicConstructorService {
+		calls++
+		return &BasicConstructorService{}
+	})
+	_ = container.SingletonConstructor((*NotificationService)(nil), func() *SMSNotifier {
+		t.Error("singletons not marked eager should stay lazy")
+		return &SMSNotifier{}
+	})
+
+	if err := container.Eager((*ConstructorService)(nil)); err != nil {
+		t.Fatalf("Eager failed: %v", err)
+	}
+	if calls != 0 {
+		t.Fatal("Eager should not create the singleton immediately")
+	}
+	if err := container.BootProviders(); err != nil {
+		t.Fatalf("BootProviders failed: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("eager singleton created %d times on boot, want 1", calls)
+	}
+
+	for _, info := range container.Bindings() {
+		if info.Type == typeOfToken((*ConstructorService)(nil)) && !info.Eager {
+			t.Error("BindingInfo should report the eager flag")
+		}
+	}
+}
+
+func TestEager_BuildFails(t *testing.T) {
+	builder := NewBuilder()
+	_ = builder.SingletonConstructor((*ConstructorService)(nil), NewServiceThatFails)
+	_ = builder.Bind((*Logger)(nil), &ConsoleLogger{})
+	_ = builder.Eager((*ConstructorService)(nil))
+
+	if _, err := builder.Build(); err == nil {
+		t.Error("Build should fail when an eager singleton cannot be created")
+	}
+}
+
+func TestEager_Invalid(t *testing.T) {
+	container := New()
+	_ = container.Bind((*Logger)(nil), &ConsoleLogger{})
+
+	var invalid *InvalidBindingError
+	if err := container.Eager((*Logger)(nil)); !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidBindingError for transient binding, got %v", err)
+	}
+	if err := container.Eager((*Database)(nil)); err == nil {
+		t.Error("expected error for unbound type")
+	}
+	if err := container.Eager(nil); !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidBindingError for nil, got %v", err)
+	}
+}
+
+func TestWarmUp_ParallelInDependencyOrder(t *testing.T) {
+	var mu sync.Mutex
+	var events []string
+	var running, peak int
+	track := func(name string) func() {
+		mu.Lock()
+		running++
+		if running > peak {
+			peak = running
+		}
+		events = append(events, "start "+name)
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+		return func() {
+			mu.Lock()
+			running--
+			events = append(events, "end "+name)
+			mu.Unlock()
+		}
+	}
+
+	container := New(WithWarmUpWorkers(4))
+	_ = container.SingletonConstructor((*Logger)(nil), func() *ConsoleLogger {
+		defer track("logger")()
+		return &ConsoleLogger{}
+	})
+	_ = container.SingletonConstructor((*Database)(nil), func() *MockDB {
+		defer track("db")()
+		return &MockDB{}
+	})
+	_ = container.SingletonConstructor((*ConstructorService)(nil), func(l Logger, db Database) *ConstructorServiceImpl {
+		defer track("service")()
+		return &ConstructorServiceImpl{Logger: l, Database: db}
+	})
+
+	if err := container.WarmUp(); err != nil {
+		t.Fatalf("WarmUp failed: %v", err)
+	}
+
+	if peak < 2 {
+		t.Errorf("independent singletons should be built concurrently, peak = %d", peak)
+	}
+	if last := events[len(events)-2:]; last[0] != "start service" || last[1] != "end service" {
+		t.Errorf("dependent singleton should be built last, events = %v", events)
+	}
+}
+
+func TestWarmUp_Cycle(t *testing.T) {
+	container := New(WithWarmUpWorkers(2))
+	_ = container.SingletonConstructor((*treePing)(nil), func(p treePong) *treePingImpl { return &treePingImpl{p} })
+	_ = container.SingletonConstructor((*treePong)(nil), func(p treePing) *treePongImpl { return &treePongImpl{p} })
+	_ = container.Singleton((*Logger)(nil), &ConsoleLogger{})
+
+	err := container.WarmUp()
+	if err == nil || !strings.Contains(err.Error(), "2 singleton(s)") {
+		t.Errorf("both cyclic singletons should fail, got %v", err)
+	}
+}
+
+func TestWithWarmUpWorkers_Invalid(t *testing.T) {
+	n := &Nasc{}
+	if err := WithWarmUpWorkers(-1)(n); err == nil {
+		t.Error("expected error for negative workers")
+	}
+}
+
+func TestWithWarmUpWorkers_ZeroIsDefault(t *testing.T) {
+	container := New(WithWarmUpWorkers(0))
+	_ = container.Singleton((*Logger)(nil), &ConsoleLogger{})
+	if err := container.WarmUp(); err != nil {
+		t.Fatalf("WarmUp() error = %v", err)
+	}
+}